@@ -0,0 +1,165 @@
+// Package apierrors defines the domain error reasons shared by the Auth,
+// HotelService and BookingService servers. Servers attach a reason to a gRPC
+// status as google.rpc.ErrorInfo; the gateway maps it to a stable HTTP status.
+package apierrors
+
+import (
+	"errors"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Domain is set on every ErrorInfo created by this package.
+const Domain = "services_proto.junbser.github.com"
+
+type Reason string
+
+const (
+	// Auth
+	ReasonInvalidCredentials    Reason = "INVALID_CREDENTIALS"
+	ReasonTokenExpired          Reason = "TOKEN_EXPIRED"
+	ReasonTokenInvalid          Reason = "TOKEN_INVALID"
+	ReasonPermissionDenied      Reason = "PERMISSION_DENIED"
+	ReasonUserNotFound          Reason = "USER_NOT_FOUND"
+	ReasonUserAlreadyExists     Reason = "USER_ALREADY_EXISTS"
+	ReasonEmailNotVerified      Reason = "EMAIL_NOT_VERIFIED"
+	ReasonMFARequired           Reason = "MFA_REQUIRED"
+	ReasonInvalidMFACode        Reason = "INVALID_MFA_CODE"
+	ReasonServiceAccountRevoked Reason = "SERVICE_ACCOUNT_REVOKED"
+	ReasonAccountLocked         Reason = "ACCOUNT_LOCKED"
+	ReasonAccountSuspended      Reason = "ACCOUNT_SUSPENDED"
+	ReasonAccountDeleted        Reason = "ACCOUNT_DELETED"
+	ReasonAccountNotDeleted     Reason = "ACCOUNT_NOT_DELETED"
+	ReasonReauthRequired        Reason = "REAUTHENTICATION_REQUIRED"
+
+	// Hotel
+	ReasonHotelNotFound       Reason = "HOTEL_NOT_FOUND"
+	ReasonRoomNotFound        Reason = "ROOM_NOT_FOUND"
+	ReasonResumeTokenExpired  Reason = "RESUME_TOKEN_EXPIRED"
+	ReasonPhotoNotFound       Reason = "PHOTO_NOT_FOUND"
+	ReasonUploadNotFound      Reason = "UPLOAD_NOT_FOUND"
+	ReasonChecksumMismatch    Reason = "CHECKSUM_MISMATCH"
+	ReasonRoomTypeNotFound    Reason = "ROOM_TYPE_NOT_FOUND"
+	ReasonRoomTypeInUse       Reason = "ROOM_TYPE_IN_USE"
+	ReasonRatePlanNotFound    Reason = "RATE_PLAN_NOT_FOUND"
+	ReasonRatePlanUnavailable Reason = "RATE_PLAN_UNAVAILABLE"
+	ReasonPricingRuleNotFound Reason = "PRICING_RULE_NOT_FOUND"
+
+	// Booking
+	ReasonBookingNotFound         Reason = "BOOKING_NOT_FOUND"
+	ReasonBookingConflict         Reason = "BOOKING_CONFLICT"
+	ReasonBookingAlreadyCancelled Reason = "BOOKING_ALREADY_CANCELLED"
+	ReasonRoomUnavailable         Reason = "ROOM_UNAVAILABLE"
+	ReasonInvalidDateRange        Reason = "INVALID_DATE_RANGE"
+
+	// Generic
+	ReasonValidationFailed Reason = "VALIDATION_FAILED"
+	ReasonRateLimited      Reason = "RATE_LIMITED"
+)
+
+// New returns a gRPC status error carrying reason as ErrorInfo.
+func New(code codes.Code, reason Reason, msg string) error {
+	return WithMetadata(code, reason, msg, nil)
+}
+
+// WithMetadata is like New but also attaches ErrorInfo metadata, e.g. the ID
+// of the conflicting resource.
+func WithMetadata(code codes.Code, reason Reason, msg string, md map[string]string) error {
+	st := status.New(code, msg)
+	withInfo, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   string(reason),
+		Domain:   Domain,
+		Metadata: md,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return withInfo.Err()
+}
+
+// FieldViolation describes one invalid request field.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Validation returns an InvalidArgument error with ErrorInfo and BadRequest
+// details listing the offending fields.
+func Validation(msg string, violations ...FieldViolation) error {
+	st := status.New(codes.InvalidArgument, msg)
+
+	br := &errdetails.BadRequest{}
+	for _, v := range violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+
+	withDetails, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: string(ReasonValidationFailed), Domain: Domain},
+		br,
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// Throttled returns a ResourceExhausted error with ErrorInfo and a RetryInfo
+// detail telling the caller when to try again.
+func Throttled(reason Reason, msg string, retryAfter time.Duration) error {
+	st := status.New(codes.ResourceExhausted, msg)
+	withDetails, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: string(reason), Domain: Domain},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// RetryDelay returns the RetryInfo delay of err.
+func RetryDelay(err error) (time.Duration, bool) {
+	for _, d := range status.Convert(err).Details() {
+		if ri, ok := d.(*errdetails.RetryInfo); ok && ri.GetRetryDelay() != nil {
+			return ri.GetRetryDelay().AsDuration(), true
+		}
+	}
+	return 0, false
+}
+
+// ReasonOf extracts the reason from err, or "" if err carries none.
+func ReasonOf(err error) Reason {
+	if info := InfoOf(err); info != nil {
+		return Reason(info.GetReason())
+	}
+	return ""
+}
+
+// InfoOf returns the first ErrorInfo detail of err.
+func InfoOf(err error) *errdetails.ErrorInfo {
+	if err == nil {
+		return nil
+	}
+	var se interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &se) {
+		return nil
+	}
+	for _, d := range se.GRPCStatus().Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	return nil
+}
+
+// Is reports whether err carries reason.
+func Is(err error, reason Reason) bool {
+	return ReasonOf(err) == reason
+}
@@ -0,0 +1,133 @@
+package apierrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReasonOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   codes.Code
+		wantReason Reason
+		wantMD     map[string]string
+	}{
+		{name: "nil", wantCode: codes.OK},
+		{name: "plain error", err: errors.New("boom"), wantCode: codes.Unknown},
+		{name: "status without details", err: status.Error(codes.NotFound, "gone"), wantCode: codes.NotFound},
+		{
+			name:       "new",
+			err:        New(codes.NotFound, ReasonHotelNotFound, "hotel not found"),
+			wantCode:   codes.NotFound,
+			wantReason: ReasonHotelNotFound,
+		},
+		{
+			name:       "with metadata",
+			err:        WithMetadata(codes.FailedPrecondition, ReasonRoomTypeInUse, "in use", map[string]string{"room_id": "r-1"}),
+			wantCode:   codes.FailedPrecondition,
+			wantReason: ReasonRoomTypeInUse,
+			wantMD:     map[string]string{"room_id": "r-1"},
+		},
+		{
+			name:       "wrapped",
+			err:        fmt.Errorf("create booking: %w", New(codes.Aborted, ReasonBookingConflict, "conflict")),
+			wantCode:   codes.Aborted,
+			wantReason: ReasonBookingConflict,
+		},
+		{
+			name:       "validation",
+			err:        Validation("bad request", FieldViolation{Field: "email", Description: "required"}),
+			wantCode:   codes.InvalidArgument,
+			wantReason: ReasonValidationFailed,
+		},
+		{
+			name:       "throttled",
+			err:        Throttled(ReasonRateLimited, "slow down", time.Second),
+			wantCode:   codes.ResourceExhausted,
+			wantReason: ReasonRateLimited,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(tt.err); got != tt.wantCode {
+				t.Errorf("code = %v, want %v", got, tt.wantCode)
+			}
+			if got := ReasonOf(tt.err); got != tt.wantReason {
+				t.Errorf("ReasonOf = %q, want %q", got, tt.wantReason)
+			}
+			if tt.wantReason != "" && !Is(tt.err, tt.wantReason) {
+				t.Errorf("Is(err, %s) = false", tt.wantReason)
+			}
+			if Is(tt.err, ReasonUserNotFound) {
+				t.Error("Is(err, USER_NOT_FOUND) = true")
+			}
+
+			info := InfoOf(tt.err)
+			if tt.wantReason == "" {
+				if info != nil {
+					t.Errorf("InfoOf = %v, want nil", info)
+				}
+				return
+			}
+			if info.GetDomain() != Domain {
+				t.Errorf("domain = %q, want %q", info.GetDomain(), Domain)
+			}
+			if fmt.Sprint(info.GetMetadata()) != fmt.Sprint(tt.wantMD) {
+				t.Errorf("metadata = %v, want %v", info.GetMetadata(), tt.wantMD)
+			}
+		})
+	}
+}
+
+func TestValidation(t *testing.T) {
+	err := Validation("bad request",
+		FieldViolation{Field: "email", Description: "required"},
+		FieldViolation{Field: "password", Description: "too short"},
+	)
+
+	var br *errdetails.BadRequest
+	for _, d := range status.Convert(err).Details() {
+		if v, ok := d.(*errdetails.BadRequest); ok {
+			br = v
+		}
+	}
+	if br == nil {
+		t.Fatal("no BadRequest detail")
+	}
+	var got []string
+	for _, v := range br.GetFieldViolations() {
+		got = append(got, v.GetField()+": "+v.GetDescription())
+	}
+	if want := "[email: required password: too short]"; fmt.Sprint(got) != want {
+		t.Errorf("violations = %v, want %s", got, want)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		want   time.Duration
+		wantOK bool
+	}{
+		{"throttled", Throttled(ReasonRateLimited, "slow down", 1500*time.Millisecond), 1500 * time.Millisecond, true},
+		{"locked", Throttled(ReasonAccountLocked, "locked", time.Minute), time.Minute, true},
+		{"no retry info", New(codes.ResourceExhausted, ReasonRateLimited, "slow down"), 0, false},
+		{"nil", nil, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := RetryDelay(tt.err)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("RetryDelay = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
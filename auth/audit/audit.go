@@ -0,0 +1,161 @@
+// Package audit records who did what through admin RPCs. Recorder's
+// interceptor writes an AuditEntry for every call to a method whose
+// auth_level is ADMIN, including calls denied for lacking admin rights;
+// entries go to a Store and are served by Auth.ListAuditEntries.
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	options "github.com/JunBSer/services_proto/options/auth_options/gen/go"
+	"github.com/JunBSer/services_proto/options/methodopts"
+)
+
+// requestIDKey is the metadata key the gateway forwards X-Request-Id under.
+const requestIDKey = "x-request-id"
+
+// Store persists audit entries.
+type Store interface {
+	Append(ctx context.Context, e *authpb.AuditEntry) error
+	// List returns matching entries newest first.
+	List(ctx context.Context, req *authpb.ListAuditEntriesRequest) (*authpb.ListAuditEntriesResponse, error)
+}
+
+// Actor identifies the caller of an audited method.
+type Actor struct {
+	ID   string
+	Type authpb.SubjectType
+}
+
+// ActorFunc returns the caller. The recorder runs ahead of the
+// authentication interceptor so that denied calls are recorded too, so
+// ActorFunc must resolve the caller from the request metadata itself, e.g.
+// by verifying the bearer token. It reports false for anonymous calls and
+// invalid tokens.
+type ActorFunc func(ctx context.Context) (Actor, bool)
+
+// Recorder builds audit entries and appends them to Store.
+type Recorder struct {
+	Store Store
+	Actor ActorFunc
+	// Audited decides which methods are recorded. Defaults to methods whose
+	// auth_level is ADMIN.
+	Audited func(fullMethod string) bool
+	// Now defaults to time.Now.
+	Now func() time.Time
+	// OnError is called when an entry cannot be stored. Failing to audit does
+	// not fail the call.
+	OnError func(error)
+}
+
+func NewRecorder(store Store, actor ActorFunc) *Recorder {
+	return &Recorder{Store: store, Actor: actor}
+}
+
+// IsAdmin reports whether the method declares auth_level ADMIN.
+func IsAdmin(fullMethod string) bool {
+	level, ok := methodopts.AuthLevel(fullMethod)
+	return ok && level == options.AuthLevel_ADMIN
+}
+
+func (r *Recorder) audited(fullMethod string) bool {
+	if r.Audited != nil {
+		return r.Audited(fullMethod)
+	}
+	return IsAdmin(fullMethod)
+}
+
+func (r *Recorder) now() time.Time {
+	if r.Now != nil {
+		return r.Now()
+	}
+	return time.Now()
+}
+
+// Entry builds the audit entry for a completed call.
+func (r *Recorder) Entry(ctx context.Context, fullMethod string, req any, err error) *authpb.AuditEntry {
+	st := status.Convert(err)
+	e := &authpb.AuditEntry{
+		Id:         rand.Text(),
+		Method:     fullMethod,
+		Outcome:    authpb.AuditOutcome_AUDIT_OUTCOME_SUCCESS,
+		StatusCode: st.Code().String(),
+		Time:       timestamppb.New(r.now()),
+	}
+	if err != nil {
+		e.Outcome = authpb.AuditOutcome_AUDIT_OUTCOME_FAILURE
+		e.ErrorReason = string(apierrors.ReasonOf(err))
+	}
+	if r.Actor != nil {
+		if a, ok := r.Actor(ctx); ok {
+			e.ActorId, e.ActorType = a.ID, a.Type
+		}
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(requestIDKey); len(v) > 0 {
+			e.RequestId = v[0]
+		}
+	}
+	if m, ok := req.(proto.Message); ok {
+		e.Resource = Resource(fullMethod, m)
+		e.Params = Params(m)
+	}
+	return e
+}
+
+// Record stores the entry for a completed call.
+func (r *Recorder) Record(ctx context.Context, fullMethod string, req any, err error) {
+	// The call's context may already be cancelled; the entry is still wanted.
+	ctx = context.WithoutCancel(ctx)
+	if appendErr := r.Store.Append(ctx, r.Entry(ctx, fullMethod, req, err)); appendErr != nil && r.OnError != nil {
+		r.OnError(appendErr)
+	}
+}
+
+func (r *Recorder) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !r.audited(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		resp, err := handler(ctx, req)
+		r.Record(ctx, info.FullMethod, req, err)
+		return resp, err
+	}
+}
+
+// StreamInterceptor records streaming admin calls once they finish. The
+// request of server-streaming calls is the first message received.
+func (r *Recorder) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !r.audited(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		rs := &recordingStream{ServerStream: ss}
+		err := handler(srv, rs)
+		r.Record(ss.Context(), info.FullMethod, rs.first, err)
+		return err
+	}
+}
+
+type recordingStream struct {
+	grpc.ServerStream
+	first any
+}
+
+func (s *recordingStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.first == nil {
+		s.first = m
+	}
+	return err
+}
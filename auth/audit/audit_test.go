@@ -0,0 +1,232 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/options/fieldopts"
+)
+
+func TestResource(t *testing.T) {
+	tests := []struct {
+		method string
+		req    proto.Message
+		want   string
+	}{
+		{authpb.Auth_DeleteUser_FullMethodName, &authpb.DeleteRequest{UserId: "42"}, "/v1/admin/users/42"},
+		{authpb.Auth_SuspendUser_FullMethodName, &authpb.SuspendUserRequest{UserId: "42"}, "/v1/admin/users/42/suspend"},
+		{authpb.Auth_ListUsers_FullMethodName, &authpb.ListUsersRequest{}, "/v1/admin/users"},
+		{"/unknown.Service/Call", &authpb.ListUsersRequest{}, "/unknown.Service/Call"},
+	}
+	for _, tt := range tests {
+		if got := Resource(tt.method, tt.req); got != tt.want {
+			t.Errorf("Resource(%s) = %q, want %q", tt.method, got, tt.want)
+		}
+	}
+}
+
+func TestParams(t *testing.T) {
+	tests := []struct {
+		name string
+		req  proto.Message
+		want map[string]string
+	}{
+		{
+			name: "sensitive field redacted",
+			req:  &authpb.CreateUserRequest{Name: "Ann", Email: "ann@example.com", Password: "secret", IsAdmin: true},
+			want: map[string]string{
+				"name": `"Ann"`, "email": `"ann@example.com"`, "password": `"` + fieldopts.Redacted + `"`, "is_admin": "true",
+			},
+		},
+		{
+			name: "unset fields omitted",
+			req:  &authpb.SuspendUserRequest{UserId: "42"},
+			want: map[string]string{"user_id": `"42"`},
+		},
+		{name: "empty", req: &authpb.ListUsersRequest{}},
+	}
+	for _, tt := range tests {
+		if got := Params(tt.req); !maps.Equal(got, tt.want) {
+			t.Errorf("%s: Params = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func newTestRecorder() (*Recorder, *MemoryStore) {
+	store := NewMemoryStore()
+	r := NewRecorder(store, func(ctx context.Context) (Actor, bool) {
+		return Actor{ID: "admin-1", Type: authpb.SubjectType_SUBJECT_TYPE_USER}, true
+	})
+	r.Now = func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) }
+	return r, store
+}
+
+func entries(t *testing.T, store *MemoryStore) []*authpb.AuditEntry {
+	t.Helper()
+
+	resp, err := store.List(context.Background(), &authpb.ListAuditEntriesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.GetEntries()
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		err         error
+		wantEntry   bool
+		wantOutcome authpb.AuditOutcome
+		wantCode    string
+		wantReason  string
+	}{
+		{
+			name:        "admin success",
+			method:      authpb.Auth_SuspendUser_FullMethodName,
+			wantEntry:   true,
+			wantOutcome: authpb.AuditOutcome_AUDIT_OUTCOME_SUCCESS,
+			wantCode:    "OK",
+		},
+		{
+			name:        "admin failure",
+			method:      authpb.Auth_SuspendUser_FullMethodName,
+			err:         apierrors.New(codes.NotFound, apierrors.ReasonUserNotFound, "user not found"),
+			wantEntry:   true,
+			wantOutcome: authpb.AuditOutcome_AUDIT_OUTCOME_FAILURE,
+			wantCode:    "NotFound",
+			wantReason:  string(apierrors.ReasonUserNotFound),
+		},
+		{name: "user method", method: authpb.Auth_ChangePassword_FullMethodName},
+		{name: "public method", method: authpb.Auth_Login_FullMethodName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, store := newTestRecorder()
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-id", "req-1"))
+			req := &authpb.SuspendUserRequest{UserId: "42", Reason: "spam"}
+
+			_, err := r.UnaryInterceptor()(ctx, req, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(context.Context, any) (any, error) { return nil, tt.err })
+			if !errors.Is(err, tt.err) {
+				t.Errorf("interceptor returned %v, want the handler's %v", err, tt.err)
+			}
+
+			got := entries(t, store)
+			if !tt.wantEntry {
+				if len(got) != 0 {
+					t.Errorf("recorded %v for an unaudited method", got)
+				}
+				return
+			}
+			if len(got) != 1 {
+				t.Fatalf("recorded %d entries, want 1", len(got))
+			}
+			e := got[0]
+			if e.GetOutcome() != tt.wantOutcome || e.GetStatusCode() != tt.wantCode || e.GetErrorReason() != tt.wantReason {
+				t.Errorf("outcome %v %s %q, want %v %s %q", e.GetOutcome(), e.GetStatusCode(), e.GetErrorReason(),
+					tt.wantOutcome, tt.wantCode, tt.wantReason)
+			}
+			if e.GetActorId() != "admin-1" || e.GetActorType() != authpb.SubjectType_SUBJECT_TYPE_USER ||
+				e.GetRequestId() != "req-1" || e.GetResource() != "/v1/admin/users/42/suspend" ||
+				e.GetParams()["reason"] != `"spam"` || e.GetId() == "" || !e.GetTime().AsTime().Equal(r.now()) {
+				t.Errorf("entry = %v", e)
+			}
+		})
+	}
+}
+
+type failingStore struct{ MemoryStore }
+
+var errStoreDown = errors.New("store down")
+
+func (*failingStore) Append(context.Context, *authpb.AuditEntry) error { return errStoreDown }
+
+func TestRecordStoreFailure(t *testing.T) {
+	var reported error
+	r := NewRecorder(&failingStore{}, nil)
+	r.OnError = func(err error) { reported = err }
+
+	resp, err := r.UnaryInterceptor()(context.Background(), &authpb.SuspendUserRequest{},
+		&grpc.UnaryServerInfo{FullMethod: authpb.Auth_SuspendUser_FullMethodName},
+		func(context.Context, any) (any, error) { return "ok", nil })
+	if err != nil || resp != "ok" {
+		t.Errorf("interceptor = %v, %v; a store failure must not fail the call", resp, err)
+	}
+	if !errors.Is(reported, errStoreDown) {
+		t.Errorf("OnError got %v, want %v", reported, errStoreDown)
+	}
+}
+
+// fakeStream delivers msgs to RecvMsg in order and fails once they run out.
+type fakeStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	msgs []*authpb.StreamUserEventsRequest
+}
+
+func (s *fakeStream) Context() context.Context { return s.ctx }
+
+func (s *fakeStream) RecvMsg(m any) error {
+	if len(s.msgs) == 0 {
+		return status.Error(codes.Canceled, "done")
+	}
+	proto.Merge(m.(proto.Message), s.msgs[0])
+	s.msgs = s.msgs[1:]
+	return nil
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	tests := []struct {
+		name      string
+		audited   bool
+		wantEntry bool
+	}{
+		{"audited", true, true},
+		{"not audited", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, store := newTestRecorder()
+			r.Audited = func(string) bool { return tt.audited }
+			ss := &fakeStream{ctx: context.Background(), msgs: []*authpb.StreamUserEventsRequest{
+				{Types: []string{"first"}}, {Types: []string{"second"}},
+			}}
+
+			err := r.StreamInterceptor()(nil, ss, &grpc.StreamServerInfo{FullMethod: authpb.Auth_StreamUserEvents_FullMethodName},
+				func(_ any, stream grpc.ServerStream) error {
+					for range 2 {
+						if err := stream.RecvMsg(&authpb.StreamUserEventsRequest{}); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := entries(t, store)
+			if !tt.wantEntry {
+				if len(got) != 0 {
+					t.Errorf("recorded %v for an unaudited method", got)
+				}
+				return
+			}
+			if len(got) != 1 || got[0].GetParams()["types"] != `["first"]` {
+				t.Errorf("entries = %v, want one with the first request", got)
+			}
+		})
+	}
+}
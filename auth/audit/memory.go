@@ -0,0 +1,104 @@
+package audit
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+// MemoryStore is a Store for tests and single-process setups. Page tokens
+// are offsets into the newest-first result.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	entries []*authpb.AuditEntry
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+func (s *MemoryStore) Append(ctx context.Context, e *authpb.AuditEntry) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = append(s.entries, proto.Clone(e).(*authpb.AuditEntry))
+	return nil
+}
+
+func (s *MemoryStore) List(ctx context.Context, req *authpb.ListAuditEntriesRequest) (*authpb.ListAuditEntriesResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	size := int(req.GetPageSize())
+	switch {
+	case size < 0:
+		return nil, apierrors.Validation("invalid page size", apierrors.FieldViolation{Field: "page_size", Description: "must not be negative"})
+	case size == 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+	offset := 0
+	if t := req.GetPageToken(); t != "" {
+		n, err := strconv.Atoi(t)
+		if err != nil || n < 0 {
+			return nil, apierrors.Validation("invalid page token", apierrors.FieldViolation{Field: "page_token", Description: "must come from a previous response"})
+		}
+		offset = n
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matched []*authpb.AuditEntry
+	for i := len(s.entries) - 1; i >= 0; i-- {
+		if e := s.entries[i]; match(req, e) {
+			matched = append(matched, e)
+		}
+	}
+
+	resp := &authpb.ListAuditEntriesResponse{}
+	if offset >= len(matched) {
+		return resp, nil
+	}
+	end := min(offset+size, len(matched))
+	for _, e := range matched[offset:end] {
+		resp.Entries = append(resp.Entries, proto.Clone(e).(*authpb.AuditEntry))
+	}
+	if end < len(matched) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return resp, nil
+}
+
+func match(req *authpb.ListAuditEntriesRequest, e *authpb.AuditEntry) bool {
+	if req.GetActorId() != "" && e.GetActorId() != req.GetActorId() {
+		return false
+	}
+	if req.GetMethod() != "" && e.GetMethod() != req.GetMethod() {
+		return false
+	}
+	if !strings.HasPrefix(e.GetResource(), req.GetResource()) {
+		return false
+	}
+	if req.GetSince() != nil && e.GetTime().AsTime().Before(req.GetSince().AsTime()) {
+		return false
+	}
+	return true
+}
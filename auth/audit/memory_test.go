@@ -0,0 +1,115 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+)
+
+func TestMemoryStoreList(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewMemoryStore()
+	// e0..e5, oldest first: admins a1 and a2 alternate, users 1..3 are each
+	// touched twice.
+	for i := range 6 {
+		e := &authpb.AuditEntry{
+			Id:       fmt.Sprintf("e%d", i),
+			ActorId:  fmt.Sprintf("a%d", i%2+1),
+			Method:   authpb.Auth_UpdateUser_FullMethodName,
+			Resource: fmt.Sprintf("/v1/admin/users/%d", i/2+1),
+			Time:     timestamppb.New(base.Add(time.Duration(i) * time.Hour)),
+		}
+		if i == 5 {
+			e.Method = authpb.Auth_DeleteUser_FullMethodName
+		}
+		if err := s.Append(context.Background(), e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		req      *authpb.ListAuditEntriesRequest
+		wantIDs  []string
+		wantNext string
+		wantCode codes.Code
+	}{
+		{name: "newest first", req: &authpb.ListAuditEntriesRequest{}, wantIDs: []string{"e5", "e4", "e3", "e2", "e1", "e0"}},
+		{name: "first page", req: &authpb.ListAuditEntriesRequest{PageSize: 4}, wantIDs: []string{"e5", "e4", "e3", "e2"}, wantNext: "4"},
+		{name: "last page", req: &authpb.ListAuditEntriesRequest{PageSize: 4, PageToken: "4"}, wantIDs: []string{"e1", "e0"}},
+		{name: "past the end", req: &authpb.ListAuditEntriesRequest{PageToken: "10"}},
+		{name: "actor", req: &authpb.ListAuditEntriesRequest{ActorId: "a1"}, wantIDs: []string{"e4", "e2", "e0"}},
+		{
+			name:    "method",
+			req:     &authpb.ListAuditEntriesRequest{Method: authpb.Auth_DeleteUser_FullMethodName},
+			wantIDs: []string{"e5"},
+		},
+		{name: "resource prefix", req: &authpb.ListAuditEntriesRequest{Resource: "/v1/admin/users/2"}, wantIDs: []string{"e3", "e2"}},
+		{
+			name:    "since",
+			req:     &authpb.ListAuditEntriesRequest{Since: timestamppb.New(base.Add(4 * time.Hour))},
+			wantIDs: []string{"e5", "e4"},
+		},
+		{
+			name:     "filters with pages",
+			req:      &authpb.ListAuditEntriesRequest{ActorId: "a2", PageSize: 2},
+			wantIDs:  []string{"e5", "e3"},
+			wantNext: "2",
+		},
+		{name: "negative page size", req: &authpb.ListAuditEntriesRequest{PageSize: -1}, wantCode: codes.InvalidArgument},
+		{name: "bad page token", req: &authpb.ListAuditEntriesRequest{PageToken: "x"}, wantCode: codes.InvalidArgument},
+		{name: "negative page token", req: &authpb.ListAuditEntriesRequest{PageToken: "-1"}, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.List(context.Background(), tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("List = %v, want %v", err, tt.wantCode)
+			}
+			var ids []string
+			for _, e := range resp.GetEntries() {
+				ids = append(ids, e.GetId())
+			}
+			if !slices.Equal(ids, tt.wantIDs) {
+				t.Errorf("entries = %v, want %v", ids, tt.wantIDs)
+			}
+			if resp.GetNextPageToken() != tt.wantNext {
+				t.Errorf("NextPageToken = %q, want %q", resp.GetNextPageToken(), tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestMemoryStoreCopies(t *testing.T) {
+	s := NewMemoryStore()
+	e := &authpb.AuditEntry{Id: "e0", ActorId: "a1"}
+	if err := s.Append(context.Background(), e); err != nil {
+		t.Fatal(err)
+	}
+	e.ActorId = "changed"
+
+	resp, err := s.List(context.Background(), &authpb.ListAuditEntriesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.GetEntries()[0].Id = "changed"
+
+	again, _ := s.List(context.Background(), &authpb.ListAuditEntriesRequest{})
+	if got := again.GetEntries()[0]; got.GetId() != "e0" || got.GetActorId() != "a1" {
+		t.Errorf("stored entry = %v, want it unaffected by callers", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := s.Append(ctx, e); err == nil {
+		t.Error("Append with a cancelled context succeeded")
+	}
+}
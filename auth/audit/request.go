@@ -0,0 +1,87 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/JunBSer/services_proto/options/fieldopts"
+	"github.com/JunBSer/services_proto/options/methodopts"
+)
+
+// Params returns the populated fields of req keyed by proto field name, each
+// as a JSON value. It describes the request as sent, not how the resource
+// changed. Fields annotated (auth_options.sensitive) are redacted at any
+// depth.
+func Params(req proto.Message) map[string]string {
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(fieldopts.Redact(req))
+	if err != nil {
+		return nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil || len(fields) == 0 {
+		return nil
+	}
+
+	params := make(map[string]string, len(fields))
+	for k, v := range fields {
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, v); err != nil {
+			continue
+		}
+		params[k] = compact.String()
+	}
+	return params
+}
+
+var pathVar = regexp.MustCompile(`\{([^}=]+)(=[^}]*)?\}`)
+
+// Resource returns the HTTP path of the method with its variables filled in
+// from req, e.g. /v1/admin/users/42 for DeleteUser. Methods without an HTTP
+// binding are identified by their full method name.
+func Resource(fullMethod string, req proto.Message) string {
+	rule, ok := methodopts.HTTPRule(fullMethod)
+	if !ok {
+		return fullMethod
+	}
+	path := rule.GetGet() + rule.GetPut() + rule.GetPost() + rule.GetDelete() + rule.GetPatch() + rule.GetCustom().GetPath()
+	if path == "" {
+		return fullMethod
+	}
+	return pathVar.ReplaceAllStringFunc(path, func(v string) string {
+		field := pathVar.FindStringSubmatch(v)[1]
+		if val, ok := fieldValue(req.ProtoReflect(), field); ok {
+			return val
+		}
+		return v
+	})
+}
+
+// fieldValue resolves a dotted field path such as "room.hotel_id".
+func fieldValue(m protoreflect.Message, path string) (string, bool) {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil || fd.IsList() || fd.IsMap() {
+			return "", false
+		}
+		if i < len(names)-1 {
+			if fd.Message() == nil || !m.Has(fd) {
+				return "", false
+			}
+			m = m.Get(fd).Message()
+			continue
+		}
+		if fd.Message() != nil {
+			return "", false
+		}
+		return fmt.Sprint(m.Get(fd).Interface()), true
+	}
+	return "", false
+}
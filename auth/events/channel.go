@@ -0,0 +1,38 @@
+package events
+
+import (
+	"context"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/eventbus"
+)
+
+// ChannelBus is an in-process Publisher and Subscriber backed by an
+// eventbus.Bus. Publish never blocks: a subscriber whose buffer is full is
+// disconnected with ErrSlowSubscriber.
+type ChannelBus struct {
+	bus *eventbus.Bus[*authpb.UserEvent]
+}
+
+// NewChannelBus returns a bus whose subscriber channels hold up to buffer
+// undelivered events.
+func NewChannelBus(buffer int) *ChannelBus {
+	return &ChannelBus{bus: eventbus.New[*authpb.UserEvent](buffer)}
+}
+
+func (b *ChannelBus) Publish(_ context.Context, e *authpb.UserEvent) error {
+	if err := validate(e); err != nil {
+		return err
+	}
+	return b.bus.Publish(e)
+}
+
+func (b *ChannelBus) Subscribe(ctx context.Context, filter Filter) (*Subscription, error) {
+	return b.bus.Subscribe(ctx, filter.Match)
+}
+
+// Close ends every subscription. Later calls to Publish and Subscribe fail
+// with ErrClosed.
+func (b *ChannelBus) Close() error {
+	return b.bus.Close()
+}
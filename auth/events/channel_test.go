@@ -0,0 +1,98 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+)
+
+func TestChannelBus(t *testing.T) {
+	updated := func(t *testing.T) *authpb.UserEvent {
+		e, err := New("/test", &authpb.UserUpdated{}, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return e
+	}
+	deleted := func(t *testing.T) *authpb.UserEvent {
+		e, err := New("/test", &authpb.UserDeleted{}, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return e
+	}
+
+	tests := []struct {
+		name      string
+		buffer    int
+		filter    Filter
+		publish   []func(*testing.T) *authpb.UserEvent
+		wantTypes []string
+		wantErr   error
+	}{
+		{
+			name:      "zero filter matches everything",
+			buffer:    4,
+			publish:   []func(*testing.T) *authpb.UserEvent{updated, deleted},
+			wantTypes: []string{TypeUpdated, TypeDeleted},
+		},
+		{
+			name:      "filters by type",
+			buffer:    4,
+			filter:    Filter{Types: []string{TypeDeleted}},
+			publish:   []func(*testing.T) *authpb.UserEvent{updated, deleted, updated},
+			wantTypes: []string{TypeDeleted},
+		},
+		{
+			name:      "slow subscriber is disconnected",
+			buffer:    1,
+			publish:   []func(*testing.T) *authpb.UserEvent{updated, deleted},
+			wantTypes: []string{TypeUpdated},
+			wantErr:   ErrSlowSubscriber,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewChannelBus(tt.buffer)
+			defer b.Close()
+
+			s, err := b.Subscribe(context.Background(), tt.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, e := range tt.publish {
+				if err := b.Publish(context.Background(), e(t)); err != nil {
+					t.Fatalf("Publish: %v", err)
+				}
+			}
+			b.Close()
+
+			var types []string
+			for e := range s.C {
+				types = append(types, e.GetType())
+			}
+			if !slices.Equal(types, tt.wantTypes) {
+				t.Errorf("received %v, want %v", types, tt.wantTypes)
+			}
+			if !errors.Is(s.Err(), tt.wantErr) {
+				t.Errorf("Err = %v, want %v", s.Err(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestChannelBusRejects(t *testing.T) {
+	b := NewChannelBus(1)
+	if err := b.Publish(context.Background(), &authpb.UserEvent{}); !errors.Is(err, ErrInvalidEvent) {
+		t.Errorf("Publish(invalid) = %v, want ErrInvalidEvent", err)
+	}
+	b.Close()
+	e, _ := New("/test", &authpb.UserUpdated{}, time.Now())
+	if err := b.Publish(context.Background(), e); !errors.Is(err, ErrClosed) {
+		t.Errorf("Publish after Close = %v, want ErrClosed", err)
+	}
+}
@@ -0,0 +1,150 @@
+// Package events publishes and consumes user account lifecycle events. Events
+// are authpb.UserEvent messages: CloudEvents 1.0 envelopes whose data is one
+// of UserRegistered, UserUpdated, UserDeleted, UserRoleChanged or
+// PasswordChanged.
+package events
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/eventbus"
+	"github.com/JunBSer/services_proto/outbox"
+)
+
+// CloudEvents attributes shared by every user event.
+const (
+	SpecVersion     = "1.0"
+	DataContentType = "application/protobuf"
+)
+
+// Event types, carried in the envelope's type attribute.
+const (
+	TypeRegistered      = "user.registered"
+	TypeUpdated         = "user.updated"
+	TypeDeleted         = "user.deleted"
+	TypeRoleChanged     = "user.role_changed"
+	TypePasswordChanged = "user.password_changed"
+	TypeStatusChanged   = "user.status_changed"
+)
+
+// CloudEvents binary-mode header names set on outbox records.
+const (
+	HeaderType    = "ce-type"
+	HeaderSource  = "ce-source"
+	HeaderSubject = "ce-subject"
+)
+
+var (
+	ErrInvalidEvent = errors.New("events: event has no id or type")
+	ErrClosed       = eventbus.ErrClosed
+	// ErrSlowSubscriber ends a subscription that stopped keeping up with
+	// the published events.
+	ErrSlowSubscriber = eventbus.ErrSlowSubscriber
+)
+
+// Publisher delivers events to subscribers. Implementations must be safe for
+// concurrent use.
+type Publisher interface {
+	Publish(ctx context.Context, e *authpb.UserEvent) error
+}
+
+// Subscriber streams events matching filter until ctx is done, at which point
+// the subscription's channel is closed.
+type Subscriber interface {
+	Subscribe(ctx context.Context, filter Filter) (*Subscription, error)
+}
+
+// Subscription is a stream of events from a Subscriber.
+type Subscription = eventbus.Subscription[*authpb.UserEvent]
+
+// Filter selects events by type. The zero value matches everything.
+type Filter struct {
+	Types []string
+}
+
+func (f Filter) Match(e *authpb.UserEvent) bool {
+	return len(f.Types) == 0 || slices.Contains(f.Types, e.GetType())
+}
+
+// New wraps data in an envelope from source. data must be one of the user
+// event payload messages.
+func New(source string, data proto.Message, at time.Time) (*authpb.UserEvent, error) {
+	e := &authpb.UserEvent{
+		Id:              rand.Text(),
+		Source:          source,
+		SpecVersion:     SpecVersion,
+		Time:            timestamppb.New(at),
+		DataContentType: DataContentType,
+	}
+
+	switch d := data.(type) {
+	case *authpb.UserRegistered:
+		e.Type, e.Subject = TypeRegistered, d.GetUserId().GetValue()
+		e.Data = &authpb.UserEvent_Registered{Registered: d}
+	case *authpb.UserUpdated:
+		e.Type, e.Subject = TypeUpdated, d.GetUserId().GetValue()
+		e.Data = &authpb.UserEvent_Updated{Updated: d}
+	case *authpb.UserDeleted:
+		e.Type, e.Subject = TypeDeleted, d.GetUserId().GetValue()
+		e.Data = &authpb.UserEvent_Deleted{Deleted: d}
+	case *authpb.UserRoleChanged:
+		e.Type, e.Subject = TypeRoleChanged, d.GetUserId().GetValue()
+		e.Data = &authpb.UserEvent_RoleChanged{RoleChanged: d}
+	case *authpb.PasswordChanged:
+		e.Type, e.Subject = TypePasswordChanged, d.GetUserId().GetValue()
+		e.Data = &authpb.UserEvent_PasswordChanged{PasswordChanged: d}
+	case *authpb.UserStatusChanged:
+		e.Type, e.Subject = TypeStatusChanged, d.GetUserId().GetValue()
+		e.Data = &authpb.UserEvent_StatusChanged{StatusChanged: d}
+	default:
+		return nil, fmt.Errorf("events: unsupported payload %T", data)
+	}
+	return e, nil
+}
+
+func validate(e *authpb.UserEvent) error {
+	if e.GetId() == "" || e.GetType() == "" {
+		return ErrInvalidEvent
+	}
+	return nil
+}
+
+// Serve implements the StreamUserEvents RPC on top of sub. A client that
+// falls behind gets ErrSlowSubscriber.
+func Serve(req *authpb.StreamUserEventsRequest, stream authpb.Auth_StreamUserEventsServer, sub Subscriber) error {
+	ctx := stream.Context()
+	s, err := sub.Subscribe(ctx, Filter{Types: req.GetTypes()})
+	if err != nil {
+		return err
+	}
+	for e := range s.C {
+		if err := stream.Send(e); err != nil {
+			return err
+		}
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// Record serializes e for the outbox, reusing the event ID as the record ID.
+func Record(e *authpb.UserEvent) (outbox.Record, error) {
+	if err := validate(e); err != nil {
+		return outbox.Record{}, err
+	}
+	return outbox.NewRecord(e.GetId(), e, map[string]string{
+		HeaderType:    e.GetType(),
+		HeaderSource:  e.GetSource(),
+		HeaderSubject: e.GetSubject(),
+	})
+}
@@ -10,6 +10,7 @@ import (
 	_ "github.com/JunBSer/services_proto/options/auth_options/gen/go"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -25,6 +26,361 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MFAMethod int32
+
+const (
+	MFAMethod_MFA_METHOD_UNSPECIFIED   MFAMethod = 0
+	MFAMethod_MFA_METHOD_TOTP          MFAMethod = 1
+	MFAMethod_MFA_METHOD_RECOVERY_CODE MFAMethod = 2
+)
+
+// Enum value maps for MFAMethod.
+var (
+	MFAMethod_name = map[int32]string{
+		0: "MFA_METHOD_UNSPECIFIED",
+		1: "MFA_METHOD_TOTP",
+		2: "MFA_METHOD_RECOVERY_CODE",
+	}
+	MFAMethod_value = map[string]int32{
+		"MFA_METHOD_UNSPECIFIED":   0,
+		"MFA_METHOD_TOTP":          1,
+		"MFA_METHOD_RECOVERY_CODE": 2,
+	}
+)
+
+func (x MFAMethod) Enum() *MFAMethod {
+	p := new(MFAMethod)
+	*p = x
+	return p
+}
+
+func (x MFAMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MFAMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_auth_proto_enumTypes[0].Descriptor()
+}
+
+func (MFAMethod) Type() protoreflect.EnumType {
+	return &file_proto_auth_proto_enumTypes[0]
+}
+
+func (x MFAMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MFAMethod.Descriptor instead.
+func (MFAMethod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{0}
+}
+
+type SubjectType int32
+
+const (
+	SubjectType_SUBJECT_TYPE_UNSPECIFIED SubjectType = 0
+	SubjectType_SUBJECT_TYPE_USER        SubjectType = 1
+	SubjectType_SUBJECT_TYPE_SERVICE     SubjectType = 2
+)
+
+// Enum value maps for SubjectType.
+var (
+	SubjectType_name = map[int32]string{
+		0: "SUBJECT_TYPE_UNSPECIFIED",
+		1: "SUBJECT_TYPE_USER",
+		2: "SUBJECT_TYPE_SERVICE",
+	}
+	SubjectType_value = map[string]int32{
+		"SUBJECT_TYPE_UNSPECIFIED": 0,
+		"SUBJECT_TYPE_USER":        1,
+		"SUBJECT_TYPE_SERVICE":     2,
+	}
+)
+
+func (x SubjectType) Enum() *SubjectType {
+	p := new(SubjectType)
+	*p = x
+	return p
+}
+
+func (x SubjectType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubjectType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_auth_proto_enumTypes[1].Descriptor()
+}
+
+func (SubjectType) Type() protoreflect.EnumType {
+	return &file_proto_auth_proto_enumTypes[1]
+}
+
+func (x SubjectType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubjectType.Descriptor instead.
+func (SubjectType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{1}
+}
+
+type TokenType int32
+
+const (
+	TokenType_TOKEN_TYPE_UNSPECIFIED TokenType = 0
+	TokenType_TOKEN_TYPE_ACCESS      TokenType = 1
+	TokenType_TOKEN_TYPE_REFRESH     TokenType = 2
+)
+
+// Enum value maps for TokenType.
+var (
+	TokenType_name = map[int32]string{
+		0: "TOKEN_TYPE_UNSPECIFIED",
+		1: "TOKEN_TYPE_ACCESS",
+		2: "TOKEN_TYPE_REFRESH",
+	}
+	TokenType_value = map[string]int32{
+		"TOKEN_TYPE_UNSPECIFIED": 0,
+		"TOKEN_TYPE_ACCESS":      1,
+		"TOKEN_TYPE_REFRESH":     2,
+	}
+)
+
+func (x TokenType) Enum() *TokenType {
+	p := new(TokenType)
+	*p = x
+	return p
+}
+
+func (x TokenType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TokenType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_auth_proto_enumTypes[2].Descriptor()
+}
+
+func (TokenType) Type() protoreflect.EnumType {
+	return &file_proto_auth_proto_enumTypes[2]
+}
+
+func (x TokenType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TokenType.Descriptor instead.
+func (TokenType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{2}
+}
+
+type UserRole int32
+
+const (
+	UserRole_USER_ROLE_UNSPECIFIED UserRole = 0
+	UserRole_USER_ROLE_USER        UserRole = 1
+	UserRole_USER_ROLE_ADMIN       UserRole = 2
+)
+
+// Enum value maps for UserRole.
+var (
+	UserRole_name = map[int32]string{
+		0: "USER_ROLE_UNSPECIFIED",
+		1: "USER_ROLE_USER",
+		2: "USER_ROLE_ADMIN",
+	}
+	UserRole_value = map[string]int32{
+		"USER_ROLE_UNSPECIFIED": 0,
+		"USER_ROLE_USER":        1,
+		"USER_ROLE_ADMIN":       2,
+	}
+)
+
+func (x UserRole) Enum() *UserRole {
+	p := new(UserRole)
+	*p = x
+	return p
+}
+
+func (x UserRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_auth_proto_enumTypes[3].Descriptor()
+}
+
+func (UserRole) Type() protoreflect.EnumType {
+	return &file_proto_auth_proto_enumTypes[3]
+}
+
+func (x UserRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserRole.Descriptor instead.
+func (UserRole) EnumDescriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{3}
+}
+
+type UserStatus int32
+
+const (
+	UserStatus_USER_STATUS_UNSPECIFIED UserStatus = 0
+	UserStatus_USER_STATUS_ACTIVE      UserStatus = 1
+	// Disabled by an admin until reactivated.
+	UserStatus_USER_STATUS_SUSPENDED UserStatus = 2
+	// Registered but has not confirmed their email address yet.
+	UserStatus_USER_STATUS_PENDING_VERIFICATION UserStatus = 3
+	UserStatus_USER_STATUS_DELETED              UserStatus = 4
+)
+
+// Enum value maps for UserStatus.
+var (
+	UserStatus_name = map[int32]string{
+		0: "USER_STATUS_UNSPECIFIED",
+		1: "USER_STATUS_ACTIVE",
+		2: "USER_STATUS_SUSPENDED",
+		3: "USER_STATUS_PENDING_VERIFICATION",
+		4: "USER_STATUS_DELETED",
+	}
+	UserStatus_value = map[string]int32{
+		"USER_STATUS_UNSPECIFIED":          0,
+		"USER_STATUS_ACTIVE":               1,
+		"USER_STATUS_SUSPENDED":            2,
+		"USER_STATUS_PENDING_VERIFICATION": 3,
+		"USER_STATUS_DELETED":              4,
+	}
+)
+
+func (x UserStatus) Enum() *UserStatus {
+	p := new(UserStatus)
+	*p = x
+	return p
+}
+
+func (x UserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_auth_proto_enumTypes[4].Descriptor()
+}
+
+func (UserStatus) Type() protoreflect.EnumType {
+	return &file_proto_auth_proto_enumTypes[4]
+}
+
+func (x UserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserStatus.Descriptor instead.
+func (UserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{4}
+}
+
+type PasswordChangeMethod int32
+
+const (
+	PasswordChangeMethod_PASSWORD_CHANGE_METHOD_UNSPECIFIED PasswordChangeMethod = 0
+	PasswordChangeMethod_PASSWORD_CHANGE_METHOD_CHANGE      PasswordChangeMethod = 1
+	PasswordChangeMethod_PASSWORD_CHANGE_METHOD_RESET       PasswordChangeMethod = 2
+	PasswordChangeMethod_PASSWORD_CHANGE_METHOD_ADMIN       PasswordChangeMethod = 3
+)
+
+// Enum value maps for PasswordChangeMethod.
+var (
+	PasswordChangeMethod_name = map[int32]string{
+		0: "PASSWORD_CHANGE_METHOD_UNSPECIFIED",
+		1: "PASSWORD_CHANGE_METHOD_CHANGE",
+		2: "PASSWORD_CHANGE_METHOD_RESET",
+		3: "PASSWORD_CHANGE_METHOD_ADMIN",
+	}
+	PasswordChangeMethod_value = map[string]int32{
+		"PASSWORD_CHANGE_METHOD_UNSPECIFIED": 0,
+		"PASSWORD_CHANGE_METHOD_CHANGE":      1,
+		"PASSWORD_CHANGE_METHOD_RESET":       2,
+		"PASSWORD_CHANGE_METHOD_ADMIN":       3,
+	}
+)
+
+func (x PasswordChangeMethod) Enum() *PasswordChangeMethod {
+	p := new(PasswordChangeMethod)
+	*p = x
+	return p
+}
+
+func (x PasswordChangeMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PasswordChangeMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_auth_proto_enumTypes[5].Descriptor()
+}
+
+func (PasswordChangeMethod) Type() protoreflect.EnumType {
+	return &file_proto_auth_proto_enumTypes[5]
+}
+
+func (x PasswordChangeMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PasswordChangeMethod.Descriptor instead.
+func (PasswordChangeMethod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{5}
+}
+
+// Audit log
+type AuditOutcome int32
+
+const (
+	AuditOutcome_AUDIT_OUTCOME_UNSPECIFIED AuditOutcome = 0
+	AuditOutcome_AUDIT_OUTCOME_SUCCESS     AuditOutcome = 1
+	AuditOutcome_AUDIT_OUTCOME_FAILURE     AuditOutcome = 2
+)
+
+// Enum value maps for AuditOutcome.
+var (
+	AuditOutcome_name = map[int32]string{
+		0: "AUDIT_OUTCOME_UNSPECIFIED",
+		1: "AUDIT_OUTCOME_SUCCESS",
+		2: "AUDIT_OUTCOME_FAILURE",
+	}
+	AuditOutcome_value = map[string]int32{
+		"AUDIT_OUTCOME_UNSPECIFIED": 0,
+		"AUDIT_OUTCOME_SUCCESS":     1,
+		"AUDIT_OUTCOME_FAILURE":     2,
+	}
+)
+
+func (x AuditOutcome) Enum() *AuditOutcome {
+	p := new(AuditOutcome)
+	*p = x
+	return p
+}
+
+func (x AuditOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_auth_proto_enumTypes[6].Descriptor()
+}
+
+func (AuditOutcome) Type() protoreflect.EnumType {
+	return &file_proto_auth_proto_enumTypes[6]
+}
+
+func (x AuditOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditOutcome.Descriptor instead.
+func (AuditOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{6}
+}
+
 type UUID struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
@@ -235,8 +591,10 @@ func (x *LoginRequest) GetPassword() string {
 }
 
 type LoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tokens        *JWTPair               `protobuf:"bytes,1,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty when mfa_challenge is set.
+	Tokens        *JWTPair      `protobuf:"bytes,1,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	MfaChallenge  *MFAChallenge `protobuf:"bytes,2,opt,name=mfa_challenge,json=mfaChallenge,proto3" json:"mfa_challenge,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -278,6 +636,73 @@ func (x *LoginResponse) GetTokens() *JWTPair {
 	return nil
 }
 
+func (x *LoginResponse) GetMfaChallenge() *MFAChallenge {
+	if x != nil {
+		return x.MfaChallenge
+	}
+	return nil
+}
+
+type MFAChallenge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken      string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Methods       []MFAMethod            `protobuf:"varint,3,rep,packed,name=methods,proto3,enum=proto.MFAMethod" json:"methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MFAChallenge) Reset() {
+	*x = MFAChallenge{}
+	mi := &file_proto_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFAChallenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFAChallenge) ProtoMessage() {}
+
+func (x *MFAChallenge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFAChallenge.ProtoReflect.Descriptor instead.
+func (*MFAChallenge) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MFAChallenge) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *MFAChallenge) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *MFAChallenge) GetMethods() []MFAMethod {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
 type RegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -289,7 +714,7 @@ type RegisterRequest struct {
 
 func (x *RegisterRequest) Reset() {
 	*x = RegisterRequest{}
-	mi := &file_proto_auth_proto_msgTypes[5]
+	mi := &file_proto_auth_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -301,7 +726,7 @@ func (x *RegisterRequest) String() string {
 func (*RegisterRequest) ProtoMessage() {}
 
 func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[5]
+	mi := &file_proto_auth_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -314,7 +739,7 @@ func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
 func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{5}
+	return file_proto_auth_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RegisterRequest) GetName() string {
@@ -347,7 +772,7 @@ type RegisterResponse struct {
 
 func (x *RegisterResponse) Reset() {
 	*x = RegisterResponse{}
-	mi := &file_proto_auth_proto_msgTypes[6]
+	mi := &file_proto_auth_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +784,7 @@ func (x *RegisterResponse) String() string {
 func (*RegisterResponse) ProtoMessage() {}
 
 func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[6]
+	mi := &file_proto_auth_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +797,7 @@ func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
 func (*RegisterResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{6}
+	return file_proto_auth_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RegisterResponse) GetUserId() *UUID {
@@ -391,7 +816,7 @@ type LogoutRequest struct {
 
 func (x *LogoutRequest) Reset() {
 	*x = LogoutRequest{}
-	mi := &file_proto_auth_proto_msgTypes[7]
+	mi := &file_proto_auth_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +828,7 @@ func (x *LogoutRequest) String() string {
 func (*LogoutRequest) ProtoMessage() {}
 
 func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[7]
+	mi := &file_proto_auth_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +841,7 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
 func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{7}
+	return file_proto_auth_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *LogoutRequest) GetRefreshToken() string {
@@ -435,7 +860,7 @@ type LogoutResponse struct {
 
 func (x *LogoutResponse) Reset() {
 	*x = LogoutResponse{}
-	mi := &file_proto_auth_proto_msgTypes[8]
+	mi := &file_proto_auth_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -447,7 +872,7 @@ func (x *LogoutResponse) String() string {
 func (*LogoutResponse) ProtoMessage() {}
 
 func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[8]
+	mi := &file_proto_auth_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,7 +885,7 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
 func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{8}
+	return file_proto_auth_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *LogoutResponse) GetStatus() *Status {
@@ -481,7 +906,7 @@ type UpdateProfileRequest struct {
 
 func (x *UpdateProfileRequest) Reset() {
 	*x = UpdateProfileRequest{}
-	mi := &file_proto_auth_proto_msgTypes[9]
+	mi := &file_proto_auth_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -493,7 +918,7 @@ func (x *UpdateProfileRequest) String() string {
 func (*UpdateProfileRequest) ProtoMessage() {}
 
 func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[9]
+	mi := &file_proto_auth_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -506,7 +931,7 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{9}
+	return file_proto_auth_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateProfileRequest) GetAccessToken() string {
@@ -542,7 +967,7 @@ type ChangePasswordRequest struct {
 
 func (x *ChangePasswordRequest) Reset() {
 	*x = ChangePasswordRequest{}
-	mi := &file_proto_auth_proto_msgTypes[10]
+	mi := &file_proto_auth_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -554,7 +979,7 @@ func (x *ChangePasswordRequest) String() string {
 func (*ChangePasswordRequest) ProtoMessage() {}
 
 func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[10]
+	mi := &file_proto_auth_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +992,7 @@ func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
 func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{10}
+	return file_proto_auth_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ChangePasswordRequest) GetAccessToken() string {
@@ -600,7 +1025,7 @@ type ChangePasswordResponse struct {
 
 func (x *ChangePasswordResponse) Reset() {
 	*x = ChangePasswordResponse{}
-	mi := &file_proto_auth_proto_msgTypes[11]
+	mi := &file_proto_auth_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -612,7 +1037,7 @@ func (x *ChangePasswordResponse) String() string {
 func (*ChangePasswordResponse) ProtoMessage() {}
 
 func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[11]
+	mi := &file_proto_auth_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,7 +1050,7 @@ func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
 func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{11}
+	return file_proto_auth_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ChangePasswordResponse) GetStatus() *Status {
@@ -645,7 +1070,7 @@ type RefreshRequest struct {
 
 func (x *RefreshRequest) Reset() {
 	*x = RefreshRequest{}
-	mi := &file_proto_auth_proto_msgTypes[12]
+	mi := &file_proto_auth_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -657,7 +1082,7 @@ func (x *RefreshRequest) String() string {
 func (*RefreshRequest) ProtoMessage() {}
 
 func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[12]
+	mi := &file_proto_auth_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,7 +1095,7 @@ func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
 func (*RefreshRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{12}
+	return file_proto_auth_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RefreshRequest) GetRefreshToken() string {
@@ -689,7 +1114,7 @@ type RefreshResponse struct {
 
 func (x *RefreshResponse) Reset() {
 	*x = RefreshResponse{}
-	mi := &file_proto_auth_proto_msgTypes[13]
+	mi := &file_proto_auth_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -701,7 +1126,7 @@ func (x *RefreshResponse) String() string {
 func (*RefreshResponse) ProtoMessage() {}
 
 func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[13]
+	mi := &file_proto_auth_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,7 +1139,7 @@ func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshResponse.ProtoReflect.Descriptor instead.
 func (*RefreshResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{13}
+	return file_proto_auth_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RefreshResponse) GetTokens() *JWTPair {
@@ -733,7 +1158,7 @@ type ValidateTokenRequest struct {
 
 func (x *ValidateTokenRequest) Reset() {
 	*x = ValidateTokenRequest{}
-	mi := &file_proto_auth_proto_msgTypes[14]
+	mi := &file_proto_auth_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -745,7 +1170,7 @@ func (x *ValidateTokenRequest) String() string {
 func (*ValidateTokenRequest) ProtoMessage() {}
 
 func (x *ValidateTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[14]
+	mi := &file_proto_auth_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,7 +1183,7 @@ func (x *ValidateTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateTokenRequest.ProtoReflect.Descriptor instead.
 func (*ValidateTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{14}
+	return file_proto_auth_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ValidateTokenRequest) GetToken() string {
@@ -774,13 +1199,16 @@ type ValidateTokenResponse struct {
 	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	UserId        *UUID                  `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	IsAdmin       bool                   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	SubjectType   SubjectType            `protobuf:"varint,5,opt,name=subject_type,json=subjectType,proto3,enum=proto.SubjectType" json:"subject_type,omitempty"`
+	ClientId      string                 `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Scopes        []string               `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ValidateTokenResponse) Reset() {
 	*x = ValidateTokenResponse{}
-	mi := &file_proto_auth_proto_msgTypes[15]
+	mi := &file_proto_auth_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -792,7 +1220,7 @@ func (x *ValidateTokenResponse) String() string {
 func (*ValidateTokenResponse) ProtoMessage() {}
 
 func (x *ValidateTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[15]
+	mi := &file_proto_auth_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,7 +1233,7 @@ func (x *ValidateTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateTokenResponse.ProtoReflect.Descriptor instead.
 func (*ValidateTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{15}
+	return file_proto_auth_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ValidateTokenResponse) GetIsValid() bool {
@@ -836,32 +1264,50 @@ func (x *ValidateTokenResponse) GetIsAdmin() bool {
 	return false
 }
 
-// Admin management messages
-type CreateUserRequest struct {
+func (x *ValidateTokenResponse) GetSubjectType() SubjectType {
+	if x != nil {
+		return x.SubjectType
+	}
+	return SubjectType_SUBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *ValidateTokenResponse) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ValidateTokenResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type IntrospectTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	IsAdmin       bool                   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	TokenTypeHint TokenType              `protobuf:"varint,2,opt,name=token_type_hint,json=tokenTypeHint,proto3,enum=proto.TokenType" json:"token_type_hint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
-	mi := &file_proto_auth_proto_msgTypes[16]
+func (x *IntrospectTokenRequest) Reset() {
+	*x = IntrospectTokenRequest{}
+	mi := &file_proto_auth_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserRequest) String() string {
+func (x *IntrospectTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (*IntrospectTokenRequest) ProtoMessage() {}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[16]
+func (x *IntrospectTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,61 +1318,58 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *CreateUserRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateUserRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+// Deprecated: Use IntrospectTokenRequest.ProtoReflect.Descriptor instead.
+func (*IntrospectTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CreateUserRequest) GetPassword() string {
+func (x *IntrospectTokenRequest) GetToken() string {
 	if x != nil {
-		return x.Password
+		return x.Token
 	}
 	return ""
 }
 
-func (x *CreateUserRequest) GetIsAdmin() bool {
+func (x *IntrospectTokenRequest) GetTokenTypeHint() TokenType {
 	if x != nil {
-		return x.IsAdmin
+		return x.TokenTypeHint
 	}
-	return false
+	return TokenType_TOKEN_TYPE_UNSPECIFIED
 }
 
-type GetUserRequest struct {
+type IntrospectTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Active        bool                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	SubjectType   SubjectType            `protobuf:"varint,3,opt,name=subject_type,json=subjectType,proto3,enum=proto.SubjectType" json:"subject_type,omitempty"`
+	TokenType     TokenType              `protobuf:"varint,4,opt,name=token_type,json=tokenType,proto3,enum=proto.TokenType" json:"token_type,omitempty"`
+	Scopes        []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	SessionId     string                 `protobuf:"bytes,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ClientId      string                 `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,8,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	IssuedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Issuer        string                 `protobuf:"bytes,11,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	TokenId       string                 `protobuf:"bytes,12,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserRequest) Reset() {
-	*x = GetUserRequest{}
-	mi := &file_proto_auth_proto_msgTypes[17]
+func (x *IntrospectTokenResponse) Reset() {
+	*x = IntrospectTokenResponse{}
+	mi := &file_proto_auth_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserRequest) String() string {
+func (x *IntrospectTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRequest) ProtoMessage() {}
+func (*IntrospectTokenResponse) ProtoMessage() {}
 
-func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[17]
+func (x *IntrospectTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -937,93 +1380,119 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use IntrospectTokenResponse.ProtoReflect.Descriptor instead.
+func (*IntrospectTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetUserRequest) GetUserId() string {
+func (x *IntrospectTokenResponse) GetActive() bool {
 	if x != nil {
-		return x.UserId
+		return x.Active
+	}
+	return false
+}
+
+func (x *IntrospectTokenResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
 	}
 	return ""
 }
 
-type ListUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *IntrospectTokenResponse) GetSubjectType() SubjectType {
+	if x != nil {
+		return x.SubjectType
+	}
+	return SubjectType_SUBJECT_TYPE_UNSPECIFIED
 }
 
-func (x *ListUsersRequest) Reset() {
-	*x = ListUsersRequest{}
-	mi := &file_proto_auth_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *IntrospectTokenResponse) GetTokenType() TokenType {
+	if x != nil {
+		return x.TokenType
+	}
+	return TokenType_TOKEN_TYPE_UNSPECIFIED
 }
 
-func (x *ListUsersRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *IntrospectTokenResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
 }
 
-func (*ListUsersRequest) ProtoMessage() {}
+func (x *IntrospectTokenResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
 
-func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[18]
+func (x *IntrospectTokenResponse) GetClientId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ClientId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
-func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{18}
+func (x *IntrospectTokenResponse) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
 }
 
-func (x *ListUsersRequest) GetPage() int32 {
+func (x *IntrospectTokenResponse) GetIssuedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Page
+		return x.IssuedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *ListUsersRequest) GetLimit() int32 {
+func (x *IntrospectTokenResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Limit
+		return x.ExpiresAt
 	}
-	return 0
+	return nil
 }
 
-type ListUsersResponse struct {
+func (x *IntrospectTokenResponse) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *IntrospectTokenResponse) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+// Service accounts
+type ClientCredentialsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*UserResponse        `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersResponse) Reset() {
-	*x = ListUsersResponse{}
+func (x *ClientCredentialsRequest) Reset() {
+	*x = ClientCredentialsRequest{}
 	mi := &file_proto_auth_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersResponse) String() string {
+func (x *ClientCredentialsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersResponse) ProtoMessage() {}
+func (*ClientCredentialsRequest) ProtoMessage() {}
 
-func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+func (x *ClientCredentialsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_auth_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1035,57 +1504,56 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
-func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClientCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*ClientCredentialsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_auth_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListUsersResponse) GetUsers() []*UserResponse {
+func (x *ClientCredentialsRequest) GetClientId() string {
 	if x != nil {
-		return x.Users
+		return x.ClientId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUsersResponse) GetTotal() int32 {
+func (x *ClientCredentialsRequest) GetClientSecret() string {
 	if x != nil {
-		return x.Total
+		return x.ClientSecret
 	}
-	return 0
+	return ""
 }
 
-func (x *ListUsersResponse) GetPage() int32 {
+func (x *ClientCredentialsRequest) GetScopes() []string {
 	if x != nil {
-		return x.Page
+		return x.Scopes
 	}
-	return 0
+	return nil
 }
 
-type UpdateUserRequest struct {
+type ClientCredentialsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	IsAdmin       bool                   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
-	Password      string                 `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	TokenType     string                 `protobuf:"bytes,2,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) Reset() {
-	*x = UpdateUserRequest{}
+func (x *ClientCredentialsResponse) Reset() {
+	*x = ClientCredentialsResponse{}
 	mi := &file_proto_auth_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest) String() string {
+func (x *ClientCredentialsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequest) ProtoMessage() {}
+func (*ClientCredentialsResponse) ProtoMessage() {}
 
-func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+func (x *ClientCredentialsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_auth_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1097,67 +1565,65 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClientCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*ClientCredentialsResponse) Descriptor() ([]byte, []int) {
 	return file_proto_auth_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *UpdateUserRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *UpdateUserRequest) GetName() string {
+func (x *ClientCredentialsResponse) GetAccessToken() string {
 	if x != nil {
-		return x.Name
+		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *UpdateUserRequest) GetEmail() string {
+func (x *ClientCredentialsResponse) GetTokenType() string {
 	if x != nil {
-		return x.Email
+		return x.TokenType
 	}
 	return ""
 }
 
-func (x *UpdateUserRequest) GetIsAdmin() bool {
+func (x *ClientCredentialsResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IsAdmin
+		return x.ExpiresAt
 	}
-	return false
+	return nil
 }
 
-func (x *UpdateUserRequest) GetPassword() string {
+func (x *ClientCredentialsResponse) GetScopes() []string {
 	if x != nil {
-		return x.Password
+		return x.Scopes
 	}
-	return ""
+	return nil
 }
 
-type DeleteRequest struct {
+type ServiceAccount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Revoked       bool                   `protobuf:"varint,4,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	KeyRotatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=key_rotated_at,json=keyRotatedAt,proto3" json:"key_rotated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRequest) Reset() {
-	*x = DeleteRequest{}
+func (x *ServiceAccount) Reset() {
+	*x = ServiceAccount{}
 	mi := &file_proto_auth_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRequest) String() string {
+func (x *ServiceAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRequest) ProtoMessage() {}
+func (*ServiceAccount) ProtoMessage() {}
 
-func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+func (x *ServiceAccount) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_auth_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1169,40 +1635,3297 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServiceAccount.ProtoReflect.Descriptor instead.
+func (*ServiceAccount) Descriptor() ([]byte, []int) {
 	return file_proto_auth_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *DeleteRequest) GetUserId() string {
+func (x *ServiceAccount) GetClientId() string {
 	if x != nil {
-		return x.UserId
+		return x.ClientId
 	}
 	return ""
 }
 
-type DeleteResponse struct {
+func (x *ServiceAccount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ServiceAccount) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *ServiceAccount) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ServiceAccount) GetKeyRotatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.KeyRotatedAt
+	}
+	return nil
+}
+
+type CreateServiceAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteResponse) Reset() {
-	*x = DeleteResponse{}
+func (x *CreateServiceAccountRequest) Reset() {
+	*x = CreateServiceAccountRequest{}
 	mi := &file_proto_auth_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteResponse) String() string {
+func (x *CreateServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountRequest) ProtoMessage() {}
+
+func (x *CreateServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateServiceAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type ServiceAccountCredentials struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *ServiceAccount        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceAccountCredentials) Reset() {
+	*x = ServiceAccountCredentials{}
+	mi := &file_proto_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceAccountCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceAccountCredentials) ProtoMessage() {}
+
+func (x *ServiceAccountCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceAccountCredentials.ProtoReflect.Descriptor instead.
+func (*ServiceAccountCredentials) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ServiceAccountCredentials) GetAccount() *ServiceAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ServiceAccountCredentials) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+type RotateServiceAccountKeyRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ClientId           string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	GracePeriodSeconds int32                  `protobuf:"varint,2,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3" json:"grace_period_seconds,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RotateServiceAccountKeyRequest) Reset() {
+	*x = RotateServiceAccountKeyRequest{}
+	mi := &file_proto_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateServiceAccountKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateServiceAccountKeyRequest) ProtoMessage() {}
+
+func (x *RotateServiceAccountKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateServiceAccountKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateServiceAccountKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RotateServiceAccountKeyRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *RotateServiceAccountKeyRequest) GetGracePeriodSeconds() int32 {
+	if x != nil {
+		return x.GracePeriodSeconds
+	}
+	return 0
+}
+
+type RevokeServiceAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeServiceAccountRequest) Reset() {
+	*x = RevokeServiceAccountRequest{}
+	mi := &file_proto_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeServiceAccountRequest) ProtoMessage() {}
+
+func (x *RevokeServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*RevokeServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RevokeServiceAccountRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type RevokeServiceAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeServiceAccountResponse) Reset() {
+	*x = RevokeServiceAccountResponse{}
+	mi := &file_proto_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeServiceAccountResponse) ProtoMessage() {}
+
+func (x *RevokeServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*RevokeServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RevokeServiceAccountResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Multi-factor authentication
+type ReauthenticateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	TotpCode      string                 `protobuf:"bytes,2,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReauthenticateRequest) Reset() {
+	*x = ReauthenticateRequest{}
+	mi := &file_proto_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReauthenticateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReauthenticateRequest) ProtoMessage() {}
+
+func (x *ReauthenticateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReauthenticateRequest.ProtoReflect.Descriptor instead.
+func (*ReauthenticateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReauthenticateRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ReauthenticateRequest) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+type ReauthenticateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReauthToken   string                 `protobuf:"bytes,1,opt,name=reauth_token,json=reauthToken,proto3" json:"reauth_token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReauthenticateResponse) Reset() {
+	*x = ReauthenticateResponse{}
+	mi := &file_proto_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReauthenticateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReauthenticateResponse) ProtoMessage() {}
+
+func (x *ReauthenticateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReauthenticateResponse.ProtoReflect.Descriptor instead.
+func (*ReauthenticateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReauthenticateResponse) GetReauthToken() string {
+	if x != nil {
+		return x.ReauthToken
+	}
+	return ""
+}
+
+func (x *ReauthenticateResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type VerifyMFARequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	// Types that are valid to be assigned to Credential:
+	//
+	//	*VerifyMFARequest_TotpCode
+	//	*VerifyMFARequest_RecoveryCode
+	Credential    isVerifyMFARequest_Credential `protobuf_oneof:"credential"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMFARequest) Reset() {
+	*x = VerifyMFARequest{}
+	mi := &file_proto_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFARequest) ProtoMessage() {}
+
+func (x *VerifyMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMFARequest.ProtoReflect.Descriptor instead.
+func (*VerifyMFARequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *VerifyMFARequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *VerifyMFARequest) GetCredential() isVerifyMFARequest_Credential {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+func (x *VerifyMFARequest) GetTotpCode() string {
+	if x != nil {
+		if x, ok := x.Credential.(*VerifyMFARequest_TotpCode); ok {
+			return x.TotpCode
+		}
+	}
+	return ""
+}
+
+func (x *VerifyMFARequest) GetRecoveryCode() string {
+	if x != nil {
+		if x, ok := x.Credential.(*VerifyMFARequest_RecoveryCode); ok {
+			return x.RecoveryCode
+		}
+	}
+	return ""
+}
+
+type isVerifyMFARequest_Credential interface {
+	isVerifyMFARequest_Credential()
+}
+
+type VerifyMFARequest_TotpCode struct {
+	TotpCode string `protobuf:"bytes,2,opt,name=totp_code,json=totpCode,proto3,oneof"`
+}
+
+type VerifyMFARequest_RecoveryCode struct {
+	RecoveryCode string `protobuf:"bytes,3,opt,name=recovery_code,json=recoveryCode,proto3,oneof"`
+}
+
+func (*VerifyMFARequest_TotpCode) isVerifyMFARequest_Credential() {}
+
+func (*VerifyMFARequest_RecoveryCode) isVerifyMFARequest_Credential() {}
+
+type VerifyMFAResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        *JWTPair               `protobuf:"bytes,1,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMFAResponse) Reset() {
+	*x = VerifyMFAResponse{}
+	mi := &file_proto_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFAResponse) ProtoMessage() {}
+
+func (x *VerifyMFAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMFAResponse.ProtoReflect.Descriptor instead.
+func (*VerifyMFAResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VerifyMFAResponse) GetTokens() *JWTPair {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type EnrollTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPRequest) Reset() {
+	*x = EnrollTOTPRequest{}
+	mi := &file_proto_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPRequest) ProtoMessage() {}
+
+func (x *EnrollTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPRequest.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{31}
+}
+
+type EnrollTOTPResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Secret          string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	ProvisioningUri string                 `protobuf:"bytes,2,opt,name=provisioning_uri,json=provisioningUri,proto3" json:"provisioning_uri,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPResponse) Reset() {
+	*x = EnrollTOTPResponse{}
+	mi := &file_proto_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPResponse) ProtoMessage() {}
+
+func (x *EnrollTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPResponse.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EnrollTOTPResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTOTPResponse) GetProvisioningUri() string {
+	if x != nil {
+		return x.ProvisioningUri
+	}
+	return ""
+}
+
+type ConfirmTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPRequest) Reset() {
+	*x = ConfirmTOTPRequest{}
+	mi := &file_proto_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPRequest) ProtoMessage() {}
+
+func (x *ConfirmTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ConfirmTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmTOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RecoveryCodes []string               `protobuf:"bytes,2,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPResponse) Reset() {
+	*x = ConfirmTOTPResponse{}
+	mi := &file_proto_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPResponse) ProtoMessage() {}
+
+func (x *ConfirmTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ConfirmTOTPResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ConfirmTOTPResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTOTPRequest) Reset() {
+	*x = DisableTOTPRequest{}
+	mi := &file_proto_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTOTPRequest) ProtoMessage() {}
+
+func (x *DisableTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTOTPRequest.ProtoReflect.Descriptor instead.
+func (*DisableTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DisableTOTPRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DisableTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DisableTOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTOTPResponse) Reset() {
+	*x = DisableTOTPResponse{}
+	mi := &file_proto_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTOTPResponse) ProtoMessage() {}
+
+func (x *DisableTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTOTPResponse.ProtoReflect.Descriptor instead.
+func (*DisableTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DisableTOTPResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type RegenerateRecoveryCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesRequest) Reset() {
+	*x = RegenerateRecoveryCodesRequest{}
+	mi := &file_proto_auth_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesRequest) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RegenerateRecoveryCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesResponse) Reset() {
+	*x = RegenerateRecoveryCodesResponse{}
+	mi := &file_proto_auth_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesResponse) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RegenerateRecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+// Social login
+type StartOAuthLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	RedirectUri   string                 `protobuf:"bytes,2,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartOAuthLoginRequest) Reset() {
+	*x = StartOAuthLoginRequest{}
+	mi := &file_proto_auth_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOAuthLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOAuthLoginRequest) ProtoMessage() {}
+
+func (x *StartOAuthLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOAuthLoginRequest.ProtoReflect.Descriptor instead.
+func (*StartOAuthLoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StartOAuthLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *StartOAuthLoginRequest) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+type StartOAuthLoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizeUrl  string                 `protobuf:"bytes,1,opt,name=authorize_url,json=authorizeUrl,proto3" json:"authorize_url,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	CodeVerifier  string                 `protobuf:"bytes,3,opt,name=code_verifier,json=codeVerifier,proto3" json:"code_verifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartOAuthLoginResponse) Reset() {
+	*x = StartOAuthLoginResponse{}
+	mi := &file_proto_auth_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOAuthLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOAuthLoginResponse) ProtoMessage() {}
+
+func (x *StartOAuthLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOAuthLoginResponse.ProtoReflect.Descriptor instead.
+func (*StartOAuthLoginResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StartOAuthLoginResponse) GetAuthorizeUrl() string {
+	if x != nil {
+		return x.AuthorizeUrl
+	}
+	return ""
+}
+
+func (x *StartOAuthLoginResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *StartOAuthLoginResponse) GetCodeVerifier() string {
+	if x != nil {
+		return x.CodeVerifier
+	}
+	return ""
+}
+
+type CompleteOAuthLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	CodeVerifier  string                 `protobuf:"bytes,4,opt,name=code_verifier,json=codeVerifier,proto3" json:"code_verifier,omitempty"`
+	RedirectUri   string                 `protobuf:"bytes,5,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteOAuthLoginRequest) Reset() {
+	*x = CompleteOAuthLoginRequest{}
+	mi := &file_proto_auth_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOAuthLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOAuthLoginRequest) ProtoMessage() {}
+
+func (x *CompleteOAuthLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOAuthLoginRequest.ProtoReflect.Descriptor instead.
+func (*CompleteOAuthLoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CompleteOAuthLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CompleteOAuthLoginRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CompleteOAuthLoginRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CompleteOAuthLoginRequest) GetCodeVerifier() string {
+	if x != nil {
+		return x.CodeVerifier
+	}
+	return ""
+}
+
+func (x *CompleteOAuthLoginRequest) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+type CompleteOAuthLoginResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty when mfa_challenge is set.
+	Tokens        *JWTPair      `protobuf:"bytes,1,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	MfaChallenge  *MFAChallenge `protobuf:"bytes,2,opt,name=mfa_challenge,json=mfaChallenge,proto3" json:"mfa_challenge,omitempty"`
+	Created       bool          `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteOAuthLoginResponse) Reset() {
+	*x = CompleteOAuthLoginResponse{}
+	mi := &file_proto_auth_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOAuthLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOAuthLoginResponse) ProtoMessage() {}
+
+func (x *CompleteOAuthLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOAuthLoginResponse.ProtoReflect.Descriptor instead.
+func (*CompleteOAuthLoginResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CompleteOAuthLoginResponse) GetTokens() *JWTPair {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *CompleteOAuthLoginResponse) GetMfaChallenge() *MFAChallenge {
+	if x != nil {
+		return x.MfaChallenge
+	}
+	return nil
+}
+
+func (x *CompleteOAuthLoginResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type LinkedIdentity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	LinkedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkedIdentity) Reset() {
+	*x = LinkedIdentity{}
+	mi := &file_proto_auth_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkedIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkedIdentity) ProtoMessage() {}
+
+func (x *LinkedIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkedIdentity.ProtoReflect.Descriptor instead.
+func (*LinkedIdentity) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *LinkedIdentity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LinkedIdentity) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *LinkedIdentity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LinkedIdentity) GetLinkedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return nil
+}
+
+// Email verification and password recovery
+type VerifyEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_proto_auth_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
+	mi := &file_proto_auth_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailResponse) ProtoMessage() {}
+
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *VerifyEmailResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ResendVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationRequest) Reset() {
+	*x = ResendVerificationRequest{}
+	mi := &file_proto_auth_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationRequest) ProtoMessage() {}
+
+func (x *ResendVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationRequest.ProtoReflect.Descriptor instead.
+func (*ResendVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ResendVerificationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ResendVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationResponse) Reset() {
+	*x = ResendVerificationResponse{}
+	mi := &file_proto_auth_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationResponse) ProtoMessage() {}
+
+func (x *ResendVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationResponse.ProtoReflect.Descriptor instead.
+func (*ResendVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ResendVerificationResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_proto_auth_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_proto_auth_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RequestPasswordResetResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_proto_auth_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_proto_auth_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ResetPasswordResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Admin management messages
+type CreateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_proto_auth_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_proto_auth_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	EmailPrefix   string                 `protobuf:"bytes,3,opt,name=email_prefix,json=emailPrefix,proto3" json:"email_prefix,omitempty"`
+	NamePrefix    string                 `protobuf:"bytes,4,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`
+	Role          UserRole               `protobuf:"varint,5,opt,name=role,proto3,enum=proto.UserRole" json:"role,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	Status        UserStatus             `protobuf:"varint,8,opt,name=status,proto3,enum=proto.UserStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_proto_auth_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListUsersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetEmailPrefix() string {
+	if x != nil {
+		return x.EmailPrefix
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *ListUsersRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListUsersRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListUsersRequest) GetStatus() UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserResponse        `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_proto_auth_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListUsersResponse) GetUsers() []*UserResponse {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListUsersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type UpdateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	IsAdmin       *bool                  `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3,oneof" json:"is_admin,omitempty"`
+	Password      string                 `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_proto_auth_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpdateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetIsAdmin() bool {
+	if x != nil && x.IsAdmin != nil {
+		return *x.IsAdmin
+	}
+	return false
+}
+
+func (x *UpdateUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	mi := &file_proto_auth_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PurgeAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResponse) Reset() {
+	*x = DeleteResponse{}
+	mi := &file_proto_auth_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*DeleteResponse) ProtoMessage() {}
 
-func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[22]
+func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeleteResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *DeleteResponse) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+type RestoreUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserRequest) Reset() {
+	*x = RestoreUserRequest{}
+	mi := &file_proto_auth_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserRequest) ProtoMessage() {}
+
+func (x *RestoreUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RestoreUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SuspendUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	mi := &file_proto_auth_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserRequest) ProtoMessage() {}
+
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SuspendUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserRequest) Reset() {
+	*x = ReactivateUserRequest{}
+	mi := &file_proto_auth_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserRequest) ProtoMessage() {}
+
+func (x *ReactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ReactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ForceLogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceLogoutRequest) Reset() {
+	*x = ForceLogoutRequest{}
+	mi := &file_proto_auth_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceLogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceLogoutRequest) ProtoMessage() {}
+
+func (x *ForceLogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceLogoutRequest.ProtoReflect.Descriptor instead.
+func (*ForceLogoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ForceLogoutRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ForceLogoutResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Status          *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	SessionsRevoked int32                  `protobuf:"varint,2,opt,name=sessions_revoked,json=sessionsRevoked,proto3" json:"sessions_revoked,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ForceLogoutResponse) Reset() {
+	*x = ForceLogoutResponse{}
+	mi := &file_proto_auth_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceLogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceLogoutResponse) ProtoMessage() {}
+
+func (x *ForceLogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceLogoutResponse.ProtoReflect.Descriptor instead.
+func (*ForceLogoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ForceLogoutResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ForceLogoutResponse) GetSessionsRevoked() int32 {
+	if x != nil {
+		return x.SessionsRevoked
+	}
+	return 0
+}
+
+type UserResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           *UUID                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email            string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	IsAdmin          bool                   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EmailVerified    bool                   `protobuf:"varint,6,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	MfaEnabled       bool                   `protobuf:"varint,7,opt,name=mfa_enabled,json=mfaEnabled,proto3" json:"mfa_enabled,omitempty"`
+	LinkedIdentities []*LinkedIdentity      `protobuf:"bytes,8,rep,name=linked_identities,json=linkedIdentities,proto3" json:"linked_identities,omitempty"`
+	Status           UserStatus             `protobuf:"varint,9,opt,name=status,proto3,enum=proto.UserStatus" json:"status,omitempty"`
+	SuspensionReason string                 `protobuf:"bytes,10,opt,name=suspension_reason,json=suspensionReason,proto3" json:"suspension_reason,omitempty"`
+	DeletedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PurgeAt          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UserResponse) Reset() {
+	*x = UserResponse{}
+	mi := &file_proto_auth_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserResponse) ProtoMessage() {}
+
+func (x *UserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
+func (*UserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UserResponse) GetUserId() *UUID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *UserResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserResponse) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+func (x *UserResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserResponse) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *UserResponse) GetMfaEnabled() bool {
+	if x != nil {
+		return x.MfaEnabled
+	}
+	return false
+}
+
+func (x *UserResponse) GetLinkedIdentities() []*LinkedIdentity {
+	if x != nil {
+		return x.LinkedIdentities
+	}
+	return nil
+}
+
+func (x *UserResponse) GetStatus() UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+func (x *UserResponse) GetSuspensionReason() string {
+	if x != nil {
+		return x.SuspensionReason
+	}
+	return ""
+}
+
+func (x *UserResponse) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *UserResponse) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_proto_auth_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeleteAccountRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *DeleteAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Account deletion and data export
+type RestoreAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAccountRequest) Reset() {
+	*x = RestoreAccountRequest{}
+	mi := &file_proto_auth_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAccountRequest) ProtoMessage() {}
+
+func (x *RestoreAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAccountRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RestoreAccountRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RestoreAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type PurgeDeletedAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDeletedAccountsRequest) Reset() {
+	*x = PurgeDeletedAccountsRequest{}
+	mi := &file_proto_auth_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeletedAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeletedAccountsRequest) ProtoMessage() {}
+
+func (x *PurgeDeletedAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeletedAccountsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDeletedAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{67}
+}
+
+type PurgeDeletedAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []*UUID                `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDeletedAccountsResponse) Reset() {
+	*x = PurgeDeletedAccountsResponse{}
+	mi := &file_proto_auth_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeletedAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeletedAccountsResponse) ProtoMessage() {}
+
+func (x *PurgeDeletedAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeletedAccountsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDeletedAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PurgeDeletedAccountsResponse) GetUserIds() []*UUID {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type ExportMyDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataRequest) Reset() {
+	*x = ExportMyDataRequest{}
+	mi := &file_proto_auth_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataRequest) ProtoMessage() {}
+
+func (x *ExportMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{69}
+}
+
+// AccountExport is the account.json document of a data export.
+type AccountExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *UserResponse          `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Sessions      []*SessionInfo         `protobuf:"bytes,2,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountExport) Reset() {
+	*x = AccountExport{}
+	mi := &file_proto_auth_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountExport) ProtoMessage() {}
+
+func (x *AccountExport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountExport.ProtoReflect.Descriptor instead.
+func (*AccountExport) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AccountExport) GetProfile() *UserResponse {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *AccountExport) GetSessions() []*SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type SessionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
+	mi := &file_proto_auth_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionInfo) ProtoMessage() {}
+
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SessionInfo) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// User events
+type StreamUserEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Types         []string               `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamUserEventsRequest) Reset() {
+	*x = StreamUserEventsRequest{}
+	mi := &file_proto_auth_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamUserEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamUserEventsRequest) ProtoMessage() {}
+
+func (x *StreamUserEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamUserEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamUserEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *StreamUserEventsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// UserEvent is a CloudEvents 1.0 envelope. Attribute names follow the
+// CloudEvents spec; the payload is carried in the data oneof.
+type UserEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source          string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	SpecVersion     string                 `protobuf:"bytes,3,opt,name=spec_version,json=specVersion,proto3" json:"spec_version,omitempty"`
+	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Subject         string                 `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	Time            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	DataContentType string                 `protobuf:"bytes,7,opt,name=data_content_type,json=dataContentType,proto3" json:"data_content_type,omitempty"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UserEvent_Registered
+	//	*UserEvent_Updated
+	//	*UserEvent_Deleted
+	//	*UserEvent_RoleChanged
+	//	*UserEvent_PasswordChanged
+	//	*UserEvent_StatusChanged
+	Data          isUserEvent_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserEvent) Reset() {
+	*x = UserEvent{}
+	mi := &file_proto_auth_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEvent) ProtoMessage() {}
+
+func (x *UserEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEvent.ProtoReflect.Descriptor instead.
+func (*UserEvent) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UserEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *UserEvent) GetSpecVersion() string {
+	if x != nil {
+		return x.SpecVersion
+	}
+	return ""
+}
+
+func (x *UserEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UserEvent) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *UserEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *UserEvent) GetDataContentType() string {
+	if x != nil {
+		return x.DataContentType
+	}
+	return ""
+}
+
+func (x *UserEvent) GetData() isUserEvent_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UserEvent) GetRegistered() *UserRegistered {
+	if x != nil {
+		if x, ok := x.Data.(*UserEvent_Registered); ok {
+			return x.Registered
+		}
+	}
+	return nil
+}
+
+func (x *UserEvent) GetUpdated() *UserUpdated {
+	if x != nil {
+		if x, ok := x.Data.(*UserEvent_Updated); ok {
+			return x.Updated
+		}
+	}
+	return nil
+}
+
+func (x *UserEvent) GetDeleted() *UserDeleted {
+	if x != nil {
+		if x, ok := x.Data.(*UserEvent_Deleted); ok {
+			return x.Deleted
+		}
+	}
+	return nil
+}
+
+func (x *UserEvent) GetRoleChanged() *UserRoleChanged {
+	if x != nil {
+		if x, ok := x.Data.(*UserEvent_RoleChanged); ok {
+			return x.RoleChanged
+		}
+	}
+	return nil
+}
+
+func (x *UserEvent) GetPasswordChanged() *PasswordChanged {
+	if x != nil {
+		if x, ok := x.Data.(*UserEvent_PasswordChanged); ok {
+			return x.PasswordChanged
+		}
+	}
+	return nil
+}
+
+func (x *UserEvent) GetStatusChanged() *UserStatusChanged {
+	if x != nil {
+		if x, ok := x.Data.(*UserEvent_StatusChanged); ok {
+			return x.StatusChanged
+		}
+	}
+	return nil
+}
+
+type isUserEvent_Data interface {
+	isUserEvent_Data()
+}
+
+type UserEvent_Registered struct {
+	Registered *UserRegistered `protobuf:"bytes,10,opt,name=registered,proto3,oneof"`
+}
+
+type UserEvent_Updated struct {
+	Updated *UserUpdated `protobuf:"bytes,11,opt,name=updated,proto3,oneof"`
+}
+
+type UserEvent_Deleted struct {
+	Deleted *UserDeleted `protobuf:"bytes,12,opt,name=deleted,proto3,oneof"`
+}
+
+type UserEvent_RoleChanged struct {
+	RoleChanged *UserRoleChanged `protobuf:"bytes,13,opt,name=role_changed,json=roleChanged,proto3,oneof"`
+}
+
+type UserEvent_PasswordChanged struct {
+	PasswordChanged *PasswordChanged `protobuf:"bytes,14,opt,name=password_changed,json=passwordChanged,proto3,oneof"`
+}
+
+type UserEvent_StatusChanged struct {
+	StatusChanged *UserStatusChanged `protobuf:"bytes,15,opt,name=status_changed,json=statusChanged,proto3,oneof"`
+}
+
+func (*UserEvent_Registered) isUserEvent_Data() {}
+
+func (*UserEvent_Updated) isUserEvent_Data() {}
+
+func (*UserEvent_Deleted) isUserEvent_Data() {}
+
+func (*UserEvent_RoleChanged) isUserEvent_Data() {}
+
+func (*UserEvent_PasswordChanged) isUserEvent_Data() {}
+
+func (*UserEvent_StatusChanged) isUserEvent_Data() {}
+
+type UserRegistered struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *UUID                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Provider      string                 `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserRegistered) Reset() {
+	*x = UserRegistered{}
+	mi := &file_proto_auth_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRegistered) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRegistered) ProtoMessage() {}
+
+func (x *UserRegistered) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRegistered.ProtoReflect.Descriptor instead.
+func (*UserRegistered) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UserRegistered) GetUserId() *UUID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *UserRegistered) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserRegistered) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserRegistered) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type UserUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *UUID                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	PreviousEmail string                 `protobuf:"bytes,4,opt,name=previous_email,json=previousEmail,proto3" json:"previous_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserUpdated) Reset() {
+	*x = UserUpdated{}
+	mi := &file_proto_auth_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUpdated) ProtoMessage() {}
+
+func (x *UserUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUpdated.ProtoReflect.Descriptor instead.
+func (*UserUpdated) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UserUpdated) GetUserId() *UUID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *UserUpdated) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserUpdated) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserUpdated) GetPreviousEmail() string {
+	if x != nil {
+		return x.PreviousEmail
+	}
+	return ""
+}
+
+type UserDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *UUID                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ByAdmin       bool                   `protobuf:"varint,2,opt,name=by_admin,json=byAdmin,proto3" json:"by_admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDeleted) Reset() {
+	*x = UserDeleted{}
+	mi := &file_proto_auth_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeleted) ProtoMessage() {}
+
+func (x *UserDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeleted.ProtoReflect.Descriptor instead.
+func (*UserDeleted) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UserDeleted) GetUserId() *UUID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *UserDeleted) GetByAdmin() bool {
+	if x != nil {
+		return x.ByAdmin
+	}
+	return false
+}
+
+type UserRoleChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *UUID                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,2,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	ChangedBy     *UUID                  `protobuf:"bytes,3,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserRoleChanged) Reset() {
+	*x = UserRoleChanged{}
+	mi := &file_proto_auth_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRoleChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRoleChanged) ProtoMessage() {}
+
+func (x *UserRoleChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRoleChanged.ProtoReflect.Descriptor instead.
+func (*UserRoleChanged) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UserRoleChanged) GetUserId() *UUID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *UserRoleChanged) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+func (x *UserRoleChanged) GetChangedBy() *UUID {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return nil
+}
+
+type PasswordChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *UUID                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Method        PasswordChangeMethod   `protobuf:"varint,2,opt,name=method,proto3,enum=proto.PasswordChangeMethod" json:"method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordChanged) Reset() {
+	*x = PasswordChanged{}
+	mi := &file_proto_auth_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordChanged) ProtoMessage() {}
+
+func (x *PasswordChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordChanged.ProtoReflect.Descriptor instead.
+func (*PasswordChanged) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *PasswordChanged) GetUserId() *UUID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *PasswordChanged) GetMethod() PasswordChangeMethod {
+	if x != nil {
+		return x.Method
+	}
+	return PasswordChangeMethod_PASSWORD_CHANGE_METHOD_UNSPECIFIED
+}
+
+type UserStatusChanged struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         *UUID                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PreviousStatus UserStatus             `protobuf:"varint,2,opt,name=previous_status,json=previousStatus,proto3,enum=proto.UserStatus" json:"previous_status,omitempty"`
+	Status         UserStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=proto.UserStatus" json:"status,omitempty"`
+	ChangedBy      *UUID                  `protobuf:"bytes,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	Reason         string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserStatusChanged) Reset() {
+	*x = UserStatusChanged{}
+	mi := &file_proto_auth_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStatusChanged) ProtoMessage() {}
+
+func (x *UserStatusChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,44 +4936,78 @@ func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
-func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UserStatusChanged.ProtoReflect.Descriptor instead.
+func (*UserStatusChanged) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *DeleteResponse) GetStatus() *Status {
+func (x *UserStatusChanged) GetUserId() *UUID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *UserStatusChanged) GetPreviousStatus() UserStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+func (x *UserStatusChanged) GetStatus() UserStatus {
 	if x != nil {
 		return x.Status
 	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+func (x *UserStatusChanged) GetChangedBy() *UUID {
+	if x != nil {
+		return x.ChangedBy
+	}
 	return nil
 }
 
-type UserResponse struct {
+func (x *UserStatusChanged) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AuditEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        *UUID                  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	IsAdmin       bool                   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorType     SubjectType            `protobuf:"varint,3,opt,name=actor_type,json=actorType,proto3,enum=proto.SubjectType" json:"actor_type,omitempty"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Resource      string                 `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	Params        map[string]string      `protobuf:"bytes,6,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Outcome       AuditOutcome           `protobuf:"varint,7,opt,name=outcome,proto3,enum=proto.AuditOutcome" json:"outcome,omitempty"`
+	StatusCode    string                 `protobuf:"bytes,8,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	ErrorReason   string                 `protobuf:"bytes,9,opt,name=error_reason,json=errorReason,proto3" json:"error_reason,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=time,proto3" json:"time,omitempty"`
+	RequestId     string                 `protobuf:"bytes,11,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserResponse) Reset() {
-	*x = UserResponse{}
-	mi := &file_proto_auth_proto_msgTypes[23]
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_proto_auth_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserResponse) String() string {
+func (x *AuditEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserResponse) ProtoMessage() {}
+func (*AuditEntry) ProtoMessage() {}
 
-func (x *UserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[23]
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1261,69 +5018,115 @@ func (x *UserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
-func (*UserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *UserResponse) GetUserId() *UUID {
+func (x *AuditEntry) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *UserResponse) GetName() string {
+func (x *AuditEntry) GetActorId() string {
 	if x != nil {
-		return x.Name
+		return x.ActorId
 	}
 	return ""
 }
 
-func (x *UserResponse) GetEmail() string {
+func (x *AuditEntry) GetActorType() SubjectType {
 	if x != nil {
-		return x.Email
+		return x.ActorType
+	}
+	return SubjectType_SUBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *AuditEntry) GetMethod() string {
+	if x != nil {
+		return x.Method
 	}
 	return ""
 }
 
-func (x *UserResponse) GetIsAdmin() bool {
+func (x *AuditEntry) GetResource() string {
 	if x != nil {
-		return x.IsAdmin
+		return x.Resource
 	}
-	return false
+	return ""
 }
 
-func (x *UserResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *AuditEntry) GetParams() map[string]string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Params
 	}
 	return nil
 }
 
-type DeleteAccountRequest struct {
+func (x *AuditEntry) GetOutcome() AuditOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return AuditOutcome_AUDIT_OUTCOME_UNSPECIFIED
+}
+
+func (x *AuditEntry) GetStatusCode() string {
+	if x != nil {
+		return x.StatusCode
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetErrorReason() string {
+	if x != nil {
+		return x.ErrorReason
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type ListAuditEntriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Resource      string                 `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAccountRequest) Reset() {
-	*x = DeleteAccountRequest{}
-	mi := &file_proto_auth_proto_msgTypes[24]
+func (x *ListAuditEntriesRequest) Reset() {
+	*x = ListAuditEntriesRequest{}
+	mi := &file_proto_auth_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAccountRequest) String() string {
+func (x *ListAuditEntriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAccountRequest) ProtoMessage() {}
+func (*ListAuditEntriesRequest) ProtoMessage() {}
 
-func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[24]
+func (x *ListAuditEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1334,21 +5137,101 @@ func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListAuditEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *DeleteAccountRequest) GetAccessToken() string {
+func (x *ListAuditEntriesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.AccessToken
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *DeleteAccountRequest) GetPassword() string {
+func (x *ListAuditEntriesRequest) GetActorId() string {
 	if x != nil {
-		return x.Password
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditEntriesRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ListAuditEntriesRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ListAuditEntriesRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+type ListAuditEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEntriesResponse) Reset() {
+	*x = ListAuditEntriesResponse{}
+	mi := &file_proto_auth_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEntriesResponse) ProtoMessage() {}
+
+func (x *ListAuditEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListAuditEntriesResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListAuditEntriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
@@ -1357,89 +5240,379 @@ var File_proto_auth_proto protoreflect.FileDescriptor
 
 const file_proto_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x10proto/auth.proto\x12\x05proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x12auth_options.proto\";\n" +
+	"\x10proto/auth.proto\x12\x05proto\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x12auth_options.proto\";\n" +
 	"\x04UUID\x123\n" +
-	"\x05value\x18\x01 \x01(\tB\x1d\x92A\x1a2\x18UUID v4 in string formatR\x05value\"\xae\x01\n" +
-	"\aJWTPair\x12J\n" +
-	"\faccess_token\x18\x01 \x01(\tB'\x92A$2\"Access token for API authorizationR\vaccessToken\x12W\n" +
-	"\rrefresh_token\x18\x02 \x01(\tB2\x92A/2-Refresh token for obtaining new access tokensR\frefreshToken\"P\n" +
+	"\x05value\x18\x01 \x01(\tB\x1d\x92A\x1a2\x18UUID v4 in string formatR\x05value\"\xb6\x01\n" +
+	"\aJWTPair\x12N\n" +
+	"\faccess_token\x18\x01 \x01(\tB+\x92A$2\"Access token for API authorization\x98\xb5\x18\x01R\vaccessToken\x12[\n" +
+	"\rrefresh_token\x18\x02 \x01(\tB6\x92A/2-Refresh token for obtaining new access tokens\x98\xb5\x18\x01R\frefreshToken\"P\n" +
 	"\x06Status\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
-	"\x04code\x18\x03 \x01(\x05R\x04code\"|\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\"\x80\x01\n" +
 	"\fLoginRequest\x12/\n" +
-	"\x05email\x18\x01 \x01(\tB\x19\x92A\x162\x14User's email addressR\x05email\x12;\n" +
-	"\bpassword\x18\x02 \x01(\tB\x1f\x92A\x1c2\x0fUser's password\xa2\x02\bpasswordR\bpassword\"7\n" +
+	"\x05email\x18\x01 \x01(\tB\x19\x92A\x162\x14User's email addressR\x05email\x12?\n" +
+	"\bpassword\x18\x02 \x01(\tB#\x92A\x1c2\x0fUser's password\xa2\x02\bpassword\x98\xb5\x18\x01R\bpassword\"\xcb\x01\n" +
 	"\rLoginResponse\x12&\n" +
-	"\x06tokens\x18\x01 \x01(\v2\x0e.proto.JWTPairR\x06tokens\"\xae\x01\n" +
+	"\x06tokens\x18\x01 \x01(\v2\x0e.proto.JWTPairR\x06tokens\x12\x91\x01\n" +
+	"\rmfa_challenge\x18\x02 \x01(\v2\x13.proto.MFAChallengeBW\x92AT2RSet when the account has MFA enabled. Pass mfa_token to VerifyMFA to obtain tokensR\fmfaChallenge\"\xa2\x02\n" +
+	"\fMFAChallenge\x12U\n" +
+	"\tmfa_token\x18\x01 \x01(\tB8\x92A12/Short-lived token identifying the pending login\x98\xb5\x18\x01R\bmfaToken\x12^\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB#\x92A 2\x1eChallenge expiration timestampR\texpiresAt\x12[\n" +
+	"\amethods\x18\x03 \x03(\x0e2\x10.proto.MFAMethodB/\x92A,2*Methods accepted to complete the challengeR\amethods\"\xb2\x01\n" +
 	"\x0fRegisterRequest\x12,\n" +
 	"\x04name\x18\x01 \x01(\tB\x18\x92A\x152\x13User's display nameR\x04name\x12/\n" +
-	"\x05email\x18\x02 \x01(\tB\x19\x92A\x162\x14User's email addressR\x05email\x12<\n" +
-	"\bpassword\x18\x03 \x01(\tB \x92A\x1d2\x10Desired password\xa2\x02\bpasswordR\bpassword\"X\n" +
+	"\x05email\x18\x02 \x01(\tB\x19\x92A\x162\x14User's email addressR\x05email\x12@\n" +
+	"\bpassword\x18\x03 \x01(\tB$\x92A\x1d2\x10Desired password\xa2\x02\bpassword\x98\xb5\x18\x01R\bpassword\"X\n" +
 	"\x10RegisterResponse\x12D\n" +
-	"\auser_id\x18\x01 \x01(\v2\v.proto.UUIDB\x1e\x92A\x1b2\x19Created user ID (UUID v4)R\x06userId\"V\n" +
-	"\rLogoutRequest\x12E\n" +
-	"\rrefresh_token\x18\x01 \x01(\tB \x92A\x1d2\x1bRefresh token to invalidateR\frefreshToken\"7\n" +
+	"\auser_id\x18\x01 \x01(\v2\v.proto.UUIDB\x1e\x92A\x1b2\x19Created user ID (UUID v4)R\x06userId\"Z\n" +
+	"\rLogoutRequest\x12I\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB$\x92A\x1d2\x1bRefresh token to invalidate\x98\xb5\x18\x01R\frefreshToken\"7\n" +
 	"\x0eLogoutResponse\x12%\n" +
-	"\x06status\x18\x01 \x01(\v2\r.proto.StatusR\x06status\"\xac\x01\n" +
-	"\x14UpdateProfileRequest\x12;\n" +
-	"\faccess_token\x18\x01 \x01(\tB\x18\x92A\x152\x13JWT token to updateR\vaccessToken\x12)\n" +
+	"\x06status\x18\x01 \x01(\v2\r.proto.StatusR\x06status\"\xb0\x01\n" +
+	"\x14UpdateProfileRequest\x12?\n" +
+	"\faccess_token\x18\x01 \x01(\tB\x1c\x92A\x152\x13JWT token to update\x98\xb5\x18\x01R\vaccessToken\x12)\n" +
 	"\x04name\x18\x02 \x01(\tB\x15\x92A\x122\x10New display nameR\x04name\x12,\n" +
-	"\x05email\x18\x03 \x01(\tB\x16\x92A\x132\x11New email addressR\x05email\"\xe3\x01\n" +
-	"\x15ChangePasswordRequest\x12D\n" +
-	"\faccess_token\x18\x01 \x01(\tB!\x92A\x1e2\x1cJWT token to change passwordR\vaccessToken\x12C\n" +
-	"\fold_password\x18\x02 \x01(\tB \x92A\x1d2\x10Current password\xa2\x02\bpasswordR\voldPassword\x12?\n" +
-	"\fnew_password\x18\x03 \x01(\tB\x1c\x92A\x192\fNew password\xa2\x02\bpasswordR\vnewPassword\"?\n" +
+	"\x05email\x18\x03 \x01(\tB\x16\x92A\x132\x11New email addressR\x05email\"\xef\x01\n" +
+	"\x15ChangePasswordRequest\x12H\n" +
+	"\faccess_token\x18\x01 \x01(\tB%\x92A\x1e2\x1cJWT token to change password\x98\xb5\x18\x01R\vaccessToken\x12G\n" +
+	"\fold_password\x18\x02 \x01(\tB$\x92A\x1d2\x10Current password\xa2\x02\bpassword\x98\xb5\x18\x01R\voldPassword\x12C\n" +
+	"\fnew_password\x18\x03 \x01(\tB \x92A\x192\fNew password\xa2\x02\bpassword\x98\xb5\x18\x01R\vnewPassword\"?\n" +
 	"\x16ChangePasswordResponse\x12%\n" +
-	"\x06status\x18\x01 \x01(\v2\r.proto.StatusR\x06status\"O\n" +
-	"\x0eRefreshRequest\x12=\n" +
-	"\rrefresh_token\x18\x01 \x01(\tB\x18\x92A\x152\x13Valid refresh tokenR\frefreshToken\"9\n" +
+	"\x06status\x18\x01 \x01(\v2\r.proto.StatusR\x06status\"S\n" +
+	"\x0eRefreshRequest\x12A\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\x1c\x92A\x152\x13Valid refresh token\x98\xb5\x18\x01R\frefreshToken\"9\n" +
 	"\x0fRefreshResponse\x12&\n" +
-	"\x06tokens\x18\x01 \x01(\v2\x0e.proto.JWTPairR\x06tokens\"H\n" +
-	"\x14ValidateTokenRequest\x120\n" +
-	"\x05token\x18\x01 \x01(\tB\x1a\x92A\x172\x15JWT token to validateR\x05token\"\x9c\x02\n" +
+	"\x06tokens\x18\x01 \x01(\v2\x0e.proto.JWTPairR\x06tokens\"L\n" +
+	"\x14ValidateTokenRequest\x124\n" +
+	"\x05token\x18\x01 \x01(\tB\x1e\x92A\x172\x15JWT token to validate\x98\xb5\x18\x01R\x05token\"\xa9\x04\n" +
 	"\x15ValidateTokenResponse\x12\x19\n" +
 	"\bis_valid\x18\x01 \x01(\bR\aisValid\x12a\n" +
 	"\n" +
 	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB&\x92A#2!Token expiration timestamp (Unix)R\texpiresAt\x12K\n" +
 	"\auser_id\x18\x03 \x01(\v2\v.proto.UUIDB%\x92A\"2 User ID from the token (UUID v4)R\x06userId\x128\n" +
-	"\bis_admin\x18\x04 \x01(\bB\x1d\x92A\x1a2\x18Represents is user adminR\aisAdmin\"\xe8\x01\n" +
+	"\bis_admin\x18\x04 \x01(\bB\x1d\x92A\x1a2\x18Represents is user adminR\aisAdmin\x12w\n" +
+	"\fsubject_type\x18\x05 \x01(\x0e2\x12.proto.SubjectTypeB@\x92A=2;Whether the token was issued to a user or a service accountR\vsubjectType\x12X\n" +
+	"\tclient_id\x18\x06 \x01(\tB;\x92A826Service account client ID. Set only for service tokensR\bclientId\x128\n" +
+	"\x06scopes\x18\a \x03(\tB \x92A\x1d2\x1bScopes granted to the tokenR\x06scopes\"\xc2\x01\n" +
+	"\x16IntrospectTokenRequest\x122\n" +
+	"\x05token\x18\x01 \x01(\tB\x1c\x92A\x152\x13Token to introspect\x98\xb5\x18\x01R\x05token\x12t\n" +
+	"\x0ftoken_type_hint\x18\x02 \x01(\x0e2\x10.proto.TokenTypeB:\x92A725Optional hint about the token type to speed up lookupR\rtokenTypeHint\"\xc6\x06\n" +
+	"\x17IntrospectTokenResponse\x12e\n" +
+	"\x06active\x18\x01 \x01(\bBM\x92AJ2HWhether the token is currently active. Other fields are empty when falseR\x06active\x12[\n" +
+	"\asubject\x18\x02 \x01(\tBA\x92A>2<User ID or service account client ID the token was issued toR\asubject\x125\n" +
+	"\fsubject_type\x18\x03 \x01(\x0e2\x12.proto.SubjectTypeR\vsubjectType\x12/\n" +
+	"\n" +
+	"token_type\x18\x04 \x01(\x0e2\x10.proto.TokenTypeR\ttokenType\x128\n" +
+	"\x06scopes\x18\x05 \x03(\tB \x92A\x1d2\x1bScopes granted to the tokenR\x06scopes\x12`\n" +
+	"\n" +
+	"session_id\x18\x06 \x01(\tBA\x92A>2<Login session the token belongs to. Empty for service tokensR\tsessionId\x12@\n" +
+	"\tclient_id\x18\a \x01(\tB#\x92A 2\x1eClient the token was issued toR\bclientId\x12\x19\n" +
+	"\bis_admin\x18\b \x01(\bR\aisAdmin\x12S\n" +
+	"\tissued_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x1a\x92A\x172\x15Token issue timestampR\bissuedAt\x12Z\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x1f\x92A\x1c2\x1aToken expiration timestampR\texpiresAt\x12\x16\n" +
+	"\x06issuer\x18\v \x01(\tR\x06issuer\x12=\n" +
+	"\btoken_id\x18\f \x01(\tB\"\x92A\x1f2\x1dUnique token identifier (jti)R\atokenId\"\xa4\x02\n" +
+	"\x18ClientCredentialsRequest\x12;\n" +
+	"\tclient_id\x18\x01 \x01(\tB\x1e\x92A\x1b2\x19Service account client IDR\bclientId\x12V\n" +
+	"\rclient_secret\x18\x02 \x01(\tB1\x92A*2\x1dService account client secret\xa2\x02\bpassword\x98\xb5\x18\x01R\fclientSecret\x12s\n" +
+	"\x06scopes\x18\x03 \x03(\tB[\x92AX2VRequested scopes. Must be a subset of the account's scopes; empty requests all of themR\x06scopes\"\xb4\x02\n" +
+	"\x19ClientCredentialsResponse\x12N\n" +
+	"\faccess_token\x18\x01 \x01(\tB+\x92A$2\"Access token for API authorization\x98\xb5\x18\x01R\vaccessToken\x121\n" +
+	"\n" +
+	"token_type\x18\x02 \x01(\tB\x12\x92A\x0f2\rAlways BearerR\ttokenType\x12Z\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x1f\x92A\x1c2\x1aToken expiration timestampR\texpiresAt\x128\n" +
+	"\x06scopes\x18\x04 \x03(\tB \x92A\x1d2\x1bScopes granted to the tokenR\x06scopes\"\xd8\x02\n" +
+	"\x0eServiceAccount\x125\n" +
+	"\tclient_id\x18\x01 \x01(\tB\x18\x92A\x152\x13Immutable client IDR\bclientId\x12;\n" +
+	"\x04name\x18\x02 \x01(\tB'\x92A$2\"Service name, e.g. booking-serviceR\x04name\x12;\n" +
+	"\x06scopes\x18\x03 \x03(\tB#\x92A 2\x1eScopes the account may requestR\x06scopes\x12\x18\n" +
+	"\arevoked\x18\x04 \x01(\bR\arevoked\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12@\n" +
+	"\x0ekey_rotated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fkeyRotatedAt\"\x97\x01\n" +
+	"\x1bCreateServiceAccountRequest\x12;\n" +
+	"\x04name\x18\x01 \x01(\tB'\x92A$2\"Service name, e.g. booking-serviceR\x04name\x12;\n" +
+	"\x06scopes\x18\x02 \x03(\tB#\x92A 2\x1eScopes the account may requestR\x06scopes\"\x9a\x01\n" +
+	"\x19ServiceAccountCredentials\x12/\n" +
+	"\aaccount\x18\x01 \x01(\v2\x15.proto.ServiceAccountR\aaccount\x12L\n" +
+	"\rclient_secret\x18\x02 \x01(\tB'\x92A 2\x1eClient secret. Shown only once\x98\xb5\x18\x01R\fclientSecret\"\xc3\x01\n" +
+	"\x1eRotateServiceAccountKeyRequest\x12;\n" +
+	"\tclient_id\x18\x01 \x01(\tB\x1e\x92A\x1b2\x19Service account client IDR\bclientId\x12d\n" +
+	"\x14grace_period_seconds\x18\x02 \x01(\x05B2\x92A/2*How long the previous secret remains valid:\x010R\x12gracePeriodSeconds\"Z\n" +
+	"\x1bRevokeServiceAccountRequest\x12;\n" +
+	"\tclient_id\x18\x01 \x01(\tB\x1e\x92A\x1b2\x19Service account client IDR\bclientId\"E\n" +
+	"\x1cRevokeServiceAccountResponse\x12%\n" +
+	"\x06status\x18\x01 \x01(\v2\r.proto.StatusR\x06status\"\xc6\x01\n" +
+	"\x15ReauthenticateRequest\x12@\n" +
+	"\bpassword\x18\x01 \x01(\tB$\x92A\x1d2\x10Current password\xa2\x02\bpassword\x98\xb5\x18\x01R\bpassword\x12k\n" +
+	"\ttotp_code\x18\x02 \x01(\tBN\x92AG2E6-digit code from the authenticator app, required when MFA is enabled\x98\xb5\x18\x01R\btotpCode\"\xb3\x01\n" +
+	"\x16ReauthenticateResponse\x12^\n" +
+	"\freauth_token\x18\x01 \x01(\tB;\x92A422Step-up token to send in the X-Reauth-Token header\x98\xb5\x18\x01R\vreauthToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x84\x02\n" +
+	"\x10VerifyMFARequest\x12G\n" +
+	"\tmfa_token\x18\x01 \x01(\tB*\x92A#2!Challenge token returned by Login\x98\xb5\x18\x01R\bmfaToken\x12O\n" +
+	"\ttotp_code\x18\x02 \x01(\tB0\x92A)2'6-digit code from the authenticator app\x98\xb5\x18\x01H\x00R\btotpCode\x12H\n" +
+	"\rrecovery_code\x18\x03 \x01(\tB!\x92A\x1a2\x18Single-use recovery code\x98\xb5\x18\x01H\x00R\frecoveryCodeB\f\n" +
+	"\n" +
+	"credential\";\n" +
+	"\x11VerifyMFAResponse\x12&\n" +
+	"\x06tokens\x18\x01 \x01(\v2\x0e.proto.JWTPairR\x06tokens\"\x13\n" +
+	"\x11EnrollTOTPRequest\"\xac\x01\n" +
+	"\x12EnrollTOTPResponse\x12;\n" +
+	"\x06secret\x18\x01 \x01(\tB#\x92A\x1c2\x1aBase32-encoded TOTP secret\x98\xb5\x18\x01R\x06secret\x12Y\n" +
+	"\x10provisioning_uri\x18\x02 \x01(\tB.\x92A'2%otpauth:// URI to render as a QR code\x98\xb5\x18\x01R\x0fprovisioningUri\"Z\n" +
+	"\x12ConfirmTOTPRequest\x12D\n" +
+	"\x04code\x18\x01 \x01(\tB0\x92A)2'Current code from the authenticator app\x98\xb5\x18\x01R\x04code\"\x98\x01\n" +
+	"\x13ConfirmTOTPResponse\x12%\n" +
+	"\x06status\x18\x01 \x01(\v2\r.proto.StatusR\x06status\x12Z\n" +
+	"\x0erecovery_codes\x18\x02 \x03(\tB3\x92A,2*Single-use recovery codes. Shown only once\x98\xb5\x18\x01R\rrecoveryCodes\"\xa9\x01\n" +
+	"\x12DisableTOTPRequest\x12P\n" +
+	"\bpassword\x18\x01 \x01(\tB4\x92A-2 User's password for confirmation\xa2\x02\bpassword\x98\xb5\x18\x01R\bpassword\x12A\n" +
+	"\x04code\x18\x02 \x01(\tB-\x92A&2$Current TOTP code or a recovery code\x98\xb5\x18\x01R\x04code\"<\n" +
+	"\x13DisableTOTPResponse\x12%\n" +
+	"\x06status\x18\x01 \x01(\v2\r.proto.StatusR\x06status\"f\n" +
+	"\x1eRegenerateRecoveryCodesRequest\x12D\n" +
+	"\x04code\x18\x01 \x01(\tB0\x92A)2'Current code from the authenticator app\x98\xb5\x18\x01R\x04code\"\x81\x01\n" +
+	"\x1fRegenerateRecoveryCodesResponse\x12^\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tB7\x92A02.New single-use recovery codes. Shown only once\x98\xb5\x18\x01R\rrecoveryCodes\"\xbb\x01\n" +
+	"\x16StartOAuthLoginRequest\x12N\n" +
+	"\bprovider\x18\x01 \x01(\tB2\x92A/2-Identity provider name, e.g. google or githubR\bprovider\x12Q\n" +
+	"\fredirect_uri\x18\x02 \x01(\tB.\x92A+2)Callback URL registered with the providerR\vredirectUri\"\xae\x02\n" +
+	"\x17StartOAuthLoginResponse\x12K\n" +
+	"\rauthorize_url\x18\x01 \x01(\tB&\x92A#2!URL to redirect the user agent toR\fauthorizeUrl\x12_\n" +
+	"\x05state\x18\x02 \x01(\tBI\x92AB2@Opaque CSRF state that must be echoed back to CompleteOAuthLogin\x98\xb5\x18\x01R\x05state\x12e\n" +
+	"\rcode_verifier\x18\x03 \x01(\tB@\x92A927PKCE code verifier to keep client-side until completion\x98\xb5\x18\x01R\fcodeVerifier\"\x93\x03\n" +
+	"\x19CompleteOAuthLoginRequest\x127\n" +
+	"\bprovider\x18\x01 \x01(\tB\x1b\x92A\x182\x16Identity provider nameR\bprovider\x12H\n" +
+	"\x04code\x18\x02 \x01(\tB4\x92A-2+Authorization code returned by the provider\x98\xb5\x18\x01R\x04code\x12@\n" +
+	"\x05state\x18\x03 \x01(\tB*\x92A#2!State returned by StartOAuthLogin\x98\xb5\x18\x01R\x05state\x12\\\n" +
+	"\rcode_verifier\x18\x04 \x01(\tB7\x92A02.PKCE code verifier returned by StartOAuthLogin\x98\xb5\x18\x01R\fcodeVerifier\x12S\n" +
+	"\fredirect_uri\x18\x05 \x01(\tB0\x92A-2+Same callback URL passed to StartOAuthLoginR\vredirectUri\"\xd7\x01\n" +
+	"\x1aCompleteOAuthLoginResponse\x12&\n" +
+	"\x06tokens\x18\x01 \x01(\v2\x0e.proto.JWTPairR\x06tokens\x128\n" +
+	"\rmfa_challenge\x18\x02 \x01(\v2\x13.proto.MFAChallengeR\fmfaChallenge\x12W\n" +
+	"\acreated\x18\x03 \x01(\bB=\x92A:28True when a new account was registered for this identityR\acreated\"\xa0\x02\n" +
+	"\x0eLinkedIdentity\x127\n" +
+	"\bprovider\x18\x01 \x01(\tB\x1b\x92A\x182\x16Identity provider nameR\bprovider\x12>\n" +
+	"\asubject\x18\x02 \x01(\tB$\x92A!2\x1fUser identifier at the providerR\asubject\x129\n" +
+	"\x05email\x18\x03 \x01(\tB#\x92A 2\x1eEmail reported by the providerR\x05email\x12Z\n" +
+	"\tlinked_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB!\x92A\x1e2\x1cWhen the identity was linkedR\blinkedAt\"Y\n" +
+	"\x12VerifyEmailRequest\x12C\n" +
+	"\x05token\x18\x01 \x01(\tB-\x92A&2$Verification token received by email\x98\xb5\x18\x01R\x05token\"<\n" +
+	"\x13VerifyEmailResponse\x12%\n" +
+	"\x06status\x18\x01 \x01(\v2\r.proto.StatusR\x06status\"]\n" +
+	"\x19ResendVerificationRequest\x12@\n" +
+	"\x05email\x18\x01 \x01(\tB*\x92A'2%Email address to send verification toR\x05email\"C\n" +
+	"\x1aResendVerificationResponse\x12%\n" +
+	"\x06status\x18\x01 \x01(\v2\r.proto.StatusR\x06status\"a\n" +
+	"\x1bRequestPasswordResetRequest\x12B\n" +
+	"\x05email\x18\x01 \x01(\tB,\x92A)2'Email address of the account to recoverR\x05email\"E\n" +
+	"\x1cRequestPasswordResetResponse\x12%\n" +
+	"\x06status\x18\x01 \x01(\v2\r.proto.StatusR\x06status\"\xa2\x01\n" +
+	"\x14ResetPasswordRequest\x12E\n" +
+	"\x05token\x18\x01 \x01(\tB/\x92A(2&Password reset token received by email\x98\xb5\x18\x01R\x05token\x12C\n" +
+	"\fnew_password\x18\x02 \x01(\tB \x92A\x192\fNew password\xa2\x02\bpassword\x98\xb5\x18\x01R\vnewPassword\">\n" +
+	"\x15ResetPasswordResponse\x12%\n" +
+	"\x06status\x18\x01 \x01(\v2\r.proto.StatusR\x06status\"\xec\x01\n" +
 	"\x11CreateUserRequest\x12,\n" +
 	"\x04name\x18\x01 \x01(\tB\x18\x92A\x152\x13User's display nameR\x04name\x12/\n" +
-	"\x05email\x18\x02 \x01(\tB\x19\x92A\x162\x14User's email addressR\x05email\x12<\n" +
-	"\bpassword\x18\x03 \x01(\tB \x92A\x1d2\x10Initial password\xa2\x02\bpasswordR\bpassword\x126\n" +
+	"\x05email\x18\x02 \x01(\tB\x19\x92A\x162\x14User's email addressR\x05email\x12@\n" +
+	"\bpassword\x18\x03 \x01(\tB$\x92A\x1d2\x10Initial password\xa2\x02\bpassword\x98\xb5\x18\x01R\bpassword\x126\n" +
 	"\bis_admin\x18\x04 \x01(\bB\x1b\x92A\x182\x16Grant admin privilegesR\aisAdmin\"M\n" +
 	"\x0eGetUserRequest\x12;\n" +
-	"\auser_id\x18\x01 \x01(\tB\"\x92A\x1f2\x1dUser ID to retrieve (UUID v4)R\x06userId\"s\n" +
+	"\auser_id\x18\x01 \x01(\tB\"\x92A\x1f2\x1dUser ID to retrieve (UUID v4)R\x06userId\"\xb1\x05\n" +
 	"\x10ListUsersRequest\x12'\n" +
 	"\x04page\x18\x01 \x01(\x05B\x13\x92A\x102\vPage number:\x011R\x04page\x126\n" +
-	"\x05limit\x18\x02 \x01(\x05B \x92A\x1d2\x0eItems per page:\x0220Y\x00\x00\x00\x00\x00\x00Y@R\x05limit\"h\n" +
+	"\x05limit\x18\x02 \x01(\x05B \x92A\x1d2\x0eItems per page:\x0220Y\x00\x00\x00\x00\x00\x00Y@R\x05limit\x12h\n" +
+	"\femail_prefix\x18\x03 \x01(\tBE\x92AB2@Only users whose email starts with this prefix, case-insensitiveR\vemailPrefix\x12e\n" +
+	"\vname_prefix\x18\x04 \x01(\tBD\x92AA2?Only users whose name starts with this prefix, case-insensitiveR\n" +
+	"namePrefix\x12C\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x0f.proto.UserRoleB\x1e\x92A\x1b2\x19Only users with this roleR\x04role\x12n\n" +
+	"\rcreated_after\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB-\x92A*2(Only users created at or after this timeR\fcreatedAfter\x12k\n" +
+	"\x0ecreated_before\x18\a \x01(\v2\x1a.google.protobuf.TimestampB(\x92A%2#Only users created before this timeR\rcreatedBefore\x12I\n" +
+	"\x06status\x18\b \x01(\x0e2\x11.proto.UserStatusB\x1e\x92A\x1b2\x19Only users in this statusR\x06status\"h\n" +
 	"\x11ListUsersResponse\x12)\n" +
 	"\x05users\x18\x01 \x03(\v2\x13.proto.UserResponseR\x05users\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
-	"\x04page\x18\x03 \x01(\x05R\x04page\"\xa7\x02\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\"\xe6\x02\n" +
 	"\x11UpdateUserRequest\x12N\n" +
 	"\auser_id\x18\x01 \x01(\tB5\x92A220User ID to update (UUID v4) - cannot be modifiedR\x06userId\x12&\n" +
 	"\x04name\x18\x02 \x01(\tB\x12\x92A\x0f2\rNew user nameR\x04name\x12,\n" +
-	"\x05email\x18\x03 \x01(\tB\x16\x92A\x132\x11New email addressR\x05email\x12,\n" +
-	"\bis_admin\x18\x04 \x01(\bB\x11\x92A\x0e2\fAdmin statusR\aisAdmin\x12>\n" +
-	"\bpassword\x18\x05 \x01(\tB\"\x92A\x1f2\x12Password to change\xa2\x02\bpasswordR\bpassword\"J\n" +
+	"\x05email\x18\x03 \x01(\tB\x16\x92A\x132\x11New email addressR\x05email\x12Z\n" +
+	"\bis_admin\x18\x04 \x01(\bB:\x92A725Admin status. The role is left unchanged when omittedH\x00R\aisAdmin\x88\x01\x01\x12B\n" +
+	"\bpassword\x18\x05 \x01(\tB&\x92A\x1f2\x12Password to change\xa2\x02\bpassword\x98\xb5\x18\x01R\bpasswordB\v\n" +
+	"\t_is_admin\"J\n" +
 	"\rDeleteRequest\x129\n" +
-	"\auser_id\x18\x01 \x01(\tB \x92A\x1d2\x1bUser ID to delete (UUID v4)R\x06userId\"7\n" +
+	"\auser_id\x18\x01 \x01(\tB \x92A\x1d2\x1bUser ID to delete (UUID v4)R\x06userId\"\xa4\x01\n" +
 	"\x0eDeleteResponse\x12%\n" +
-	"\x06status\x18\x01 \x01(\v2\r.proto.StatusR\x06status\"\xbc\x02\n" +
+	"\x06status\x18\x01 \x01(\v2\r.proto.StatusR\x06status\x12k\n" +
+	"\bpurge_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB4\x92A12/When the account will be purged unless restoredR\apurgeAt\"P\n" +
+	"\x12RestoreUserRequest\x12:\n" +
+	"\auser_id\x18\x01 \x01(\tB!\x92A\x1e2\x1cUser ID to restore (UUID v4)R\x06userId\"\x9c\x01\n" +
+	"\x12SuspendUserRequest\x12:\n" +
+	"\auser_id\x18\x01 \x01(\tB!\x92A\x1e2\x1cUser ID to suspend (UUID v4)R\x06userId\x12J\n" +
+	"\x06reason\x18\x02 \x01(\tB2\x92A/2-Why the account is suspended, shown to adminsR\x06reason\"V\n" +
+	"\x15ReactivateUserRequest\x12=\n" +
+	"\auser_id\x18\x01 \x01(\tB$\x92A!2\x1fUser ID to reactivate (UUID v4)R\x06userId\"d\n" +
+	"\x12ForceLogoutRequest\x12N\n" +
+	"\auser_id\x18\x01 \x01(\tB5\x92A220User ID whose sessions are invalidated (UUID v4)R\x06userId\"\x90\x01\n" +
+	"\x13ForceLogoutResponse\x12%\n" +
+	"\x06status\x18\x01 \x01(\v2\r.proto.StatusR\x06status\x12R\n" +
+	"\x10sessions_revoked\x18\x02 \x01(\x05B'\x92A$2\"Number of sessions that were endedR\x0fsessionsRevoked\"\xa0\b\n" +
 	"\fUserResponse\x12F\n" +
 	"\auser_id\x18\x01 \x01(\v2\v.proto.UUIDB \x92A\x1d2\x1bImmutable user ID (UUID v4)R\x06userId\x12,\n" +
 	"\x04name\x18\x02 \x01(\tB\x18\x92A\x152\x13User's display nameR\x04name\x12/\n" +
 	"\x05email\x18\x03 \x01(\tB\x19\x92A\x162\x14User's email addressR\x05email\x12,\n" +
 	"\bis_admin\x18\x04 \x01(\bB\x11\x92A\x0e2\fAdmin statusR\aisAdmin\x12W\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x1c\x92A\x192\x17User creation timestampR\tcreatedAt\"\x96\x01\n" +
-	"\x14DeleteAccountRequest\x12;\n" +
-	"\faccess_token\x18\x01 \x01(\tB\x18\x92A\x152\x13JWT token to deleteR\vaccessToken\x12A\n" +
-	"\bpassword\x18\x02 \x01(\tB%\x92A\"2 User's password for confirmationR\bpassword2\x81\x1a\n" +
-	"\x04Auth\x12\xf9\x01\n" +
-	"\x05Login\x12\x13.proto.LoginRequest\x1a\x14.proto.LoginResponse\"\xc4\x01\x92A\xa3\x01\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x1c\x92A\x192\x17User creation timestampR\tcreatedAt\x12^\n" +
+	"\x0eemail_verified\x18\x06 \x01(\bB7\x92A422Whether the user has confirmed their email addressR\remailVerified\x12Y\n" +
+	"\vmfa_enabled\x18\a \x01(\bB8\x92A523Whether TOTP multi-factor authentication is enabledR\n" +
+	"mfaEnabled\x12\x7f\n" +
+	"\x11linked_identities\x18\b \x03(\v2\x15.proto.LinkedIdentityB;\x92A826External identity provider accounts linked to the userR\x10linkedIdentities\x12>\n" +
+	"\x06status\x18\t \x01(\x0e2\x11.proto.UserStatusB\x13\x92A\x102\x0eAccount statusR\x06status\x12]\n" +
+	"\x11suspension_reason\x18\n" +
+	" \x01(\tB0\x92A-2+Reason given when the account was suspendedR\x10suspensionReason\x12z\n" +
+	"\n" +
+	"deleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampB?\x92A<2:When deletion was requested. Set only for DELETED accountsR\tdeletedAt\x12\x8a\x01\n" +
+	"\bpurge_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampBS\x92AP2NWhen the account will be purged unless restored. Set only for DELETED accountsR\apurgeAt\"\x9e\x01\n" +
+	"\x14DeleteAccountRequest\x12?\n" +
+	"\faccess_token\x18\x01 \x01(\tB\x1c\x92A\x152\x13JWT token to delete\x98\xb5\x18\x01R\vaccessToken\x12E\n" +
+	"\bpassword\x18\x02 \x01(\tB)\x92A\"2 User's password for confirmation\x98\xb5\x18\x01R\bpassword\"\xa1\x01\n" +
+	"\x15RestoreAccountRequest\x127\n" +
+	"\x05email\x18\x01 \x01(\tB!\x92A\x1e2\x1cEmail of the deleted accountR\x05email\x12O\n" +
+	"\bpassword\x18\x02 \x01(\tB3\x92A,2\x1fPassword of the deleted account\xa2\x02\bpassword\x98\xb5\x18\x01R\bpassword\"\x1d\n" +
+	"\x1bPurgeDeletedAccountsRequest\"g\n" +
+	"\x1cPurgeDeletedAccountsResponse\x12G\n" +
+	"\buser_ids\x18\x01 \x03(\v2\v.proto.UUIDB\x1f\x92A\x1c2\x1aIDs of the purged accountsR\auserIds\"\x15\n" +
+	"\x13ExportMyDataRequest\"\x9a\x01\n" +
+	"\rAccountExport\x12-\n" +
+	"\aprofile\x18\x01 \x01(\v2\x13.proto.UserResponseR\aprofile\x12Z\n" +
+	"\bsessions\x18\x02 \x03(\v2\x12.proto.SessionInfoB*\x92A'2%Sessions that are currently signed inR\bsessions\"\x88\x01\n" +
+	"\vSessionInfo\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12Z\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x1f\x92A\x1c2\x1aWhen the session signed inR\tcreatedAt\"p\n" +
+	"\x17StreamUserEventsRequest\x12U\n" +
+	"\x05types\x18\x01 \x03(\tB?\x92A<2:Event types to receive, e.g. user.deleted. Empty means allR\x05types\"\x84\a\n" +
+	"\tUserEvent\x12D\n" +
+	"\x02id\x18\x01 \x01(\tB4\x92A12/Unique event identifier, used for deduplicationR\x02id\x12L\n" +
+	"\x06source\x18\x02 \x01(\tB4\x92A12/URI reference identifying the producing serviceR\x06source\x12L\n" +
+	"\fspec_version\x18\x03 \x01(\tB)\x92A&2$CloudEvents spec version, always 1.0R\vspecVersion\x129\n" +
+	"\x04type\x18\x04 \x01(\tB%\x92A\"2 Event type, e.g. user.registeredR\x04type\x12@\n" +
+	"\asubject\x18\x05 \x01(\tB&\x92A#2!ID of the user the event is aboutR\asubject\x12L\n" +
+	"\x04time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x1c\x92A\x192\x17Time the event occurredR\x04time\x12d\n" +
+	"\x11data_content_type\x18\a \x01(\tB8\x92A523Media type of the data, always application/protobufR\x0fdataContentType\x127\n" +
+	"\n" +
+	"registered\x18\n" +
+	" \x01(\v2\x15.proto.UserRegisteredH\x00R\n" +
+	"registered\x12.\n" +
+	"\aupdated\x18\v \x01(\v2\x12.proto.UserUpdatedH\x00R\aupdated\x12.\n" +
+	"\adeleted\x18\f \x01(\v2\x12.proto.UserDeletedH\x00R\adeleted\x12;\n" +
+	"\frole_changed\x18\r \x01(\v2\x16.proto.UserRoleChangedH\x00R\vroleChanged\x12C\n" +
+	"\x10password_changed\x18\x0e \x01(\v2\x16.proto.PasswordChangedH\x00R\x0fpasswordChanged\x12A\n" +
+	"\x0estatus_changed\x18\x0f \x01(\v2\x18.proto.UserStatusChangedH\x00R\rstatusChangedB\x06\n" +
+	"\x04data\"\x9e\x02\n" +
+	"\x0eUserRegistered\x12=\n" +
+	"\auser_id\x18\x01 \x01(\v2\v.proto.UUIDB\x17\x92A\x142\x12ID of the new userR\x06userId\x12,\n" +
+	"\x04name\x18\x02 \x01(\tB\x18\x92A\x152\x13User's display nameR\x04name\x12/\n" +
+	"\x05email\x18\x03 \x01(\tB\x19\x92A\x162\x14User's email addressR\x05email\x12n\n" +
+	"\bprovider\x18\x04 \x01(\tBR\x92AO2MIdentity provider the account was created through, empty for password sign-upR\bprovider\"\xaa\x02\n" +
+	"\vUserUpdated\x12A\n" +
+	"\auser_id\x18\x01 \x01(\v2\v.proto.UUIDB\x1b\x92A\x182\x16ID of the updated userR\x06userId\x126\n" +
+	"\x04name\x18\x02 \x01(\tB\"\x92A\x1f2\x1dDisplay name after the updateR\x04name\x129\n" +
+	"\x05email\x18\x03 \x01(\tB#\x92A 2\x1eEmail address after the updateR\x05email\x12e\n" +
+	"\x0eprevious_email\x18\x04 \x01(\tB>\x92A;29Email address before the update, set only when it changedR\rpreviousEmail\"\xac\x01\n" +
+	"\vUserDeleted\x12A\n" +
+	"\auser_id\x18\x01 \x01(\v2\v.proto.UUIDB\x1b\x92A\x182\x16ID of the deleted userR\x06userId\x12Z\n" +
+	"\bby_admin\x18\x02 \x01(\bB?\x92A<2:Whether an admin deleted the account rather than its ownerR\abyAdmin\"\xf4\x01\n" +
+	"\x0fUserRoleChanged\x12L\n" +
+	"\auser_id\x18\x01 \x01(\v2\v.proto.UUIDB&\x92A#2!ID of the user whose role changedR\x06userId\x12=\n" +
+	"\bis_admin\x18\x02 \x01(\bB\"\x92A\x1f2\x1dAdmin status after the changeR\aisAdmin\x12T\n" +
+	"\n" +
+	"changed_by\x18\x03 \x01(\v2\v.proto.UUIDB(\x92A%2#ID of the admin who made the changeR\tchangedBy\"\xbb\x01\n" +
+	"\x0fPasswordChanged\x12P\n" +
+	"\auser_id\x18\x01 \x01(\v2\v.proto.UUIDB*\x92A'2%ID of the user whose password changedR\x06userId\x12V\n" +
+	"\x06method\x18\x02 \x01(\x0e2\x1b.proto.PasswordChangeMethodB!\x92A\x1e2\x1cHow the password was changedR\x06method\"\xe2\x02\n" +
+	"\x11UserStatusChanged\x12N\n" +
+	"\auser_id\x18\x01 \x01(\v2\v.proto.UUIDB(\x92A%2#ID of the user whose status changedR\x06userId\x12:\n" +
+	"\x0fprevious_status\x18\x02 \x01(\x0e2\x11.proto.UserStatusR\x0epreviousStatus\x12)\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x11.proto.UserStatusR\x06status\x12T\n" +
+	"\n" +
+	"changed_by\x18\x04 \x01(\v2\v.proto.UUIDB(\x92A%2#ID of the admin who made the changeR\tchangedBy\x12@\n" +
+	"\x06reason\x18\x05 \x01(\tB(\x92A%2#Reason given for the change, if anyR\x06reason\"\x90\t\n" +
+	"\n" +
+	"AuditEntry\x122\n" +
+	"\x02id\x18\x01 \x01(\tB\"\x92A\x1f2\x1dUnique audit entry identifierR\x02id\x12W\n" +
+	"\bactor_id\x18\x02 \x01(\tB<\x92A927User ID or service account client ID that made the callR\aactorId\x12h\n" +
+	"\n" +
+	"actor_type\x18\x03 \x01(\x0e2\x12.proto.SubjectTypeB5\x92A220Whether the actor is a user or a service accountR\tactorType\x12O\n" +
+	"\x06method\x18\x04 \x01(\tB7\x92A422Full gRPC method name, e.g. /proto.Auth/DeleteUserR\x06method\x12k\n" +
+	"\bresource\x18\x05 \x01(\tBO\x92AL2JTarget resource path, e.g. /v1/admin/users/{user_id} with the ID filled inR\bresource\x12\xf9\x01\n" +
+	"\x06params\x18\x06 \x03(\v2\x1d.proto.AuditEntry.ParamsEntryB\xc1\x01\x92A\xbd\x012\xba\x01Request fields the caller set, keyed by proto field name, as JSON values. This is the request as sent, not a before/after diff of the resource. Passwords, tokens and secrets are redactedR\x06params\x12N\n" +
+	"\aoutcome\x18\a \x01(\x0e2\x13.proto.AuditOutcomeB\x1f\x92A\x1c2\x1aWhether the call succeededR\aoutcome\x12P\n" +
+	"\vstatus_code\x18\b \x01(\tB/\x92A,2*gRPC status code name, e.g. OK or NotFoundR\n" +
+	"statusCode\x12Z\n" +
+	"\ferror_reason\x18\t \x01(\tB7\x92A422Machine-readable error reason when the call failedR\verrorReason\x12L\n" +
+	"\x04time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x1c\x92A\x192\x17Time the call completedR\x04time\x12J\n" +
+	"\n" +
+	"request_id\x18\v \x01(\tB+\x92A(2&X-Request-Id of the call, when presentR\trequestId\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x94\x04\n" +
+	"\x17ListAuditEntriesRequest\x12=\n" +
+	"\tpage_size\x18\x01 \x01(\x05B \x92A\x1d2\x0eItems per page:\x0250Y\x00\x00\x00\x00\x00@\x7f@R\bpageSize\x12Y\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB:\x92A725Token from a previous response to fetch the next pageR\tpageToken\x12F\n" +
+	"\bactor_id\x18\x03 \x01(\tB+\x92A(2&Only return entries made by this actorR\aactorId\x12O\n" +
+	"\x06method\x18\x04 \x01(\tB7\x92A422Only return entries for this full gRPC method nameR\x06method\x12[\n" +
+	"\bresource\x18\x05 \x01(\tB?\x92A<2:Only return entries whose resource starts with this prefixR\bresource\x12i\n" +
+	"\x05since\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB7\x92A422Only return entries recorded at or after this timeR\x05since\"\xb3\x01\n" +
+	"\x18ListAuditEntriesResponse\x12+\n" +
+	"\aentries\x18\x01 \x03(\v2\x11.proto.AuditEntryR\aentries\x12j\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tBB\x92A?2=Token for the next page; empty when there are no more entriesR\rnextPageToken*Z\n" +
+	"\tMFAMethod\x12\x1a\n" +
+	"\x16MFA_METHOD_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fMFA_METHOD_TOTP\x10\x01\x12\x1c\n" +
+	"\x18MFA_METHOD_RECOVERY_CODE\x10\x02*\\\n" +
+	"\vSubjectType\x12\x1c\n" +
+	"\x18SUBJECT_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11SUBJECT_TYPE_USER\x10\x01\x12\x18\n" +
+	"\x14SUBJECT_TYPE_SERVICE\x10\x02*V\n" +
+	"\tTokenType\x12\x1a\n" +
+	"\x16TOKEN_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TOKEN_TYPE_ACCESS\x10\x01\x12\x16\n" +
+	"\x12TOKEN_TYPE_REFRESH\x10\x02*N\n" +
+	"\bUserRole\x12\x19\n" +
+	"\x15USER_ROLE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eUSER_ROLE_USER\x10\x01\x12\x13\n" +
+	"\x0fUSER_ROLE_ADMIN\x10\x02*\x9b\x01\n" +
+	"\n" +
+	"UserStatus\x12\x1b\n" +
+	"\x17USER_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12USER_STATUS_ACTIVE\x10\x01\x12\x19\n" +
+	"\x15USER_STATUS_SUSPENDED\x10\x02\x12$\n" +
+	" USER_STATUS_PENDING_VERIFICATION\x10\x03\x12\x17\n" +
+	"\x13USER_STATUS_DELETED\x10\x04*\xa5\x01\n" +
+	"\x14PasswordChangeMethod\x12&\n" +
+	"\"PASSWORD_CHANGE_METHOD_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dPASSWORD_CHANGE_METHOD_CHANGE\x10\x01\x12 \n" +
+	"\x1cPASSWORD_CHANGE_METHOD_RESET\x10\x02\x12 \n" +
+	"\x1cPASSWORD_CHANGE_METHOD_ADMIN\x10\x03*c\n" +
+	"\fAuditOutcome\x12\x1d\n" +
+	"\x19AUDIT_OUTCOME_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15AUDIT_OUTCOME_SUCCESS\x10\x01\x12\x19\n" +
+	"\x15AUDIT_OUTCOME_FAILURE\x10\x022\xfck\n" +
+	"\x04Auth\x12\xd8\x02\n" +
+	"\x05Login\x12\x13.proto.LoginRequest\x1a\x14.proto.LoginResponse\"\xa3\x02\x92A\xee\x01\n" +
 	"\x0eAuthentication\x12\n" +
 	"User login\x1a)Authenticates user and returns JWT tokensJ%\n" +
 	"\x03200\x12\x1e\n" +
@@ -1447,15 +5620,56 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\x03400\x12\x15\n" +
 	"\x13Invalid credentialsJ\x15\n" +
 	"\x03401\x12\x0e\n" +
-	"\fUnauthorized\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12\xfb\x01\n" +
-	"\bRegister\x12\x16.proto.RegisterRequest\x1a\x17.proto.RegisterResponse\"\xbd\x01\x92A\x99\x01\n" +
+	"\fUnauthorizedJI\n" +
+	"\x03429\x12B\n" +
+	"@Too many attempts or account temporarily locked; see Retry-After\x90\xb5\x18\x00\xa2\xb5\x18\x06\b\x14\x10<\x18\x01\xa2\xb5\x18\x06\b\x05\x10<\x18\x02\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12\xb8\x02\n" +
+	"\bRegister\x12\x16.proto.RegisterRequest\x1a\x17.proto.RegisterResponse\"\xfa\x01\x92A\xcb\x01\n" +
 	"\x0eAuthentication\x12\x11Register new user\x1a\x18Creates new user accountJ\"\n" +
 	"\x03201\x12\x1b\n" +
 	"\x19User created successfullyJ\x18\n" +
 	"\x03400\x12\x11\n" +
 	"\x0fInvalid requestJ\x1c\n" +
 	"\x03409\x12\x15\n" +
-	"\x13User already exists\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/register\x12\xec\x01\n" +
+	"\x13User already existsJ0\n" +
+	"\x03429\x12)\n" +
+	"'Too many registrations; see Retry-After\x90\xb5\x18\x00\xa2\xb5\x18\a\b\n" +
+	"\x10\x90\x1c\x18\x01\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/register\x12\xa8\x02\n" +
+	"\vVerifyEmail\x12\x19.proto.VerifyEmailRequest\x1a\x1a.proto.VerifyEmailResponse\"\xe1\x01\x92A\xb9\x01\n" +
+	"\x0eAuthentication\x12\x14Verify email address\x1a;Confirms user's email address using the token sent by emailJ$\n" +
+	"\x03200\x12\x1d\n" +
+	"\x1bEmail verified successfullyJ.\n" +
+	"\x03400\x12'\n" +
+	"%Invalid or expired verification token\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/auth/email/verify\x12\xaa\x02\n" +
+	"\x12ResendVerification\x12 .proto.ResendVerificationRequest\x1a!.proto.ResendVerificationResponse\"\xce\x01\x92A\xa6\x01\n" +
+	"\x0eAuthentication\x12\x19Resend verification email\x1a=Sends a new verification token to an unverified email addressJ \n" +
+	"\x03200\x12\x19\n" +
+	"\x17Verification email sentJ\x18\n" +
+	"\x03400\x12\x11\n" +
+	"\x0fInvalid request\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/auth/email/resend\x12\xcc\x02\n" +
+	"\x14RequestPasswordReset\x12\".proto.RequestPasswordResetRequest\x1a#.proto.RequestPasswordResetResponse\"\xea\x01\x92A\xbf\x01\n" +
+	"\x0eAuthentication\x12\x16Request password reset\x1adSends a password reset token to the user's email. Always succeeds to avoid leaking registered emailsJ/\n" +
+	"\x03200\x12(\n" +
+	"&Reset email sent if the account exists\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/password/forgot\x12\x99\x02\n" +
+	"\rResetPassword\x12\x1b.proto.ResetPasswordRequest\x1a\x1c.proto.ResetPasswordResponse\"\xcc\x01\x92A\xa2\x01\n" +
+	"\x0eAuthentication\x12\x0eReset password\x1a1Sets a new password using the token sent by emailJ$\n" +
+	"\x03200\x12\x1d\n" +
+	"\x1bPassword reset successfullyJ'\n" +
+	"\x03400\x12 \n" +
+	"\x1eInvalid or expired reset token\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/password/reset\x12\xc1\x02\n" +
+	"\x0fStartOAuthLogin\x12\x1d.proto.StartOAuthLoginRequest\x1a\x1e.proto.StartOAuthLoginResponse\"\xee\x01\x92A\xbc\x01\n" +
+	"\x0eAuthentication\x12\x12Start social login\x1aUReturns the identity provider authorization URL together with state and PKCE verifierJ$\n" +
+	"\x03200\x12\x1d\n" +
+	"\x1bAuthorization URL generatedJ\x19\n" +
+	"\x03404\x12\x12\n" +
+	"\x10Unknown provider\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/auth/oauth/{provider}/start\x12\xff\x02\n" +
+	"\x12CompleteOAuthLogin\x12 .proto.CompleteOAuthLoginRequest\x1a!.proto.CompleteOAuthLoginResponse\"\xa3\x02\x92A\xee\x01\n" +
+	"\x0eAuthentication\x12\x15Complete social login\x1aPExchanges the authorization code for JWT tokens, creating or linking the accountJ%\n" +
+	"\x03200\x12\x1e\n" +
+	"\x1cSuccess response with tokensJ\x1e\n" +
+	"\x03400\x12\x17\n" +
+	"\x15Invalid state or codeJ,\n" +
+	"\x03401\x12%\n" +
+	"#Identity provider rejected the code\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/auth/oauth/{provider}/complete\x12\xec\x01\n" +
 	"\x06Logout\x12\x14.proto.LogoutRequest\x1a\x15.proto.LogoutResponse\"\xb4\x01\x92A\x92\x01\n" +
 	"\x0eAuthentication\x12\vUser logout\x1a(Invalidates user's authentication tokensJ \n" +
 	"\x03204\x12\x19\n" +
@@ -1464,45 +5678,181 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\fUnauthorizedb\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/logout\x12\xb1\x02\n" +
-	"\x0eChangePassword\x12\x1c.proto.ChangePasswordRequest\x1a\x1d.proto.ChangePasswordResponse\"\xe1\x01\x92A\xb9\x01\n" +
-	"\x0fUser Management\x12\x14Change user password\x1a%Updates authenticated user's passwordJ&\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/logout\x12\xc5\x03\n" +
+	"\x0eChangePassword\x12\x1c.proto.ChangePasswordRequest\x1a\x1d.proto.ChangePasswordResponse\"\xf5\x02\x92A\xc6\x02\n" +
+	"\x0fUser Management\x12\x14Change user password\x1aZUpdates authenticated user's password. Requires reauthentication within the last 5 minutesJ&\n" +
 	"\x03204\x12\x1f\n" +
 	"\x1dPassword changed successfullyJ\x18\n" +
 	"\x03400\x12\x11\n" +
-	"\x0fInvalid requestJ\x15\n" +
+	"\x0fInvalid requestJ2\n" +
+	"\x03401\x12+\n" +
+	")Unauthorized or reauthentication requiredb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00r9\n" +
+	"7\n" +
+	"\x0eX-Reauth-Token\x12!Step-up token from Reauthenticate\x18\x01(\x01\x90\xb5\x18\x01\xaa\xb5\x18\x03\b\xac\x02\x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/v1/users/me/password\x12\xee\x03\n" +
+	"\x0eReauthenticate\x12\x1c.proto.ReauthenticateRequest\x1a\x1d.proto.ReauthenticateResponse\"\x9e\x03\x92A\xe9\x02\n" +
+	"\x0eAuthentication\x12\x10Confirm identity\x1a\xc6\x01Checks the caller's password, and TOTP code when MFA is enabled, and issues a short-lived step-up token. Send it in the X-Reauth-Token header to change the password or email or to delete the accountJ\x16\n" +
+	"\x03200\x12\x0f\n" +
+	"\rStep-up tokenJ%\n" +
+	"\x03401\x12\x1e\n" +
+	"\x1cInvalid password or MFA codeJ+\n" +
+	"\x03429\x12$\n" +
+	"\"Too many requests; see Retry-Afterb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x01\xa2\xb5\x18\a\b\x05\x10\xac\x02\x18\x03\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/reauthenticate\x12\xa3\x03\n" +
+	"\tVerifyMFA\x12\x17.proto.VerifyMFARequest\x1a\x18.proto.VerifyMFAResponse\"\xe2\x02\x92A\xb2\x02\n" +
+	"\x0eAuthentication\x12\x12Complete MFA login\x1aWExchanges the MFA challenge token from Login and a TOTP or recovery code for JWT tokensJ%\n" +
+	"\x03200\x12\x1e\n" +
+	"\x1cSuccess response with tokensJ_\n" +
+	"\x03401\x12X\n" +
+	"VInvalid or expired challenge or code. The challenge is invalidated after 5 wrong codesJ+\n" +
+	"\x03429\x12$\n" +
+	"\"Too many attempts; see Retry-After\x90\xb5\x18\x00\xa2\xb5\x18\x06\b\n" +
+	"\x10<\x18\x01\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/auth/mfa/verify\x12\xcc\x02\n" +
+	"\n" +
+	"EnrollTOTP\x12\x18.proto.EnrollTOTPRequest\x1a\x19.proto.EnrollTOTPResponse\"\x88\x02\x92A\xe0\x01\n" +
+	"\x0fUser Management\x12\x15Start TOTP enrollment\x1aNGenerates a new TOTP secret. MFA is not enforced until the secret is confirmedJ\x1e\n" +
+	"\x03200\x12\x17\n" +
+	"\x15TOTP secret generatedJ\x15\n" +
+	"\x03401\x12\x0e\n" +
+	"\fUnauthorizedJ\x1d\n" +
+	"\x03409\x12\x16\n" +
+	"\x14TOTP already enabledb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/users/me/mfa/totp\x12\xeb\x02\n" +
+	"\vConfirmTOTP\x12\x19.proto.ConfirmTOTPRequest\x1a\x1a.proto.ConfirmTOTPResponse\"\xa4\x02\x92A\xf4\x01\n" +
+	"\x0fUser Management\x12\x17Confirm TOTP enrollment\x1aXEnables MFA after verifying a code from the authenticator app and returns recovery codesJ.\n" +
+	"\x03200\x12'\n" +
+	"%TOTP enabled, recovery codes returnedJ\x15\n" +
+	"\x03400\x12\x0e\n" +
+	"\fInvalid codeJ\x15\n" +
+	"\x03401\x12\x0e\n" +
+	"\fUnauthorizedb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/users/me/mfa/totp/confirm\x12\xaa\x02\n" +
+	"\vDisableTOTP\x12\x19.proto.DisableTOTPRequest\x1a\x1a.proto.DisableTOTPResponse\"\xe3\x01\x92A\xbb\x01\n" +
+	"\x0fUser Management\x12\fDisable TOTP\x1a6Turns off MFA and invalidates remaining recovery codesJ\x16\n" +
+	"\x03204\x12\x0f\n" +
+	"\rTOTP disabledJ!\n" +
+	"\x03400\x12\x1a\n" +
+	"\x18Invalid password or codeJ\x15\n" +
+	"\x03401\x12\x0e\n" +
+	"\fUnauthorizedb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x1a:\x01**\x15/v1/users/me/mfa/totp\x12\xf2\x02\n" +
+	"\x17RegenerateRecoveryCodes\x12%.proto.RegenerateRecoveryCodesRequest\x1a&.proto.RegenerateRecoveryCodesResponse\"\x87\x02\x92A\xd5\x01\n" +
+	"\x0fUser Management\x12\x19Regenerate recovery codes\x1aAReplaces all recovery codes. Previously issued codes stop workingJ$\n" +
+	"\x03200\x12\x1d\n" +
+	"\x1bNew recovery codes returnedJ\x15\n" +
+	"\x03400\x12\x0e\n" +
+	"\fInvalid codeJ\x15\n" +
 	"\x03401\x12\x0e\n" +
 	"\fUnauthorizedb\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/v1/users/me/password\x12\xee\x01\n" +
-	"\fRefreshToken\x12\x15.proto.RefreshRequest\x1a\x16.proto.RefreshResponse\"\xae\x01\x92A\x8b\x01\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/users/me/mfa/recovery-codes\x12\xa5\x02\n" +
+	"\fRefreshToken\x12\x15.proto.RefreshRequest\x1a\x16.proto.RefreshResponse\"\xe5\x01\x92A\xb8\x01\n" +
 	"\x0eAuthentication\x12\x0eRefresh tokens\x1a*Generates new JWT pair using refresh tokenJ\x1d\n" +
 	"\x03200\x12\x16\n" +
 	"\x14New tokens generatedJ\x1e\n" +
 	"\x03401\x12\x17\n" +
-	"\x15Invalid refresh token\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/auth/refresh\x12\xad\x02\n" +
-	"\rDeleteAccount\x12\x1b.proto.DeleteAccountRequest\x1a\r.proto.Status\"\xef\x01\x92A\xd0\x01\n" +
-	"\x0fUser Management\x12\x13Delete user account\x1a)Permanently delete current user's accountJ%\n" +
+	"\x15Invalid refresh tokenJ+\n" +
+	"\x03429\x12$\n" +
+	"\"Too many requests; see Retry-After\x90\xb5\x18\x00\xa2\xb5\x18\x06\b\x1e\x10<\x18\x01\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/auth/refresh\x12\x9c\x04\n" +
+	"\rDeleteAccount\x12\x1b.proto.DeleteAccountRequest\x1a\r.proto.Status\"\xde\x03\x92A\xb8\x03\n" +
+	"\x0fUser Management\x12\x13Delete user account\x1a\xb8\x01Schedules deletion of current user's account. It can be restored with RestoreAccount until the grace period ends, then it is purged. Requires reauthentication within the last 5 minutesJ%\n" +
 	"\x03204\x12\x1e\n" +
 	"\x1cAccount deleted successfullyJ\x19\n" +
 	"\x03400\x12\x12\n" +
-	"\x10Invalid passwordJ\x15\n" +
-	"\x03401\x12\x0e\n" +
-	"\fUnauthorizedJ\x12\n" +
+	"\x10Invalid passwordJ2\n" +
+	"\x03401\x12+\n" +
+	")Unauthorized or reauthentication requiredJ\x12\n" +
 	"\x03403\x12\v\n" +
 	"\tForbiddenb\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02\x11:\x01**\f/v1/users/me\x12\xef\x01\n" +
-	"\rUpdateProfile\x12\x1b.proto.UpdateProfileRequest\x1a\x13.proto.UserResponse\"\xab\x01\x92A\x8c\x01\n" +
-	"\x0fUser Management\x12\x13Update user profile\x1a+Updates authenticated user's name and emailJ%\n" +
+	"bearerAuth\x12\x00r9\n" +
+	"7\n" +
+	"\x0eX-Reauth-Token\x12!Step-up token from Reauthenticate\x18\x01(\x01\x90\xb5\x18\x01\xaa\xb5\x18\x03\b\xac\x02\x82\xd3\xe4\x93\x02\x11:\x01**\f/v1/users/me\x12\xb4\x03\n" +
+	"\x0eRestoreAccount\x12\x1c.proto.RestoreAccountRequest\x1a\x13.proto.UserResponse\"\xee\x02\x92A\xb7\x02\n" +
+	"\x0fUser Management\x12\x17Restore deleted account\x1a~Cancels a pending self-service deletion during the grace period. Accounts deleted by an admin can only be restored by an adminJ\x19\n" +
+	"\x03200\x12\x12\n" +
+	"\x10Account restoredJ\x1c\n" +
+	"\x03401\x12\x15\n" +
+	"\x13Invalid credentialsJ(\n" +
+	"\x03403\x12!\n" +
+	"\x1fAccount was deleted by an adminJ(\n" +
+	"\x03409\x12!\n" +
+	"\x1fAccount is not pending deletion\x90\xb5\x18\x00\xa2\xb5\x18\x06\b\x14\x10<\x18\x01\xa2\xb5\x18\x06\b\x05\x10<\x18\x02\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/auth/restore\x12\xeb\x02\n" +
+	"\fExportMyData\x12\x1a.proto.ExportMyDataRequest\x1a\x14.google.api.HttpBody\"\xa8\x02\x92A\x85\x02\n" +
+	"\x0fUser Management\x12\x0eExport my data\x1a\x80\x01Returns a zip archive of JSON documents with everything the services hold about the current user: profile, sessions and bookings:\x0fapplication/zipJ%\n" +
+	"\x03200\x12\x1e\n" +
+	"\vZip archive\x12\x0f\n" +
+	"\r\x9a\x02\x01\a\xa2\x02\x06binaryJ\x15\n" +
+	"\x03401\x12\x0e\n" +
+	"\fUnauthorizedb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/users/me/export\x12\xd4\x03\n" +
+	"\rUpdateProfile\x12\x1b.proto.UpdateProfileRequest\x1a\x13.proto.UserResponse\"\x90\x03\x92A\xe3\x02\n" +
+	"\x0fUser Management\x12\x13Update user profile\x1asUpdates authenticated user's name and email. Changing the email requires reauthentication within the last 5 minutesJ%\n" +
 	"\x03200\x12\x1e\n" +
-	"\x1cProfile updated successfullyb\x10\n" +
+	"\x1cProfile updated successfullyJ2\n" +
+	"\x03401\x12+\n" +
+	")Unauthorized or reauthentication requiredb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00rY\n" +
+	"W\n" +
+	"\x0eX-Reauth-Token\x12CStep-up token from Reauthenticate, required when changing the email\x18\x01\x90\xb5\x18\x01\xaa\xb5\x18\n" +
+	"\b\xac\x02\x12\x05email\x82\xd3\xe4\x93\x02\x11:\x01*\x1a\f/v1/users/me\x12\xa2\x02\n" +
+	"\x1bIssueClientCredentialsToken\x12\x1f.proto.ClientCredentialsRequest\x1a .proto.ClientCredentialsResponse\"\xbf\x01\x92A\x9e\x01\n" +
+	"\x0eAuthentication\x12\x13Issue service token\x1a4OAuth2 client credentials grant for service accountsJ\x1c\n" +
+	"\x03200\x12\x15\n" +
+	"\x13Access token issuedJ#\n" +
+	"\x03401\x12\x1c\n" +
+	"\x1aInvalid client credentials\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/token\x12\x82\x02\n" +
+	"\rValidateToken\x12\x1b.proto.ValidateTokenRequest\x1a\x1c.proto.ValidateTokenResponse\"\xb5\x01\x92A\x91\x01\n" +
+	"\x0eAuthentication\x12\x0eValidate token\x1aSChecks whether an access token is valid. Prefer IntrospectToken for service callersJ\x1a\n" +
+	"\x03200\x12\x13\n" +
+	"\x11Validation result\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/validate\x12\xe9\x02\n" +
+	"\x0fIntrospectToken\x12\x1d.proto.IntrospectTokenRequest\x1a\x1e.proto.IntrospectTokenResponse\"\x96\x02\x92A\xf0\x01\n" +
+	"\x0eAuthentication\x12\x10Introspect token\x1aURFC 7662 token introspection. Inactive, expired or unknown tokens return active=falseJ\x1d\n" +
+	"\x03200\x12\x16\n" +
+	"\x14Introspection resultJ\x15\n" +
+	"\x03401\x12\x0e\n" +
+	"\fUnauthorizedJ-\n" +
+	"\x03403\x12&\n" +
+	"$Forbidden - service account requiredb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x03\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/auth/introspect\x12\xfe\x02\n" +
+	"\x14PurgeDeletedAccounts\x12\".proto.PurgeDeletedAccountsRequest\x1a#.proto.PurgeDeletedAccountsResponse\"\x9c\x02\x92A\xf2\x01\n" +
+	"\x0eAuthentication\x12\x16Purge deleted accounts\x1amPermanently removes accounts whose deletion grace period has ended. Called on a schedule by a service accountJ\x18\n" +
+	"\x03200\x12\x11\n" +
+	"\x0fAccounts purgedJ-\n" +
+	"\x03403\x12&\n" +
+	"$Forbidden - service account requiredb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x03\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/accounts/purge\x12\xf2\x02\n" +
+	"\x10StreamUserEvents\x12\x1e.proto.StreamUserEventsRequest\x1a\x10.proto.UserEvent\"\xa9\x02\x92A\x8a\x02\n" +
+	"\x0eAuthentication\x12\x12Stream user events\x1alStreams account lifecycle events (registration, updates, deletion, role and password changes) as they happenJ\x1e\n" +
+	"\x03200\x12\x17\n" +
+	"\x15Stream of user eventsJ\x15\n" +
+	"\x03401\x12\x0e\n" +
+	"\fUnauthorizedJ-\n" +
+	"\x03403\x12&\n" +
+	"$Forbidden - service account requiredb\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x11:\x01*\x1a\f/v1/users/me\x12J\n" +
-	"\rValidateToken\x12\x1b.proto.ValidateTokenRequest\x1a\x1c.proto.ValidateTokenResponse\x12\x95\x02\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x03\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/auth/events0\x01\x12\x95\x02\n" +
 	"\n" +
 	"CreateUser\x12\x18.proto.CreateUserRequest\x1a\x13.proto.UserResponse\"\xd7\x01\x92A\xb5\x01\n" +
 	"\x05Admin\x12\x17Create new user (Admin)\x1a1Create new user account with specified parametersJ\"\n" +
@@ -1544,10 +5894,10 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\x0eUser not foundb\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/v1/admin/users/{user_id}\x12\x9d\x02\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/v1/admin/users/{user_id}\x12\xf8\x02\n" +
 	"\n" +
-	"DeleteUser\x12\x14.proto.DeleteRequest\x1a\x15.proto.DeleteResponse\"\xe1\x01\x92A\xb8\x01\n" +
-	"\x05Admin\x12\x13Delete user (Admin)\x1a\x1fPermanently delete user accountJ\"\n" +
+	"DeleteUser\x12\x14.proto.DeleteRequest\x1a\x15.proto.DeleteResponse\"\xbc\x02\x92A\x93\x02\n" +
+	"\x05Admin\x12\x13Delete user (Admin)\x1azSchedules deletion of the user account. It can be restored with RestoreUser until the grace period ends, then it is purgedJ\"\n" +
 	"\x03204\x12\x1b\n" +
 	"\x19User deleted successfullyJ*\n" +
 	"\x03403\x12#\n" +
@@ -1556,13 +5906,101 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\x0eUser not foundb\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02\x1b*\x19/v1/admin/users/{user_id}B\xbd\x02\x92A\x83\x02\x12\x89\x01\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02\x1b*\x19/v1/admin/users/{user_id}\x12\xf2\x02\n" +
+	"\vSuspendUser\x12\x19.proto.SuspendUserRequest\x1a\x13.proto.UserResponse\"\xb2\x02\x92A\xfe\x01\n" +
+	"\x05Admin\x12\x14Suspend user (Admin)\x1aoTemporarily disables the account and ends all of its sessions. A suspended user cannot log in until reactivatedJ\x17\n" +
+	"\x03200\x12\x10\n" +
+	"\x0eUser suspendedJ*\n" +
+	"\x03403\x12#\n" +
+	"!Forbidden - admin access requiredJ\x17\n" +
+	"\x03404\x12\x10\n" +
+	"\x0eUser not foundb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/admin/users/{user_id}/suspend\x12\xc0\x02\n" +
+	"\x0eReactivateUser\x12\x1c.proto.ReactivateUserRequest\x1a\x13.proto.UserResponse\"\xfa\x01\x92A\xc3\x01\n" +
+	"\x05Admin\x12\x17Reactivate user (Admin)\x1a/Lifts a suspension so the user can log in againJ\x19\n" +
+	"\x03200\x12\x12\n" +
+	"\x10User reactivatedJ*\n" +
+	"\x03403\x12#\n" +
+	"!Forbidden - admin access requiredJ\x17\n" +
+	"\x03404\x12\x10\n" +
+	"\x0eUser not foundb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02):\x01*\"$/v1/admin/users/{user_id}/reactivate\x12\xef\x02\n" +
+	"\vForceLogout\x12\x19.proto.ForceLogoutRequest\x1a\x1a.proto.ForceLogoutResponse\"\xa8\x02\x92A\xf5\x01\n" +
+	"\x05Admin\x12\x14Force logout (Admin)\x1a`Invalidates every session of the user. Issued access and refresh tokens stop working immediatelyJ\x1d\n" +
+	"\x03200\x12\x16\n" +
+	"\x14Sessions invalidatedJ*\n" +
+	"\x03403\x12#\n" +
+	"!Forbidden - admin access requiredJ\x17\n" +
+	"\x03404\x12\x10\n" +
+	"\x0eUser not foundb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02%:\x01*\" /v1/admin/users/{user_id}/logout\x12\xdb\x02\n" +
+	"\vRestoreUser\x12\x19.proto.RestoreUserRequest\x1a\x13.proto.UserResponse\"\x9b\x02\x92A\xe7\x01\n" +
+	"\x05Admin\x12\x14Restore user (Admin)\x1a2Cancels a pending deletion during the grace periodJ\x16\n" +
+	"\x03200\x12\x0f\n" +
+	"\rUser restoredJ*\n" +
+	"\x03403\x12#\n" +
+	"!Forbidden - admin access requiredJ\x17\n" +
+	"\x03404\x12\x10\n" +
+	"\x0eUser not foundJ%\n" +
+	"\x03409\x12\x1e\n" +
+	"\x1cUser is not pending deletionb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/admin/users/{user_id}/restore\x12\x96\x03\n" +
+	"\x14CreateServiceAccount\x12\".proto.CreateServiceAccountRequest\x1a .proto.ServiceAccountCredentials\"\xb7\x02\x92A\x8a\x02\n" +
+	"\x05Admin\x12\x1eCreate service account (Admin)\x1aXRegisters a service account and returns its client secret. The secret is shown only onceJ \n" +
+	"\x03201\x12\x19\n" +
+	"\x17Service account createdJ*\n" +
+	"\x03403\x12#\n" +
+	"!Forbidden - admin access requiredJ'\n" +
+	"\x03409\x12 \n" +
+	"\x1eService account already existsb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/admin/service-accounts\x12\xaa\x03\n" +
+	"\x17RotateServiceAccountKey\x12%.proto.RotateServiceAccountKeyRequest\x1a .proto.ServiceAccountCredentials\"\xc5\x02\x92A\x85\x02\n" +
+	"\x05Admin\x12\"Rotate service account key (Admin)\x1aZIssues a new client secret. The previous secret stays valid for the requested grace periodJ\x1a\n" +
+	"\x03200\x12\x13\n" +
+	"\x11New secret issuedJ*\n" +
+	"\x03403\x12#\n" +
+	"!Forbidden - admin access requiredJ\"\n" +
+	"\x03404\x12\x1b\n" +
+	"\x19Service account not foundb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x022:\x01*\"-/v1/admin/service-accounts/{client_id}/rotate\x12\x83\x03\n" +
+	"\x14RevokeServiceAccount\x12\".proto.RevokeServiceAccountRequest\x1a#.proto.RevokeServiceAccountResponse\"\xa1\x02\x92A\xeb\x01\n" +
+	"\x05Admin\x12\x1eRevoke service account (Admin)\x1a>Disables the service account and invalidates all of its tokensJ \n" +
+	"\x03204\x12\x19\n" +
+	"\x17Service account revokedJ*\n" +
+	"\x03403\x12#\n" +
+	"!Forbidden - admin access requiredJ\"\n" +
+	"\x03404\x12\x1b\n" +
+	"\x19Service account not foundb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02(*&/v1/admin/service-accounts/{client_id}\x12\xaa\x02\n" +
+	"\x10ListAuditEntries\x12\x1e.proto.ListAuditEntriesRequest\x1a\x1f.proto.ListAuditEntriesResponse\"\xd4\x01\x92A\xb5\x01\n" +
+	"\x05Admin\x12\x1aList audit entries (Admin)\x1a0Retrieve recorded admin operations, newest firstJ \n" +
+	"\x03200\x12\x19\n" +
+	"\x17Audit entries retrievedJ*\n" +
+	"\x03403\x12#\n" +
+	"!Forbidden - admin access requiredb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/admin/auditB\xd0\x02\x92A\x96\x02\x12\x89\x01\n" +
 	"\x10Auth Service API\"D\n" +
 	"\aJunBSer\x12\x1ahttps://github.com/JunBSer\x1a\x1daleksei.radzetskiiw@gmail.com**\n" +
-	"\x03MIT\x12#https://opensource.org/licenses/MIT2\x032.0\x1a\x0elocalhost:8080*\x01\x012\x10application/json:\x10application/jsonZ>\n" +
-	"<\n" +
+	"\x03MIT\x12#https://opensource.org/licenses/MIT2\x032.0\x1a\x0elocalhost:8080*\x01\x012\x10application/json:\x10application/jsonZQ\n" +
+	"O\n" +
 	"\n" +
-	"bearerAuth\x12.\x12,JWT Authentication. Format: 'Bearer {token}'Z4github.com/JunBSer/services_proto/auth/gen/go;authpbb\x06proto3"
+	"bearerAuth\x12A\b\x02\x12,JWT Authentication. Format: 'Bearer {token}'\x1a\rAuthorization \x02Z4github.com/JunBSer/services_proto/auth/gen/go;authpbb\x06proto3"
 
 var (
 	file_proto_auth_proto_rawDescOnce sync.Once
@@ -1576,78 +6014,261 @@ func file_proto_auth_proto_rawDescGZIP() []byte {
 	return file_proto_auth_proto_rawDescData
 }
 
-var file_proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_proto_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
 var file_proto_auth_proto_goTypes = []any{
-	(*UUID)(nil),                   // 0: proto.UUID
-	(*JWTPair)(nil),                // 1: proto.JWTPair
-	(*Status)(nil),                 // 2: proto.Status
-	(*LoginRequest)(nil),           // 3: proto.LoginRequest
-	(*LoginResponse)(nil),          // 4: proto.LoginResponse
-	(*RegisterRequest)(nil),        // 5: proto.RegisterRequest
-	(*RegisterResponse)(nil),       // 6: proto.RegisterResponse
-	(*LogoutRequest)(nil),          // 7: proto.LogoutRequest
-	(*LogoutResponse)(nil),         // 8: proto.LogoutResponse
-	(*UpdateProfileRequest)(nil),   // 9: proto.UpdateProfileRequest
-	(*ChangePasswordRequest)(nil),  // 10: proto.ChangePasswordRequest
-	(*ChangePasswordResponse)(nil), // 11: proto.ChangePasswordResponse
-	(*RefreshRequest)(nil),         // 12: proto.RefreshRequest
-	(*RefreshResponse)(nil),        // 13: proto.RefreshResponse
-	(*ValidateTokenRequest)(nil),   // 14: proto.ValidateTokenRequest
-	(*ValidateTokenResponse)(nil),  // 15: proto.ValidateTokenResponse
-	(*CreateUserRequest)(nil),      // 16: proto.CreateUserRequest
-	(*GetUserRequest)(nil),         // 17: proto.GetUserRequest
-	(*ListUsersRequest)(nil),       // 18: proto.ListUsersRequest
-	(*ListUsersResponse)(nil),      // 19: proto.ListUsersResponse
-	(*UpdateUserRequest)(nil),      // 20: proto.UpdateUserRequest
-	(*DeleteRequest)(nil),          // 21: proto.DeleteRequest
-	(*DeleteResponse)(nil),         // 22: proto.DeleteResponse
-	(*UserResponse)(nil),           // 23: proto.UserResponse
-	(*DeleteAccountRequest)(nil),   // 24: proto.DeleteAccountRequest
-	(*timestamppb.Timestamp)(nil),  // 25: google.protobuf.Timestamp
+	(MFAMethod)(0),                          // 0: proto.MFAMethod
+	(SubjectType)(0),                        // 1: proto.SubjectType
+	(TokenType)(0),                          // 2: proto.TokenType
+	(UserRole)(0),                           // 3: proto.UserRole
+	(UserStatus)(0),                         // 4: proto.UserStatus
+	(PasswordChangeMethod)(0),               // 5: proto.PasswordChangeMethod
+	(AuditOutcome)(0),                       // 6: proto.AuditOutcome
+	(*UUID)(nil),                            // 7: proto.UUID
+	(*JWTPair)(nil),                         // 8: proto.JWTPair
+	(*Status)(nil),                          // 9: proto.Status
+	(*LoginRequest)(nil),                    // 10: proto.LoginRequest
+	(*LoginResponse)(nil),                   // 11: proto.LoginResponse
+	(*MFAChallenge)(nil),                    // 12: proto.MFAChallenge
+	(*RegisterRequest)(nil),                 // 13: proto.RegisterRequest
+	(*RegisterResponse)(nil),                // 14: proto.RegisterResponse
+	(*LogoutRequest)(nil),                   // 15: proto.LogoutRequest
+	(*LogoutResponse)(nil),                  // 16: proto.LogoutResponse
+	(*UpdateProfileRequest)(nil),            // 17: proto.UpdateProfileRequest
+	(*ChangePasswordRequest)(nil),           // 18: proto.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),          // 19: proto.ChangePasswordResponse
+	(*RefreshRequest)(nil),                  // 20: proto.RefreshRequest
+	(*RefreshResponse)(nil),                 // 21: proto.RefreshResponse
+	(*ValidateTokenRequest)(nil),            // 22: proto.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),           // 23: proto.ValidateTokenResponse
+	(*IntrospectTokenRequest)(nil),          // 24: proto.IntrospectTokenRequest
+	(*IntrospectTokenResponse)(nil),         // 25: proto.IntrospectTokenResponse
+	(*ClientCredentialsRequest)(nil),        // 26: proto.ClientCredentialsRequest
+	(*ClientCredentialsResponse)(nil),       // 27: proto.ClientCredentialsResponse
+	(*ServiceAccount)(nil),                  // 28: proto.ServiceAccount
+	(*CreateServiceAccountRequest)(nil),     // 29: proto.CreateServiceAccountRequest
+	(*ServiceAccountCredentials)(nil),       // 30: proto.ServiceAccountCredentials
+	(*RotateServiceAccountKeyRequest)(nil),  // 31: proto.RotateServiceAccountKeyRequest
+	(*RevokeServiceAccountRequest)(nil),     // 32: proto.RevokeServiceAccountRequest
+	(*RevokeServiceAccountResponse)(nil),    // 33: proto.RevokeServiceAccountResponse
+	(*ReauthenticateRequest)(nil),           // 34: proto.ReauthenticateRequest
+	(*ReauthenticateResponse)(nil),          // 35: proto.ReauthenticateResponse
+	(*VerifyMFARequest)(nil),                // 36: proto.VerifyMFARequest
+	(*VerifyMFAResponse)(nil),               // 37: proto.VerifyMFAResponse
+	(*EnrollTOTPRequest)(nil),               // 38: proto.EnrollTOTPRequest
+	(*EnrollTOTPResponse)(nil),              // 39: proto.EnrollTOTPResponse
+	(*ConfirmTOTPRequest)(nil),              // 40: proto.ConfirmTOTPRequest
+	(*ConfirmTOTPResponse)(nil),             // 41: proto.ConfirmTOTPResponse
+	(*DisableTOTPRequest)(nil),              // 42: proto.DisableTOTPRequest
+	(*DisableTOTPResponse)(nil),             // 43: proto.DisableTOTPResponse
+	(*RegenerateRecoveryCodesRequest)(nil),  // 44: proto.RegenerateRecoveryCodesRequest
+	(*RegenerateRecoveryCodesResponse)(nil), // 45: proto.RegenerateRecoveryCodesResponse
+	(*StartOAuthLoginRequest)(nil),          // 46: proto.StartOAuthLoginRequest
+	(*StartOAuthLoginResponse)(nil),         // 47: proto.StartOAuthLoginResponse
+	(*CompleteOAuthLoginRequest)(nil),       // 48: proto.CompleteOAuthLoginRequest
+	(*CompleteOAuthLoginResponse)(nil),      // 49: proto.CompleteOAuthLoginResponse
+	(*LinkedIdentity)(nil),                  // 50: proto.LinkedIdentity
+	(*VerifyEmailRequest)(nil),              // 51: proto.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),             // 52: proto.VerifyEmailResponse
+	(*ResendVerificationRequest)(nil),       // 53: proto.ResendVerificationRequest
+	(*ResendVerificationResponse)(nil),      // 54: proto.ResendVerificationResponse
+	(*RequestPasswordResetRequest)(nil),     // 55: proto.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),    // 56: proto.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),            // 57: proto.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),           // 58: proto.ResetPasswordResponse
+	(*CreateUserRequest)(nil),               // 59: proto.CreateUserRequest
+	(*GetUserRequest)(nil),                  // 60: proto.GetUserRequest
+	(*ListUsersRequest)(nil),                // 61: proto.ListUsersRequest
+	(*ListUsersResponse)(nil),               // 62: proto.ListUsersResponse
+	(*UpdateUserRequest)(nil),               // 63: proto.UpdateUserRequest
+	(*DeleteRequest)(nil),                   // 64: proto.DeleteRequest
+	(*DeleteResponse)(nil),                  // 65: proto.DeleteResponse
+	(*RestoreUserRequest)(nil),              // 66: proto.RestoreUserRequest
+	(*SuspendUserRequest)(nil),              // 67: proto.SuspendUserRequest
+	(*ReactivateUserRequest)(nil),           // 68: proto.ReactivateUserRequest
+	(*ForceLogoutRequest)(nil),              // 69: proto.ForceLogoutRequest
+	(*ForceLogoutResponse)(nil),             // 70: proto.ForceLogoutResponse
+	(*UserResponse)(nil),                    // 71: proto.UserResponse
+	(*DeleteAccountRequest)(nil),            // 72: proto.DeleteAccountRequest
+	(*RestoreAccountRequest)(nil),           // 73: proto.RestoreAccountRequest
+	(*PurgeDeletedAccountsRequest)(nil),     // 74: proto.PurgeDeletedAccountsRequest
+	(*PurgeDeletedAccountsResponse)(nil),    // 75: proto.PurgeDeletedAccountsResponse
+	(*ExportMyDataRequest)(nil),             // 76: proto.ExportMyDataRequest
+	(*AccountExport)(nil),                   // 77: proto.AccountExport
+	(*SessionInfo)(nil),                     // 78: proto.SessionInfo
+	(*StreamUserEventsRequest)(nil),         // 79: proto.StreamUserEventsRequest
+	(*UserEvent)(nil),                       // 80: proto.UserEvent
+	(*UserRegistered)(nil),                  // 81: proto.UserRegistered
+	(*UserUpdated)(nil),                     // 82: proto.UserUpdated
+	(*UserDeleted)(nil),                     // 83: proto.UserDeleted
+	(*UserRoleChanged)(nil),                 // 84: proto.UserRoleChanged
+	(*PasswordChanged)(nil),                 // 85: proto.PasswordChanged
+	(*UserStatusChanged)(nil),               // 86: proto.UserStatusChanged
+	(*AuditEntry)(nil),                      // 87: proto.AuditEntry
+	(*ListAuditEntriesRequest)(nil),         // 88: proto.ListAuditEntriesRequest
+	(*ListAuditEntriesResponse)(nil),        // 89: proto.ListAuditEntriesResponse
+	nil,                                     // 90: proto.AuditEntry.ParamsEntry
+	(*timestamppb.Timestamp)(nil),           // 91: google.protobuf.Timestamp
+	(*httpbody.HttpBody)(nil),               // 92: google.api.HttpBody
 }
 var file_proto_auth_proto_depIdxs = []int32{
-	1,  // 0: proto.LoginResponse.tokens:type_name -> proto.JWTPair
-	0,  // 1: proto.RegisterResponse.user_id:type_name -> proto.UUID
-	2,  // 2: proto.LogoutResponse.status:type_name -> proto.Status
-	2,  // 3: proto.ChangePasswordResponse.status:type_name -> proto.Status
-	1,  // 4: proto.RefreshResponse.tokens:type_name -> proto.JWTPair
-	25, // 5: proto.ValidateTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
-	0,  // 6: proto.ValidateTokenResponse.user_id:type_name -> proto.UUID
-	23, // 7: proto.ListUsersResponse.users:type_name -> proto.UserResponse
-	2,  // 8: proto.DeleteResponse.status:type_name -> proto.Status
-	0,  // 9: proto.UserResponse.user_id:type_name -> proto.UUID
-	25, // 10: proto.UserResponse.created_at:type_name -> google.protobuf.Timestamp
-	3,  // 11: proto.Auth.Login:input_type -> proto.LoginRequest
-	5,  // 12: proto.Auth.Register:input_type -> proto.RegisterRequest
-	7,  // 13: proto.Auth.Logout:input_type -> proto.LogoutRequest
-	10, // 14: proto.Auth.ChangePassword:input_type -> proto.ChangePasswordRequest
-	12, // 15: proto.Auth.RefreshToken:input_type -> proto.RefreshRequest
-	24, // 16: proto.Auth.DeleteAccount:input_type -> proto.DeleteAccountRequest
-	9,  // 17: proto.Auth.UpdateProfile:input_type -> proto.UpdateProfileRequest
-	14, // 18: proto.Auth.ValidateToken:input_type -> proto.ValidateTokenRequest
-	16, // 19: proto.Auth.CreateUser:input_type -> proto.CreateUserRequest
-	17, // 20: proto.Auth.GetUser:input_type -> proto.GetUserRequest
-	18, // 21: proto.Auth.ListUsers:input_type -> proto.ListUsersRequest
-	20, // 22: proto.Auth.UpdateUser:input_type -> proto.UpdateUserRequest
-	21, // 23: proto.Auth.DeleteUser:input_type -> proto.DeleteRequest
-	4,  // 24: proto.Auth.Login:output_type -> proto.LoginResponse
-	6,  // 25: proto.Auth.Register:output_type -> proto.RegisterResponse
-	8,  // 26: proto.Auth.Logout:output_type -> proto.LogoutResponse
-	11, // 27: proto.Auth.ChangePassword:output_type -> proto.ChangePasswordResponse
-	13, // 28: proto.Auth.RefreshToken:output_type -> proto.RefreshResponse
-	2,  // 29: proto.Auth.DeleteAccount:output_type -> proto.Status
-	23, // 30: proto.Auth.UpdateProfile:output_type -> proto.UserResponse
-	15, // 31: proto.Auth.ValidateToken:output_type -> proto.ValidateTokenResponse
-	23, // 32: proto.Auth.CreateUser:output_type -> proto.UserResponse
-	23, // 33: proto.Auth.GetUser:output_type -> proto.UserResponse
-	19, // 34: proto.Auth.ListUsers:output_type -> proto.ListUsersResponse
-	23, // 35: proto.Auth.UpdateUser:output_type -> proto.UserResponse
-	22, // 36: proto.Auth.DeleteUser:output_type -> proto.DeleteResponse
-	24, // [24:37] is the sub-list for method output_type
-	11, // [11:24] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	8,   // 0: proto.LoginResponse.tokens:type_name -> proto.JWTPair
+	12,  // 1: proto.LoginResponse.mfa_challenge:type_name -> proto.MFAChallenge
+	91,  // 2: proto.MFAChallenge.expires_at:type_name -> google.protobuf.Timestamp
+	0,   // 3: proto.MFAChallenge.methods:type_name -> proto.MFAMethod
+	7,   // 4: proto.RegisterResponse.user_id:type_name -> proto.UUID
+	9,   // 5: proto.LogoutResponse.status:type_name -> proto.Status
+	9,   // 6: proto.ChangePasswordResponse.status:type_name -> proto.Status
+	8,   // 7: proto.RefreshResponse.tokens:type_name -> proto.JWTPair
+	91,  // 8: proto.ValidateTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	7,   // 9: proto.ValidateTokenResponse.user_id:type_name -> proto.UUID
+	1,   // 10: proto.ValidateTokenResponse.subject_type:type_name -> proto.SubjectType
+	2,   // 11: proto.IntrospectTokenRequest.token_type_hint:type_name -> proto.TokenType
+	1,   // 12: proto.IntrospectTokenResponse.subject_type:type_name -> proto.SubjectType
+	2,   // 13: proto.IntrospectTokenResponse.token_type:type_name -> proto.TokenType
+	91,  // 14: proto.IntrospectTokenResponse.issued_at:type_name -> google.protobuf.Timestamp
+	91,  // 15: proto.IntrospectTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	91,  // 16: proto.ClientCredentialsResponse.expires_at:type_name -> google.protobuf.Timestamp
+	91,  // 17: proto.ServiceAccount.created_at:type_name -> google.protobuf.Timestamp
+	91,  // 18: proto.ServiceAccount.key_rotated_at:type_name -> google.protobuf.Timestamp
+	28,  // 19: proto.ServiceAccountCredentials.account:type_name -> proto.ServiceAccount
+	9,   // 20: proto.RevokeServiceAccountResponse.status:type_name -> proto.Status
+	91,  // 21: proto.ReauthenticateResponse.expires_at:type_name -> google.protobuf.Timestamp
+	8,   // 22: proto.VerifyMFAResponse.tokens:type_name -> proto.JWTPair
+	9,   // 23: proto.ConfirmTOTPResponse.status:type_name -> proto.Status
+	9,   // 24: proto.DisableTOTPResponse.status:type_name -> proto.Status
+	8,   // 25: proto.CompleteOAuthLoginResponse.tokens:type_name -> proto.JWTPair
+	12,  // 26: proto.CompleteOAuthLoginResponse.mfa_challenge:type_name -> proto.MFAChallenge
+	91,  // 27: proto.LinkedIdentity.linked_at:type_name -> google.protobuf.Timestamp
+	9,   // 28: proto.VerifyEmailResponse.status:type_name -> proto.Status
+	9,   // 29: proto.ResendVerificationResponse.status:type_name -> proto.Status
+	9,   // 30: proto.RequestPasswordResetResponse.status:type_name -> proto.Status
+	9,   // 31: proto.ResetPasswordResponse.status:type_name -> proto.Status
+	3,   // 32: proto.ListUsersRequest.role:type_name -> proto.UserRole
+	91,  // 33: proto.ListUsersRequest.created_after:type_name -> google.protobuf.Timestamp
+	91,  // 34: proto.ListUsersRequest.created_before:type_name -> google.protobuf.Timestamp
+	4,   // 35: proto.ListUsersRequest.status:type_name -> proto.UserStatus
+	71,  // 36: proto.ListUsersResponse.users:type_name -> proto.UserResponse
+	9,   // 37: proto.DeleteResponse.status:type_name -> proto.Status
+	91,  // 38: proto.DeleteResponse.purge_at:type_name -> google.protobuf.Timestamp
+	9,   // 39: proto.ForceLogoutResponse.status:type_name -> proto.Status
+	7,   // 40: proto.UserResponse.user_id:type_name -> proto.UUID
+	91,  // 41: proto.UserResponse.created_at:type_name -> google.protobuf.Timestamp
+	50,  // 42: proto.UserResponse.linked_identities:type_name -> proto.LinkedIdentity
+	4,   // 43: proto.UserResponse.status:type_name -> proto.UserStatus
+	91,  // 44: proto.UserResponse.deleted_at:type_name -> google.protobuf.Timestamp
+	91,  // 45: proto.UserResponse.purge_at:type_name -> google.protobuf.Timestamp
+	7,   // 46: proto.PurgeDeletedAccountsResponse.user_ids:type_name -> proto.UUID
+	71,  // 47: proto.AccountExport.profile:type_name -> proto.UserResponse
+	78,  // 48: proto.AccountExport.sessions:type_name -> proto.SessionInfo
+	91,  // 49: proto.SessionInfo.created_at:type_name -> google.protobuf.Timestamp
+	91,  // 50: proto.UserEvent.time:type_name -> google.protobuf.Timestamp
+	81,  // 51: proto.UserEvent.registered:type_name -> proto.UserRegistered
+	82,  // 52: proto.UserEvent.updated:type_name -> proto.UserUpdated
+	83,  // 53: proto.UserEvent.deleted:type_name -> proto.UserDeleted
+	84,  // 54: proto.UserEvent.role_changed:type_name -> proto.UserRoleChanged
+	85,  // 55: proto.UserEvent.password_changed:type_name -> proto.PasswordChanged
+	86,  // 56: proto.UserEvent.status_changed:type_name -> proto.UserStatusChanged
+	7,   // 57: proto.UserRegistered.user_id:type_name -> proto.UUID
+	7,   // 58: proto.UserUpdated.user_id:type_name -> proto.UUID
+	7,   // 59: proto.UserDeleted.user_id:type_name -> proto.UUID
+	7,   // 60: proto.UserRoleChanged.user_id:type_name -> proto.UUID
+	7,   // 61: proto.UserRoleChanged.changed_by:type_name -> proto.UUID
+	7,   // 62: proto.PasswordChanged.user_id:type_name -> proto.UUID
+	5,   // 63: proto.PasswordChanged.method:type_name -> proto.PasswordChangeMethod
+	7,   // 64: proto.UserStatusChanged.user_id:type_name -> proto.UUID
+	4,   // 65: proto.UserStatusChanged.previous_status:type_name -> proto.UserStatus
+	4,   // 66: proto.UserStatusChanged.status:type_name -> proto.UserStatus
+	7,   // 67: proto.UserStatusChanged.changed_by:type_name -> proto.UUID
+	1,   // 68: proto.AuditEntry.actor_type:type_name -> proto.SubjectType
+	90,  // 69: proto.AuditEntry.params:type_name -> proto.AuditEntry.ParamsEntry
+	6,   // 70: proto.AuditEntry.outcome:type_name -> proto.AuditOutcome
+	91,  // 71: proto.AuditEntry.time:type_name -> google.protobuf.Timestamp
+	91,  // 72: proto.ListAuditEntriesRequest.since:type_name -> google.protobuf.Timestamp
+	87,  // 73: proto.ListAuditEntriesResponse.entries:type_name -> proto.AuditEntry
+	10,  // 74: proto.Auth.Login:input_type -> proto.LoginRequest
+	13,  // 75: proto.Auth.Register:input_type -> proto.RegisterRequest
+	51,  // 76: proto.Auth.VerifyEmail:input_type -> proto.VerifyEmailRequest
+	53,  // 77: proto.Auth.ResendVerification:input_type -> proto.ResendVerificationRequest
+	55,  // 78: proto.Auth.RequestPasswordReset:input_type -> proto.RequestPasswordResetRequest
+	57,  // 79: proto.Auth.ResetPassword:input_type -> proto.ResetPasswordRequest
+	46,  // 80: proto.Auth.StartOAuthLogin:input_type -> proto.StartOAuthLoginRequest
+	48,  // 81: proto.Auth.CompleteOAuthLogin:input_type -> proto.CompleteOAuthLoginRequest
+	15,  // 82: proto.Auth.Logout:input_type -> proto.LogoutRequest
+	18,  // 83: proto.Auth.ChangePassword:input_type -> proto.ChangePasswordRequest
+	34,  // 84: proto.Auth.Reauthenticate:input_type -> proto.ReauthenticateRequest
+	36,  // 85: proto.Auth.VerifyMFA:input_type -> proto.VerifyMFARequest
+	38,  // 86: proto.Auth.EnrollTOTP:input_type -> proto.EnrollTOTPRequest
+	40,  // 87: proto.Auth.ConfirmTOTP:input_type -> proto.ConfirmTOTPRequest
+	42,  // 88: proto.Auth.DisableTOTP:input_type -> proto.DisableTOTPRequest
+	44,  // 89: proto.Auth.RegenerateRecoveryCodes:input_type -> proto.RegenerateRecoveryCodesRequest
+	20,  // 90: proto.Auth.RefreshToken:input_type -> proto.RefreshRequest
+	72,  // 91: proto.Auth.DeleteAccount:input_type -> proto.DeleteAccountRequest
+	73,  // 92: proto.Auth.RestoreAccount:input_type -> proto.RestoreAccountRequest
+	76,  // 93: proto.Auth.ExportMyData:input_type -> proto.ExportMyDataRequest
+	17,  // 94: proto.Auth.UpdateProfile:input_type -> proto.UpdateProfileRequest
+	26,  // 95: proto.Auth.IssueClientCredentialsToken:input_type -> proto.ClientCredentialsRequest
+	22,  // 96: proto.Auth.ValidateToken:input_type -> proto.ValidateTokenRequest
+	24,  // 97: proto.Auth.IntrospectToken:input_type -> proto.IntrospectTokenRequest
+	74,  // 98: proto.Auth.PurgeDeletedAccounts:input_type -> proto.PurgeDeletedAccountsRequest
+	79,  // 99: proto.Auth.StreamUserEvents:input_type -> proto.StreamUserEventsRequest
+	59,  // 100: proto.Auth.CreateUser:input_type -> proto.CreateUserRequest
+	60,  // 101: proto.Auth.GetUser:input_type -> proto.GetUserRequest
+	61,  // 102: proto.Auth.ListUsers:input_type -> proto.ListUsersRequest
+	63,  // 103: proto.Auth.UpdateUser:input_type -> proto.UpdateUserRequest
+	64,  // 104: proto.Auth.DeleteUser:input_type -> proto.DeleteRequest
+	67,  // 105: proto.Auth.SuspendUser:input_type -> proto.SuspendUserRequest
+	68,  // 106: proto.Auth.ReactivateUser:input_type -> proto.ReactivateUserRequest
+	69,  // 107: proto.Auth.ForceLogout:input_type -> proto.ForceLogoutRequest
+	66,  // 108: proto.Auth.RestoreUser:input_type -> proto.RestoreUserRequest
+	29,  // 109: proto.Auth.CreateServiceAccount:input_type -> proto.CreateServiceAccountRequest
+	31,  // 110: proto.Auth.RotateServiceAccountKey:input_type -> proto.RotateServiceAccountKeyRequest
+	32,  // 111: proto.Auth.RevokeServiceAccount:input_type -> proto.RevokeServiceAccountRequest
+	88,  // 112: proto.Auth.ListAuditEntries:input_type -> proto.ListAuditEntriesRequest
+	11,  // 113: proto.Auth.Login:output_type -> proto.LoginResponse
+	14,  // 114: proto.Auth.Register:output_type -> proto.RegisterResponse
+	52,  // 115: proto.Auth.VerifyEmail:output_type -> proto.VerifyEmailResponse
+	54,  // 116: proto.Auth.ResendVerification:output_type -> proto.ResendVerificationResponse
+	56,  // 117: proto.Auth.RequestPasswordReset:output_type -> proto.RequestPasswordResetResponse
+	58,  // 118: proto.Auth.ResetPassword:output_type -> proto.ResetPasswordResponse
+	47,  // 119: proto.Auth.StartOAuthLogin:output_type -> proto.StartOAuthLoginResponse
+	49,  // 120: proto.Auth.CompleteOAuthLogin:output_type -> proto.CompleteOAuthLoginResponse
+	16,  // 121: proto.Auth.Logout:output_type -> proto.LogoutResponse
+	19,  // 122: proto.Auth.ChangePassword:output_type -> proto.ChangePasswordResponse
+	35,  // 123: proto.Auth.Reauthenticate:output_type -> proto.ReauthenticateResponse
+	37,  // 124: proto.Auth.VerifyMFA:output_type -> proto.VerifyMFAResponse
+	39,  // 125: proto.Auth.EnrollTOTP:output_type -> proto.EnrollTOTPResponse
+	41,  // 126: proto.Auth.ConfirmTOTP:output_type -> proto.ConfirmTOTPResponse
+	43,  // 127: proto.Auth.DisableTOTP:output_type -> proto.DisableTOTPResponse
+	45,  // 128: proto.Auth.RegenerateRecoveryCodes:output_type -> proto.RegenerateRecoveryCodesResponse
+	21,  // 129: proto.Auth.RefreshToken:output_type -> proto.RefreshResponse
+	9,   // 130: proto.Auth.DeleteAccount:output_type -> proto.Status
+	71,  // 131: proto.Auth.RestoreAccount:output_type -> proto.UserResponse
+	92,  // 132: proto.Auth.ExportMyData:output_type -> google.api.HttpBody
+	71,  // 133: proto.Auth.UpdateProfile:output_type -> proto.UserResponse
+	27,  // 134: proto.Auth.IssueClientCredentialsToken:output_type -> proto.ClientCredentialsResponse
+	23,  // 135: proto.Auth.ValidateToken:output_type -> proto.ValidateTokenResponse
+	25,  // 136: proto.Auth.IntrospectToken:output_type -> proto.IntrospectTokenResponse
+	75,  // 137: proto.Auth.PurgeDeletedAccounts:output_type -> proto.PurgeDeletedAccountsResponse
+	80,  // 138: proto.Auth.StreamUserEvents:output_type -> proto.UserEvent
+	71,  // 139: proto.Auth.CreateUser:output_type -> proto.UserResponse
+	71,  // 140: proto.Auth.GetUser:output_type -> proto.UserResponse
+	62,  // 141: proto.Auth.ListUsers:output_type -> proto.ListUsersResponse
+	71,  // 142: proto.Auth.UpdateUser:output_type -> proto.UserResponse
+	65,  // 143: proto.Auth.DeleteUser:output_type -> proto.DeleteResponse
+	71,  // 144: proto.Auth.SuspendUser:output_type -> proto.UserResponse
+	71,  // 145: proto.Auth.ReactivateUser:output_type -> proto.UserResponse
+	70,  // 146: proto.Auth.ForceLogout:output_type -> proto.ForceLogoutResponse
+	71,  // 147: proto.Auth.RestoreUser:output_type -> proto.UserResponse
+	30,  // 148: proto.Auth.CreateServiceAccount:output_type -> proto.ServiceAccountCredentials
+	30,  // 149: proto.Auth.RotateServiceAccountKey:output_type -> proto.ServiceAccountCredentials
+	33,  // 150: proto.Auth.RevokeServiceAccount:output_type -> proto.RevokeServiceAccountResponse
+	89,  // 151: proto.Auth.ListAuditEntries:output_type -> proto.ListAuditEntriesResponse
+	113, // [113:152] is the sub-list for method output_type
+	74,  // [74:113] is the sub-list for method input_type
+	74,  // [74:74] is the sub-list for extension type_name
+	74,  // [74:74] is the sub-list for extension extendee
+	0,   // [0:74] is the sub-list for field type_name
 }
 
 func init() { file_proto_auth_proto_init() }
@@ -1655,18 +6276,32 @@ func file_proto_auth_proto_init() {
 	if File_proto_auth_proto != nil {
 		return
 	}
+	file_proto_auth_proto_msgTypes[29].OneofWrappers = []any{
+		(*VerifyMFARequest_TotpCode)(nil),
+		(*VerifyMFARequest_RecoveryCode)(nil),
+	}
+	file_proto_auth_proto_msgTypes[56].OneofWrappers = []any{}
+	file_proto_auth_proto_msgTypes[73].OneofWrappers = []any{
+		(*UserEvent_Registered)(nil),
+		(*UserEvent_Updated)(nil),
+		(*UserEvent_Deleted)(nil),
+		(*UserEvent_RoleChanged)(nil),
+		(*UserEvent_PasswordChanged)(nil),
+		(*UserEvent_StatusChanged)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_auth_proto_rawDesc), len(file_proto_auth_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   25,
+			NumEnums:      7,
+			NumMessages:   84,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_auth_proto_goTypes,
 		DependencyIndexes: file_proto_auth_proto_depIdxs,
+		EnumInfos:         file_proto_auth_proto_enumTypes,
 		MessageInfos:      file_proto_auth_proto_msgTypes,
 	}.Build()
 	File_proto_auth_proto = out.File
@@ -0,0 +1,57 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FileMailer writes every message as an .eml file into Dir, so emails can be
+// inspected without an SMTP server.
+type FileMailer struct {
+	Dir string
+
+	mu  sync.Mutex
+	seq int
+	now func() time.Time
+}
+
+func NewFileMailer(dir string) (*FileMailer, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("mailer: create dir: %w", err)
+	}
+	return &FileMailer{Dir: dir, now: time.Now}, nil
+}
+
+func (m *FileMailer) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := validate(msg); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.seq++
+	msg.SentAt = now(m.now)
+	name := fmt.Sprintf("%s-%04d-%s.eml", msg.SentAt.UTC().Format("20060102T150405"), m.seq, msg.Kind)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", msg.SentAt.Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "X-Mail-Kind: %s\r\n", msg.Kind)
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	b.WriteString(msg.Body)
+
+	if err := os.WriteFile(filepath.Join(m.Dir, name), []byte(b.String()), 0o644); err != nil {
+		return fmt.Errorf("mailer: write message: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTemplates(t *testing.T) {
+	tmpl := Templates{BaseURL: "https://hotels.example.com"}
+	tests := []struct {
+		name     string
+		msg      Message
+		wantKind Kind
+		wantPath string
+	}{
+		{"verification", tmpl.Verification("a@example.com", "tok+/="), KindVerification, "/verify-email"},
+		{"password reset", tmpl.PasswordReset("a@example.com", "tok+/="), KindPasswordReset, "/reset-password"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.msg.Kind != tt.wantKind || tt.msg.To != "a@example.com" || tt.msg.Token != "tok+/=" || tt.msg.Subject == "" {
+				t.Errorf("message = %+v", tt.msg)
+			}
+			var link string
+			for _, line := range strings.Split(tt.msg.Body, "\n") {
+				if strings.HasPrefix(line, "https://") {
+					link = line
+				}
+			}
+			u, err := url.Parse(link)
+			if err != nil {
+				t.Fatalf("body has no valid link: %q", tt.msg.Body)
+			}
+			if u.Host != "hotels.example.com" || u.Path != tt.wantPath || u.Query().Get("token") != "tok+/=" {
+				t.Errorf("link = %s, want %s with the escaped token", link, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestMemoryMailer(t *testing.T) {
+	at := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	m := NewMemoryMailer()
+	m.now = func() time.Time { return at }
+	ctx := context.Background()
+	tmpl := Templates{BaseURL: "https://x"}
+
+	sends := []struct {
+		msg     Message
+		wantErr error
+	}{
+		{tmpl.Verification("a@example.com", "v1"), nil},
+		{tmpl.PasswordReset("a@example.com", "r1"), nil},
+		{tmpl.Verification("a@example.com", "v2"), nil},
+		{tmpl.Verification("", "v3"), ErrNoRecipient},
+	}
+	for _, s := range sends {
+		if err := m.Send(ctx, s.msg); !errors.Is(err, s.wantErr) {
+			t.Errorf("Send(%s) = %v, want %v", s.msg.Token, err, s.wantErr)
+		}
+	}
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := m.Send(cancelled, tmpl.Verification("a@example.com", "v4")); !errors.Is(err, context.Canceled) {
+		t.Errorf("Send with a cancelled context = %v", err)
+	}
+
+	tests := []struct {
+		to        string
+		kind      Kind
+		wantToken string
+		wantOK    bool
+	}{
+		{"a@example.com", KindVerification, "v2", true},
+		{"a@example.com", KindPasswordReset, "r1", true},
+		{"b@example.com", KindVerification, "", false},
+	}
+	for _, tt := range tests {
+		msg, ok := m.Last(tt.to, tt.kind)
+		if ok != tt.wantOK || msg.Token != tt.wantToken {
+			t.Errorf("Last(%s, %s) = %q, %v; want %q, %v", tt.to, tt.kind, msg.Token, ok, tt.wantToken, tt.wantOK)
+		}
+		if ok && !msg.SentAt.Equal(at) {
+			t.Errorf("SentAt = %v, want %v", msg.SentAt, at)
+		}
+	}
+
+	msgs := m.Messages()
+	if len(msgs) != 3 {
+		t.Fatalf("Messages = %d, want 3", len(msgs))
+	}
+	msgs[0].Token = "changed"
+	if m.Messages()[0].Token != "v1" {
+		t.Error("Messages does not return a copy")
+	}
+	m.Reset()
+	if len(m.Messages()) != 0 {
+		t.Error("Reset kept messages")
+	}
+}
+
+func TestFileMailer(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mail")
+	m, err := NewFileMailer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.now = func() time.Time { return time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC) }
+
+	tmpl := Templates{BaseURL: "https://x"}
+	for _, msg := range []Message{tmpl.Verification("a@example.com", "v1"), tmpl.PasswordReset("b@example.com", "r1")} {
+		if err := m.Send(context.Background(), msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := m.Send(context.Background(), Message{Kind: KindVerification}); !errors.Is(err, ErrNoRecipient) {
+		t.Errorf("Send without recipient = %v, want ErrNoRecipient", err)
+	}
+
+	tests := []struct {
+		file string
+		want []string
+	}{
+		{"20260501T120000-0001-verification.eml", []string{"To: a@example.com\r\n", "X-Mail-Kind: verification\r\n", "token=v1"}},
+		{"20260501T120000-0002-password_reset.eml", []string{"To: b@example.com\r\n", "Subject: Reset your password\r\n", "token=r1"}},
+	}
+	for _, tt := range tests {
+		b, err := os.ReadFile(filepath.Join(dir, tt.file))
+		if err != nil {
+			t.Errorf("read %s: %v", tt.file, err)
+			continue
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(string(b), want) {
+				t.Errorf("%s does not contain %q:\n%s", tt.file, want, b)
+			}
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package oauth
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// FakeIDP is a minimal OpenID Connect provider for tests. Serve it with
+// httptest.NewServer and point an OIDCProvider at it via Provider. Every
+// authorization request immediately logs in as the identity set with
+// SetIdentity and redirects back with a code.
+type FakeIDP struct {
+	ClientID string
+
+	mu       sync.Mutex
+	identity Identity
+	seq      int
+	codes    map[string]fakeGrant
+	tokens   map[string]Identity
+}
+
+type fakeGrant struct {
+	challenge   string
+	redirectURI string
+	identity    Identity
+}
+
+func NewFakeIDP(clientID string) *FakeIDP {
+	return &FakeIDP{
+		ClientID: clientID,
+		codes:    make(map[string]fakeGrant),
+		tokens:   make(map[string]Identity),
+	}
+}
+
+// SetIdentity sets the user that subsequent authorizations log in as.
+func (f *FakeIDP) SetIdentity(id Identity) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.identity = id
+}
+
+// Provider returns an OIDCProvider configured for a FakeIDP served at baseURL.
+func (f *FakeIDP) Provider(name, baseURL string, client *http.Client) *OIDCProvider {
+	return &OIDCProvider{
+		ProviderName: name,
+		ClientID:     f.ClientID,
+		AuthURL:      baseURL + "/authorize",
+		TokenURL:     baseURL + "/token",
+		UserInfoURL:  baseURL + "/userinfo",
+		HTTPClient:   client,
+	}
+}
+
+// Authorize plays the role of the browser: it processes an authorization URL
+// produced by AuthCodeURL and returns the code and state that would be
+// delivered to the redirect URI.
+func (f *FakeIDP) Authorize(authorizeURL string) (code, state string, err error) {
+	u, err := url.Parse(authorizeURL)
+	if err != nil {
+		return "", "", err
+	}
+	redirect, err := f.authorize(u.Query())
+	if err != nil {
+		return "", "", err
+	}
+	q := redirect.Query()
+	return q.Get("code"), q.Get("state"), nil
+}
+
+func (f *FakeIDP) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/authorize"):
+		redirect, err := f.authorize(r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Redirect(w, r, redirect.String(), http.StatusFound)
+	case strings.HasSuffix(r.URL.Path, "/token"):
+		f.serveToken(w, r)
+	case strings.HasSuffix(r.URL.Path, "/userinfo"):
+		f.serveUserInfo(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (f *FakeIDP) authorize(q url.Values) (*url.URL, error) {
+	if q.Get("client_id") != f.ClientID {
+		return nil, errors.New("unknown client_id")
+	}
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		return nil, errors.New("PKCE S256 challenge required")
+	}
+	redirect, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || redirect.String() == "" {
+		return nil, errors.New("invalid redirect_uri")
+	}
+
+	f.mu.Lock()
+	f.seq++
+	code := "code-" + strconv.Itoa(f.seq)
+	f.codes[code] = fakeGrant{
+		challenge:   q.Get("code_challenge"),
+		redirectURI: redirect.String(),
+		identity:    f.identity,
+	}
+	f.mu.Unlock()
+
+	rq := redirect.Query()
+	rq.Set("code", code)
+	rq.Set("state", q.Get("state"))
+	redirect.RawQuery = rq.Encode()
+	return redirect, nil
+}
+
+func (f *FakeIDP) serveToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	code := r.PostForm.Get("code")
+	grant, ok := f.codes[code]
+	delete(f.codes, code)
+	switch {
+	case !ok, r.PostForm.Get("client_id") != f.ClientID, r.PostForm.Get("redirect_uri") != grant.redirectURI:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	case CodeChallenge(r.PostForm.Get("code_verifier")) != grant.challenge:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	f.seq++
+	token := "access-" + strconv.Itoa(f.seq)
+	f.tokens[token] = grant.identity
+	writeJSON(w, http.StatusOK, map[string]string{"access_token": token, "token_type": "Bearer"})
+}
+
+func (f *FakeIDP) serveUserInfo(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	f.mu.Lock()
+	id, ok := f.tokens[token]
+	f.mu.Unlock()
+
+	if !ok {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_token"})
+		return
+	}
+	writeJSON(w, http.StatusOK, userInfo{
+		Subject:       id.Subject,
+		Email:         id.Email,
+		EmailVerified: id.EmailVerified,
+		Name:          id.Name,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
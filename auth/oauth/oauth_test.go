@@ -0,0 +1,176 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"testing"
+)
+
+func TestCodeChallenge(t *testing.T) {
+	// RFC 7636, appendix B.
+	const verifier = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	if got, want := CodeChallenge(verifier), "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"; got != want {
+		t.Errorf("CodeChallenge = %s, want %s", got, want)
+	}
+
+	a, err := NewCodeVerifier()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := NewCodeVerifier()
+	// RFC 7636 requires 43 to 128 characters from the unreserved set.
+	if len(a) < 43 || len(a) > 128 || a == b || url.QueryEscape(a) != a {
+		t.Errorf("NewCodeVerifier = %q, %q", a, b)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry(&OIDCProvider{ProviderName: "google"})
+	r.Register(&OIDCProvider{ProviderName: "github"})
+
+	if got := r.Names(); !slices.Equal(got, []string{"github", "google"}) {
+		t.Errorf("Names = %v", got)
+	}
+	if p, err := r.Get("github"); err != nil || p.Name() != "github" {
+		t.Errorf("Get(github) = %v, %v", p, err)
+	}
+	if _, err := r.Get("facebook"); !errors.Is(err, ErrUnknownProvider) {
+		t.Errorf("Get(facebook) = %v, want ErrUnknownProvider", err)
+	}
+}
+
+func TestOIDCProviderAuthCodeURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		provider  OIDCProvider
+		wantBase  string
+		wantScope string
+	}{
+		{"defaults", OIDCProvider{ClientID: "c", AuthURL: "https://idp/authorize"}, "https://idp/authorize", "openid email profile"},
+		{
+			"existing query and scopes",
+			OIDCProvider{ClientID: "c", AuthURL: "https://idp/authorize?tenant=t", Scopes: []string{"openid"}},
+			"https://idp/authorize", "openid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.provider.AuthCodeURL("st", "ch", "https://app/cb"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			q := u.Query()
+			if base := u.Scheme + "://" + u.Host + u.Path; base != tt.wantBase {
+				t.Errorf("base = %s, want %s", base, tt.wantBase)
+			}
+			want := map[string]string{
+				"response_type": "code", "client_id": "c", "redirect_uri": "https://app/cb", "scope": tt.wantScope,
+				"state": "st", "code_challenge": "ch", "code_challenge_method": "S256",
+			}
+			for k, v := range want {
+				if q.Get(k) != v {
+					t.Errorf("%s = %q, want %q", k, q.Get(k), v)
+				}
+			}
+		})
+	}
+}
+
+func TestExchangeWithFakeIDP(t *testing.T) {
+	const redirectURI = "https://app.example.com/callback"
+	alice := Identity{Subject: "sub-1", Email: "alice@example.com", EmailVerified: true, Name: "Alice"}
+
+	tests := []struct {
+		name string
+		// tamper changes what is sent to the token endpoint.
+		tamper  func(code, verifier, redirect string) (string, string, string)
+		twice   bool
+		wantErr bool
+	}{
+		{name: "valid"},
+		{
+			name:    "wrong verifier",
+			tamper:  func(c, _, r string) (string, string, string) { return c, "not-the-verifier", r },
+			wantErr: true,
+		},
+		{
+			name:    "wrong redirect",
+			tamper:  func(c, v, _ string) (string, string, string) { return c, v, "https://evil.example.com/cb" },
+			wantErr: true,
+		},
+		{
+			name:    "unknown code",
+			tamper:  func(_, v, r string) (string, string, string) { return "code-999", v, r },
+			wantErr: true,
+		},
+		{name: "code reused", twice: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp := NewFakeIDP("client-1")
+			srv := httptest.NewServer(idp)
+			defer srv.Close()
+			idp.SetIdentity(alice)
+			p := idp.Provider("fake", srv.URL, srv.Client())
+
+			start, err := Begin(p, redirectURI)
+			if err != nil {
+				t.Fatal(err)
+			}
+			code, state, err := idp.Authorize(start.URL)
+			if err != nil {
+				t.Fatalf("Authorize: %v", err)
+			}
+			if state != start.State {
+				t.Errorf("state = %q, want %q", state, start.State)
+			}
+
+			verifier, redirect := start.CodeVerifier, redirectURI
+			if tt.tamper != nil {
+				code, verifier, redirect = tt.tamper(code, verifier, redirect)
+			}
+			if tt.twice {
+				if _, err := p.Exchange(context.Background(), code, verifier, redirect); err != nil {
+					t.Fatalf("first Exchange: %v", err)
+				}
+			}
+			id, err := p.Exchange(context.Background(), code, verifier, redirect)
+			if tt.wantErr {
+				if !errors.Is(err, ErrExchangeFailed) {
+					t.Errorf("Exchange = %v, want ErrExchangeFailed", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Exchange: %v", err)
+			}
+			want := alice
+			want.Provider = "fake"
+			if *id != want {
+				t.Errorf("identity = %+v, want %+v", *id, want)
+			}
+		})
+	}
+}
+
+func TestFakeIDPAuthorizeRejects(t *testing.T) {
+	idp := NewFakeIDP("client-1")
+	p := idp.Provider("fake", "https://idp.example.com", nil)
+
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{"unknown client", (&OIDCProvider{ClientID: "other", AuthURL: p.AuthURL}).AuthCodeURL("s", "c", "https://app/cb")},
+		{"no challenge", p.AuthCodeURL("s", "", "https://app/cb")},
+		{"no redirect", p.AuthCodeURL("s", "c", "")},
+	}
+	for _, tt := range tests {
+		if _, _, err := idp.Authorize(tt.url); err == nil {
+			t.Errorf("%s: Authorize succeeded", tt.name)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Package reauth enforces the require_recent_auth method option declared in
+// auth_options.proto. Callers confirm their identity with Auth.Reauthenticate
+// and send the returned step-up token in the x-reauth-token metadata; a Guard
+// rejects guarded calls whose token is missing, belongs to someone else or
+// is older than the method allows.
+package reauth
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	options "github.com/JunBSer/services_proto/options/auth_options/gen/go"
+	"github.com/JunBSer/services_proto/options/methodopts"
+)
+
+// MetadataKey carries the step-up token. The gateway forwards the
+// X-Reauth-Token header under this key.
+const MetadataKey = "x-reauth-token"
+
+// Grant is a verified step-up token.
+type Grant struct {
+	Subject string
+	// AuthTime is when the user last proved their identity.
+	AuthTime time.Time
+}
+
+// Verifier checks step-up tokens issued by Reauthenticate.
+type Verifier interface {
+	VerifyReauth(ctx context.Context, token string) (Grant, error)
+}
+
+// Guard enforces require_recent_auth. It must run after the interceptor that
+// authenticates the caller.
+type Guard struct {
+	Verifier Verifier
+	// Subject returns the authenticated caller the token must belong to.
+	Subject func(ctx context.Context) (string, bool)
+	// Unchanged reports whether a when_set field holds the value the caller
+	// already has, such as their current email. Such a field does not
+	// trigger the guard. When nil, any set field does.
+	Unchanged func(ctx context.Context, fd protoreflect.FieldDescriptor, v protoreflect.Value) bool
+	// Now defaults to time.Now.
+	Now func() time.Time
+}
+
+func NewGuard(v Verifier, subject func(ctx context.Context) (string, bool)) *Guard {
+	return &Guard{Verifier: v, Subject: subject}
+}
+
+func (g *Guard) now() time.Time {
+	if g.Now != nil {
+		return g.Now()
+	}
+	return time.Now()
+}
+
+// Check returns a REAUTHENTICATION_REQUIRED error unless the call carries a
+// fresh step-up token of the caller or the method does not require one.
+func (g *Guard) Check(ctx context.Context, fullMethod string, req any) error {
+	ra, ok := methodopts.RecentAuth(fullMethod)
+	if !ok || !g.guarded(ctx, ra, req) {
+		return nil
+	}
+	maxAge := time.Duration(ra.GetMaxAgeSeconds()) * time.Second
+
+	token, ok := Token(ctx)
+	if !ok {
+		return required(maxAge, "recent authentication required")
+	}
+	grant, err := g.Verifier.VerifyReauth(ctx, token)
+	if err != nil {
+		return required(maxAge, "invalid or expired reauthentication token")
+	}
+	if subject, ok := g.Subject(ctx); !ok || subject != grant.Subject {
+		return required(maxAge, "reauthentication token belongs to another user")
+	}
+	if maxAge > 0 && g.now().Sub(grant.AuthTime) > maxAge {
+		return required(maxAge, "reauthentication is too old")
+	}
+	return nil
+}
+
+// guarded applies the when_set condition of the option: the call is guarded
+// when one of the named fields is set to something other than its current
+// value. Proto3 scalars are set when non-zero.
+func (g *Guard) guarded(ctx context.Context, ra *options.RecentAuth, req any) bool {
+	if len(ra.GetWhenSet()) == 0 {
+		return true
+	}
+	m, ok := req.(proto.Message)
+	if !ok {
+		return true
+	}
+	r := m.ProtoReflect()
+	for _, name := range ra.GetWhenSet() {
+		fd := r.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil || !r.Has(fd) {
+			continue
+		}
+		if g.Unchanged == nil || !g.Unchanged(ctx, fd, r.Get(fd)) {
+			return true
+		}
+	}
+	return false
+}
+
+func required(maxAge time.Duration, msg string) error {
+	return apierrors.WithMetadata(codes.Unauthenticated, apierrors.ReasonReauthRequired, msg,
+		map[string]string{"max_age_seconds": strconv.Itoa(int(maxAge.Seconds()))})
+}
+
+func (g *Guard) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := g.Check(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Token returns the step-up token of an incoming call.
+func Token(ctx context.Context) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get(MetadataKey); len(v) > 0 && v[0] != "" {
+		return v[0], true
+	}
+	return "", false
+}
+
+// WithToken attaches a step-up token to outgoing gRPC metadata.
+func WithToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, token)
+}
@@ -0,0 +1,160 @@
+package reauth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+)
+
+var now = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// grants is a Verifier that knows a fixed set of tokens.
+type grants map[string]Grant
+
+func (g grants) VerifyReauth(_ context.Context, token string) (Grant, error) {
+	grant, ok := g[token]
+	if !ok {
+		return Grant{}, errors.New("unknown token")
+	}
+	return grant, nil
+}
+
+func newTestGuard() *Guard {
+	g := NewGuard(grants{
+		"fresh": {Subject: "u1", AuthTime: now.Add(-time.Minute)},
+		"limit": {Subject: "u1", AuthTime: now.Add(-5 * time.Minute)},
+		"stale": {Subject: "u1", AuthTime: now.Add(-5*time.Minute - time.Second)},
+		"other": {Subject: "u2", AuthTime: now},
+	}, func(ctx context.Context) (string, bool) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if v := md.Get("subject"); len(v) > 0 {
+			return v[0], true
+		}
+		return "", false
+	})
+	g.Unchanged = func(_ context.Context, fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		return fd.Name() == "email" && v.String() == "current@example.com"
+	}
+	g.Now = func() time.Time { return now }
+	return g
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		req      any
+		subject  string
+		token    string
+		wantDeny bool
+	}{
+		{name: "unguarded method", method: authpb.Auth_Login_FullMethodName, req: &authpb.LoginRequest{}},
+		{name: "fresh", method: authpb.Auth_ChangePassword_FullMethodName, subject: "u1", token: "fresh"},
+		{name: "at max age", method: authpb.Auth_ChangePassword_FullMethodName, subject: "u1", token: "limit"},
+		{name: "too old", method: authpb.Auth_ChangePassword_FullMethodName, subject: "u1", token: "stale", wantDeny: true},
+		{name: "missing", method: authpb.Auth_ChangePassword_FullMethodName, subject: "u1", wantDeny: true},
+		{name: "invalid", method: authpb.Auth_ChangePassword_FullMethodName, subject: "u1", token: "invalid", wantDeny: true},
+		{name: "another user", method: authpb.Auth_ChangePassword_FullMethodName, subject: "u1", token: "other", wantDeny: true},
+		{name: "no caller", method: authpb.Auth_ChangePassword_FullMethodName, token: "fresh", wantDeny: true},
+		{
+			name:   "when_set field unset",
+			method: authpb.Auth_UpdateProfile_FullMethodName,
+			req:    &authpb.UpdateProfileRequest{Name: "New name"},
+		},
+		{
+			name:     "when_set field set",
+			method:   authpb.Auth_UpdateProfile_FullMethodName,
+			req:      &authpb.UpdateProfileRequest{Email: "new@example.com"},
+			subject:  "u1",
+			wantDeny: true,
+		},
+		{
+			name:    "when_set field unchanged",
+			method:  authpb.Auth_UpdateProfile_FullMethodName,
+			req:     &authpb.UpdateProfileRequest{Email: "current@example.com"},
+			subject: "u1",
+		},
+		{
+			name:    "when_set field set with token",
+			method:  authpb.Auth_UpdateProfile_FullMethodName,
+			req:     &authpb.UpdateProfileRequest{Email: "new@example.com"},
+			subject: "u1",
+			token:   "fresh",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := metadata.MD{}
+			if tt.subject != "" {
+				md.Set("subject", tt.subject)
+			}
+			if tt.token != "" {
+				md.Set(MetadataKey, tt.token)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			err := newTestGuard().Check(ctx, tt.method, tt.req)
+			if !tt.wantDeny {
+				if err != nil {
+					t.Errorf("Check = %v, want nil", err)
+				}
+				return
+			}
+			if status.Code(err) != codes.Unauthenticated || apierrors.ReasonOf(err) != apierrors.ReasonReauthRequired {
+				t.Fatalf("Check = %v, want Unauthenticated %s", err, apierrors.ReasonReauthRequired)
+			}
+			if got := apierrors.InfoOf(err).GetMetadata()["max_age_seconds"]; got != "300" {
+				t.Errorf("max_age_seconds = %q, want 300", got)
+			}
+		})
+	}
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name        string
+		token       string
+		wantHandler bool
+	}{
+		{"allowed", "fresh", true},
+		{"denied", "stale", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("subject", "u1", MetadataKey, tt.token))
+			called := false
+			_, err := newTestGuard().UnaryInterceptor()(ctx, &authpb.ChangePasswordRequest{},
+				&grpc.UnaryServerInfo{FullMethod: authpb.Auth_ChangePassword_FullMethodName},
+				func(context.Context, any) (any, error) {
+					called = true
+					return nil, nil
+				})
+			if called != tt.wantHandler || (err == nil) != tt.wantHandler {
+				t.Errorf("handler called %v, err %v; want called %v", called, err, tt.wantHandler)
+			}
+		})
+	}
+}
+
+func TestWithToken(t *testing.T) {
+	out := WithToken(context.Background(), "step-up")
+	md, _ := metadata.FromOutgoingContext(out)
+	in := metadata.NewIncomingContext(context.Background(), md)
+
+	if got, ok := Token(in); !ok || got != "step-up" {
+		t.Errorf("Token = %q, %v; want step-up", got, ok)
+	}
+	if _, ok := Token(context.Background()); ok {
+		t.Error("Token found a token in an empty context")
+	}
+}
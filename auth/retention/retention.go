@@ -0,0 +1,67 @@
+// Package retention enforces the deletion grace period of soft-deleted
+// accounts by periodically calling Auth.PurgeDeletedAccounts.
+package retention
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+)
+
+// Purger removes accounts whose grace period has ended.
+type Purger struct {
+	Client authpb.AuthClient
+	// CallOptions carry the service credentials PurgeDeletedAccounts
+	// requires, e.g. grpc.PerRPCCredentials.
+	CallOptions []grpc.CallOption
+	// Interval between purges. Defaults to 1h.
+	Interval time.Duration
+	// OnPurge observes the IDs of purged accounts.
+	OnPurge func(ids []string)
+	// OnError observes failed purges, which are retried on the next tick.
+	OnError func(error)
+}
+
+func NewPurger(client authpb.AuthClient, opts ...grpc.CallOption) *Purger {
+	return &Purger{Client: client, CallOptions: opts}
+}
+
+// Run purges once immediately and then every Interval until ctx is done.
+func (p *Purger) Run(ctx context.Context) error {
+	interval := p.Interval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.Purge(ctx); err != nil && ctx.Err() == nil && p.OnError != nil {
+			p.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge calls PurgeDeletedAccounts once and returns the purged IDs.
+func (p *Purger) Purge(ctx context.Context) ([]string, error) {
+	resp, err := p.Client.PurgeDeletedAccounts(ctx, &authpb.PurgeDeletedAccountsRequest{}, p.CallOptions...)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(resp.GetUserIds()))
+	for i, id := range resp.GetUserIds() {
+		ids[i] = id.GetValue()
+	}
+	if len(ids) > 0 && p.OnPurge != nil {
+		p.OnPurge(ids)
+	}
+	return ids, nil
+}
@@ -0,0 +1,162 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+)
+
+// purgeClient answers PurgeDeletedAccounts from results, one per call, and
+// repeats the last one after they run out.
+type purgeClient struct {
+	authpb.AuthClient
+
+	mu      sync.Mutex
+	results []purgeResult
+	calls   int
+	opts    int
+}
+
+type purgeResult struct {
+	ids []string
+	err error
+}
+
+func (c *purgeClient) PurgeDeletedAccounts(_ context.Context, _ *authpb.PurgeDeletedAccountsRequest, opts ...grpc.CallOption) (*authpb.PurgeDeletedAccountsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	r := c.results[min(c.calls, len(c.results)-1)]
+	c.calls++
+	c.opts = len(opts)
+	if r.err != nil {
+		return nil, r.err
+	}
+	resp := &authpb.PurgeDeletedAccountsResponse{}
+	for _, id := range r.ids {
+		resp.UserIds = append(resp.UserIds, &authpb.UUID{Value: id})
+	}
+	return resp, nil
+}
+
+func (c *purgeClient) callCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.calls
+}
+
+var errUnavailable = errors.New("auth unavailable")
+
+func TestPurge(t *testing.T) {
+	tests := []struct {
+		name        string
+		result      purgeResult
+		wantIDs     []string
+		wantOnPurge bool
+		wantErr     error
+	}{
+		{name: "purged", result: purgeResult{ids: []string{"u1", "u2"}}, wantIDs: []string{"u1", "u2"}, wantOnPurge: true},
+		{name: "nothing due", result: purgeResult{}, wantIDs: []string{}},
+		{name: "error", result: purgeResult{err: errUnavailable}, wantErr: errUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &purgeClient{results: []purgeResult{tt.result}}
+			p := NewPurger(client, grpc.WaitForReady(true))
+			var observed []string
+			p.OnPurge = func(ids []string) { observed = ids }
+
+			ids, err := p.Purge(context.Background())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Purge error = %v, want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(ids, tt.wantIDs) {
+				t.Errorf("ids = %v, want %v", ids, tt.wantIDs)
+			}
+			if (observed != nil) != tt.wantOnPurge || (tt.wantOnPurge && !slices.Equal(observed, tt.wantIDs)) {
+				t.Errorf("OnPurge got %v, want called %v", observed, tt.wantOnPurge)
+			}
+			if client.opts != 1 {
+				t.Errorf("sent %d call options, want 1", client.opts)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	client := &purgeClient{results: []purgeResult{{err: errUnavailable}, {ids: []string{"u1"}}, {}}}
+	p := NewPurger(client)
+	p.Interval = time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var (
+		mu     sync.Mutex
+		errs   []error
+		purged []string
+	)
+	p.OnError = func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		errs = append(errs, err)
+	}
+	p.OnPurge = func(ids []string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		purged = append(purged, ids...)
+		cancel()
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- p.Run(ctx) }()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Run = %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after cancel")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	// The failed first purge is reported and retried on the next tick.
+	if len(errs) != 1 || !errors.Is(errs[0], errUnavailable) {
+		t.Errorf("OnError got %v, want one %v", errs, errUnavailable)
+	}
+	if !slices.Equal(purged, []string{"u1"}) {
+		t.Errorf("purged %v, want [u1]", purged)
+	}
+	if n := client.callCount(); n < 2 {
+		t.Errorf("PurgeDeletedAccounts called %d times, want at least 2", n)
+	}
+}
+
+func TestRunPurgesImmediately(t *testing.T) {
+	client := &purgeClient{results: []purgeResult{{ids: []string{"u1"}}}}
+	p := NewPurger(client)
+	// The default interval would block for an hour before a second purge.
+	ctx, cancel := context.WithCancel(context.Background())
+	p.OnPurge = func([]string) { cancel() }
+
+	done := make(chan error, 1)
+	go func() { done <- p.Run(ctx) }()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not purge before the first tick")
+	}
+	if n := client.callCount(); n != 1 {
+		t.Errorf("PurgeDeletedAccounts called %d times, want 1", n)
+	}
+}
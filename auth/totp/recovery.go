@@ -0,0 +1,75 @@
+package totp
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const recoveryAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+
+// recoveryByteLimit is the largest multiple of the alphabet size that fits
+// in a byte. Random bytes at or above it are discarded so every character is
+// equally likely.
+const recoveryByteLimit = 256 - 256%len(recoveryAlphabet)
+
+// GenerateRecoveryCodes returns n random single-use codes formatted as
+// "xxxxx-xxxxx". Only their hashes should be stored.
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, n)
+	for i := range codes {
+		chars, err := recoveryChars(10)
+		if err != nil {
+			return nil, fmt.Errorf("totp: generate recovery code: %w", err)
+		}
+		codes[i] = string(chars[:5]) + "-" + string(chars[5:])
+	}
+	return codes, nil
+}
+
+// recoveryChars returns n characters drawn uniformly from recoveryAlphabet.
+func recoveryChars(n int) ([]byte, error) {
+	out := make([]byte, 0, n)
+	buf := make([]byte, n)
+	for len(out) < n {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, err
+		}
+		for _, c := range buf {
+			if int(c) < recoveryByteLimit && len(out) < n {
+				out = append(out, recoveryAlphabet[int(c)%len(recoveryAlphabet)])
+			}
+		}
+	}
+	return out, nil
+}
+
+// HashRecoveryCode returns the value to persist for a recovery code. Input is
+// normalized so users may omit the dash or change case.
+func HashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(normalizeRecoveryCode(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+// MatchRecoveryCode returns the index of the stored hash matching code, or -1.
+// The caller must remove the matched hash so the code cannot be reused.
+func MatchRecoveryCode(hashes []string, code string) int {
+	h := []byte(HashRecoveryCode(code))
+	match := -1
+	for i, stored := range hashes {
+		if subtle.ConstantTimeCompare([]byte(stored), h) == 1 && match < 0 {
+			match = i
+		}
+	}
+	return match
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, "-", "")
+	code = strings.ReplaceAll(code, " ", "")
+	return code
+}
@@ -0,0 +1,68 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != 200 {
+		t.Fatalf("got %d codes, want 200", len(codes))
+	}
+
+	seen := make(map[string]bool)
+	counts := make(map[rune]int)
+	for _, c := range codes {
+		if len(c) != 11 || c[5] != '-' {
+			t.Fatalf("code %q is not formatted as xxxxx-xxxxx", c)
+		}
+		for _, r := range strings.ReplaceAll(c, "-", "") {
+			if !strings.ContainsRune(recoveryAlphabet, r) {
+				t.Fatalf("code %q uses %q outside the alphabet", c, r)
+			}
+			counts[r]++
+		}
+		if seen[c] {
+			t.Fatalf("code %q generated twice", c)
+		}
+		seen[c] = true
+	}
+	// 2000 characters over 31 symbols: every symbol should show up.
+	if len(counts) != len(recoveryAlphabet) {
+		t.Errorf("only %d of %d symbols used", len(counts), len(recoveryAlphabet))
+	}
+}
+
+func TestRecoveryByteLimit(t *testing.T) {
+	if recoveryByteLimit%len(recoveryAlphabet) != 0 || recoveryByteLimit > 256 || 256-recoveryByteLimit >= len(recoveryAlphabet) {
+		t.Errorf("recoveryByteLimit = %d is not the largest multiple of %d up to 256", recoveryByteLimit, len(recoveryAlphabet))
+	}
+}
+
+func TestMatchRecoveryCode(t *testing.T) {
+	hashes := []string{HashRecoveryCode("abcde-fghjk"), HashRecoveryCode("mnpqr-stuvw")}
+
+	tests := []struct {
+		name string
+		code string
+		want int
+	}{
+		{"exact", "abcde-fghjk", 0},
+		{"second", "mnpqr-stuvw", 1},
+		{"without dash", "mnpqrstuvw", 1},
+		{"upper case and spaces", " ABCDE FGHJK ", 0},
+		{"unknown", "zzzzz-zzzzz", -1},
+		{"empty", "", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchRecoveryCode(hashes, tt.code); got != tt.want {
+				t.Errorf("MatchRecoveryCode(%q) = %d, want %d", tt.code, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+// Package totp implements time-based one-time passwords (RFC 6238) and
+// recovery codes used by the Auth service for multi-factor authentication.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidSecret = errors.New("totp: invalid secret")
+	ErrInvalidCode   = errors.New("totp: invalid code")
+	ErrCodeReused    = errors.New("totp: code already used")
+	// ErrInvalidDigits is returned for Options.Digits above 9, which do not
+	// fit the 31-bit value HOTP truncates to.
+	ErrInvalidDigits = errors.New("totp: digits must be at most 9")
+)
+
+const maxDigits = 9
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Clock abstracts the current time so validation can be tested with a fake.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// SystemClock returns a Clock backed by time.Now.
+func SystemClock() Clock { return systemClock{} }
+
+// Options configures code generation and validation. The zero value uses the
+// parameters understood by common authenticator apps: 6 digits, 30 second
+// period, SHA-1 and one step of allowed clock skew.
+type Options struct {
+	Issuer string
+	// Digits defaults to 6 and may be at most 9.
+	Digits int
+	Period time.Duration
+	// Skew is the number of periods before and after the current one that
+	// are still accepted. Zero means the default of 1; set NoSkew to accept
+	// only the current period.
+	Skew   uint
+	NoSkew bool
+	Clock  Clock
+}
+
+func (o Options) digits() int {
+	if o.Digits <= 0 {
+		return 6
+	}
+	return o.Digits
+}
+
+func (o Options) validate() error {
+	if o.digits() > maxDigits {
+		return ErrInvalidDigits
+	}
+	return nil
+}
+
+func (o Options) period() time.Duration {
+	if o.Period <= 0 {
+		return 30 * time.Second
+	}
+	return o.Period
+}
+
+func (o Options) skew() uint {
+	if o.NoSkew {
+		return 0
+	}
+	if o.Skew == 0 {
+		return 1
+	}
+	return o.Skew
+}
+
+func (o Options) now() time.Time {
+	if o.Clock == nil {
+		return time.Now()
+	}
+	return o.Clock.Now()
+}
+
+// GenerateSecret returns a random 160-bit secret encoded as unpadded base32.
+func GenerateSecret() (string, error) {
+	buf := make([]byte, 20)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("totp: generate secret: %w", err)
+	}
+	return encoding.EncodeToString(buf), nil
+}
+
+// ProvisioningURI builds the otpauth:// URI that authenticator apps import
+// from a QR code.
+func (o Options) ProvisioningURI(account, secret string) string {
+	label := account
+	if o.Issuer != "" {
+		label = o.Issuer + ":" + account
+	}
+
+	q := url.Values{}
+	q.Set("secret", secret)
+	if o.Issuer != "" {
+		q.Set("issuer", o.Issuer)
+	}
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(o.digits()))
+	q.Set("period", fmt.Sprint(int(o.period().Seconds())))
+
+	u := url.URL{Scheme: "otpauth", Host: "totp", Path: "/" + label, RawQuery: q.Encode()}
+	return u.String()
+}
+
+// Code returns the code for the period containing t.
+func (o Options) Code(secret string, t time.Time) (string, error) {
+	if err := o.validate(); err != nil {
+		return "", err
+	}
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return hotp(key, o.counter(t), o.digits()), nil
+}
+
+// Validate checks code against the current time and returns the counter of
+// the matching period. Callers should persist the counter and pass it as
+// lastCounter on the next call so a code cannot be replayed; use 0 when no
+// code has been accepted yet.
+func (o Options) Validate(secret, code string, lastCounter uint64) (uint64, error) {
+	if err := o.validate(); err != nil {
+		return 0, err
+	}
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, err
+	}
+
+	code = strings.TrimSpace(code)
+	if len(code) != o.digits() {
+		return 0, ErrInvalidCode
+	}
+
+	current := o.counter(o.now())
+	skew := uint64(o.skew())
+	for delta := uint64(0); delta <= 2*skew; delta++ {
+		if current+delta < skew {
+			continue
+		}
+		counter := current + delta - skew
+		expected := hotp(key, counter, o.digits())
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) != 1 {
+			continue
+		}
+		if counter <= lastCounter {
+			return 0, ErrCodeReused
+		}
+		return counter, nil
+	}
+	return 0, ErrInvalidCode
+}
+
+func (o Options) counter(t time.Time) uint64 {
+	return uint64(t.Unix()) / uint64(o.period().Seconds())
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	secret = strings.TrimRight(secret, "=")
+	key, err := encoding.DecodeString(secret)
+	if err != nil || len(key) == 0 {
+		return nil, ErrInvalidSecret
+	}
+	return key, nil
+}
+
+// hotp implements RFC 4226. digits must be at most 9.
+func hotp(key []byte, counter uint64, digits int) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
@@ -0,0 +1,207 @@
+package totp
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 key of the RFC 6238 test vectors,
+// "12345678901234567890", in base32.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) Now() time.Time { return c.t }
+
+func TestCodeRFC6238(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+	opts := Options{Digits: 8}
+	for _, tt := range tests {
+		got, err := opts.Code(rfcSecret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("Code(%d): %v", tt.unix, err)
+		}
+		if got != tt.want {
+			t.Errorf("Code(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestCodeDigits(t *testing.T) {
+	at := time.Unix(59, 0)
+	tests := []struct {
+		digits  int
+		want    string
+		wantErr error
+	}{
+		{0, "287082", nil},
+		{6, "287082", nil},
+		{9, "094287082", nil},
+		{10, "", ErrInvalidDigits},
+	}
+	for _, tt := range tests {
+		got, err := Options{Digits: tt.digits}.Code(rfcSecret, at)
+		if !errors.Is(err, tt.wantErr) || got != tt.want {
+			t.Errorf("Digits %d: Code = %q, %v; want %q, %v", tt.digits, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	base := Options{Clock: &fakeClock{now}}
+	current := base.counter(now)
+	code := func(offset int) string {
+		c, err := base.Code(rfcSecret, now.Add(time.Duration(offset)*30*time.Second))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name        string
+		opts        func(Options) Options
+		secret      string
+		code        string
+		lastCounter uint64
+		wantCounter uint64
+		wantErr     error
+	}{
+		{name: "current period", code: code(0), wantCounter: current},
+		{name: "surrounding whitespace", code: " " + code(0) + "\n", wantCounter: current},
+		{name: "previous period within skew", code: code(-1), wantCounter: current - 1},
+		{name: "next period within skew", code: code(1), wantCounter: current + 1},
+		{name: "outside default skew", code: code(-2), wantErr: ErrInvalidCode},
+		{
+			name: "wider skew", code: code(-2), wantCounter: current - 2,
+			opts: func(o Options) Options { o.Skew = 2; return o },
+		},
+		{
+			name: "no skew accepts the current period", code: code(0), wantCounter: current,
+			opts: func(o Options) Options { o.NoSkew = true; return o },
+		},
+		{
+			name: "no skew rejects the previous period", code: code(-1), wantErr: ErrInvalidCode,
+			opts: func(o Options) Options { o.NoSkew = true; o.Skew = 3; return o },
+		},
+		{name: "replayed", code: code(0), lastCounter: current, wantErr: ErrCodeReused},
+		{name: "older than the last accepted", code: code(-1), lastCounter: current, wantErr: ErrCodeReused},
+		{name: "wrong length", code: code(0)[:5], wantErr: ErrInvalidCode},
+		{name: "wrong code", code: "000000", wantErr: ErrInvalidCode},
+		{name: "invalid secret", secret: "not base32!", code: code(0), wantErr: ErrInvalidSecret},
+		{
+			name: "too many digits", code: "0123456789", wantErr: ErrInvalidDigits,
+			opts: func(o Options) Options { o.Digits = 10; return o },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := base
+			if tt.opts != nil {
+				opts = tt.opts(opts)
+			}
+			secret := tt.secret
+			if secret == "" {
+				secret = rfcSecret
+			}
+			got, err := opts.Validate(secret, tt.code, tt.lastCounter)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Validate error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.wantCounter {
+				t.Errorf("Validate counter = %d, want %d", got, tt.wantCounter)
+			}
+		})
+	}
+}
+
+func TestValidateFollowsClock(t *testing.T) {
+	clock := &fakeClock{time.Unix(1111111111, 0)}
+	opts := Options{Clock: clock}
+	code, err := opts.Code(rfcSecret, clock.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		advance time.Duration
+		wantErr error
+	}{
+		{0, nil},
+		{30 * time.Second, nil},
+		{60 * time.Second, ErrInvalidCode},
+	}
+	for _, tt := range tests {
+		clock.t = time.Unix(1111111111, 0).Add(tt.advance)
+		if _, err := opts.Validate(rfcSecret, code, 0); !errors.Is(err, tt.wantErr) {
+			t.Errorf("after %v: Validate = %v, want %v", tt.advance, err, tt.wantErr)
+		}
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	a, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := GenerateSecret()
+	if a == b {
+		t.Error("GenerateSecret returned the same secret twice")
+	}
+	key, err := decodeSecret(a)
+	if err != nil || len(key) != 20 {
+		t.Errorf("secret %q decodes to %d bytes (%v), want 20", a, len(key), err)
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      Options
+		wantPath  string
+		wantQuery url.Values
+	}{
+		{
+			name:     "defaults",
+			wantPath: "/alice@example.com",
+			wantQuery: url.Values{
+				"secret": {rfcSecret}, "algorithm": {"SHA1"}, "digits": {"6"}, "period": {"30"},
+			},
+		},
+		{
+			name:     "issuer and parameters",
+			opts:     Options{Issuer: "Hotels", Digits: 8, Period: time.Minute},
+			wantPath: "/Hotels:alice@example.com",
+			wantQuery: url.Values{
+				"secret": {rfcSecret}, "issuer": {"Hotels"}, "algorithm": {"SHA1"}, "digits": {"8"}, "period": {"60"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.opts.ProvisioningURI("alice@example.com", rfcSecret))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != tt.wantPath {
+				t.Errorf("URI = %s, want otpauth://totp%s", u, tt.wantPath)
+			}
+			if got := u.Query().Encode(); got != tt.wantQuery.Encode() {
+				t.Errorf("query = %s, want %s", got, tt.wantQuery.Encode())
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	bookpb "github.com/JunBSer/services_proto/booking/gen/go"
+)
+
+func cancelled(t *testing.T, bookingID, hotelID string) *bookpb.BookingEvent {
+	t.Helper()
+	e, err := New("/test", &bookpb.BookingCancelled{BookingId: bookingID, HotelId: hotelID}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+// drain returns the events buffered in s without waiting for more.
+func drain(s *Subscription) (ids []string, closed bool) {
+	for {
+		select {
+		case e, ok := <-s.C:
+			if !ok {
+				return ids, true
+			}
+			ids = append(ids, e.GetSubject())
+		default:
+			return ids, false
+		}
+	}
+}
+
+func TestChannelBusPublish(t *testing.T) {
+	tests := []struct {
+		name       string
+		buffer     int
+		filter     Filter
+		publish    []string // booking IDs, hotel "h-1" unless prefixed with "x"
+		wantIDs    []string
+		wantClosed bool
+		wantErr    error
+	}{
+		{
+			name:    "delivers in order",
+			buffer:  4,
+			publish: []string{"b-1", "b-2", "b-3"},
+			wantIDs: []string{"b-1", "b-2", "b-3"},
+		},
+		{
+			name:    "filters by hotel",
+			buffer:  4,
+			filter:  Filter{HotelID: "h-1"},
+			publish: []string{"b-1", "x-2", "b-3"},
+			wantIDs: []string{"b-1", "b-3"},
+		},
+		{
+			name:    "filtered events do not count against the buffer",
+			buffer:  1,
+			filter:  Filter{Types: []string{TypeCreated}},
+			publish: []string{"b-1", "b-2", "b-3"},
+		},
+		{
+			name:       "disconnects a subscriber whose buffer is full",
+			buffer:     2,
+			publish:    []string{"b-1", "b-2", "b-3", "b-4"},
+			wantIDs:    []string{"b-1", "b-2"},
+			wantClosed: true,
+			wantErr:    ErrSlowSubscriber,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewChannelBus(tt.buffer)
+			defer b.Close()
+
+			s, err := b.Subscribe(context.Background(), tt.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, id := range tt.publish {
+				hotel := "h-1"
+				if id[0] == 'x' {
+					hotel = "h-2"
+				}
+				if err := b.Publish(context.Background(), cancelled(t, id, hotel)); err != nil {
+					t.Fatalf("Publish(%s): %v", id, err)
+				}
+			}
+
+			ids, closed := drain(s)
+			if len(ids) != len(tt.wantIDs) {
+				t.Fatalf("received %v, want %v", ids, tt.wantIDs)
+			}
+			for i := range ids {
+				if ids[i] != tt.wantIDs[i] {
+					t.Fatalf("received %v, want %v", ids, tt.wantIDs)
+				}
+			}
+			if closed != tt.wantClosed {
+				t.Errorf("closed = %v, want %v", closed, tt.wantClosed)
+			}
+			if closed && !errors.Is(s.Err(), tt.wantErr) {
+				t.Errorf("Err = %v, want %v", s.Err(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestChannelBusClosed(t *testing.T) {
+	b := NewChannelBus(1)
+	b.Close()
+
+	if err := b.Publish(context.Background(), cancelled(t, "b-1", "h-1")); !errors.Is(err, ErrClosed) {
+		t.Errorf("Publish = %v, want ErrClosed", err)
+	}
+	if _, err := b.Subscribe(context.Background(), Filter{}); !errors.Is(err, ErrClosed) {
+		t.Errorf("Subscribe = %v, want ErrClosed", err)
+	}
+	if err := b.Publish(context.Background(), &bookpb.BookingEvent{}); !errors.Is(err, ErrInvalidEvent) {
+		t.Errorf("Publish(invalid) = %v, want ErrInvalidEvent", err)
+	}
+}
@@ -0,0 +1,141 @@
+// Package events publishes and consumes booking lifecycle events. Events are
+// bookpb.BookingEvent messages: CloudEvents 1.0 envelopes whose data is one of
+// BookingCreated, BookingCancelled, BookingModified or BookingStatusChanged.
+package events
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bookpb "github.com/JunBSer/services_proto/booking/gen/go"
+	"github.com/JunBSer/services_proto/eventbus"
+)
+
+// CloudEvents attributes shared by every booking event.
+const (
+	SpecVersion     = "1.0"
+	DataContentType = "application/protobuf"
+)
+
+// Event types, carried in the envelope's type attribute.
+const (
+	TypeCreated       = "booking.created"
+	TypeCancelled     = "booking.cancelled"
+	TypeModified      = "booking.modified"
+	TypeStatusChanged = "booking.status_changed"
+)
+
+var (
+	ErrInvalidEvent = errors.New("events: event has no id or type")
+	ErrClosed       = eventbus.ErrClosed
+	// ErrSlowSubscriber ends a subscription that stopped keeping up with
+	// the published events.
+	ErrSlowSubscriber = eventbus.ErrSlowSubscriber
+)
+
+// Publisher delivers events to subscribers. Implementations must be safe for
+// concurrent use.
+type Publisher interface {
+	Publish(ctx context.Context, e *bookpb.BookingEvent) error
+}
+
+// Subscriber streams events matching filter until ctx is done, at which point
+// the subscription's channel is closed.
+type Subscriber interface {
+	Subscribe(ctx context.Context, filter Filter) (*Subscription, error)
+}
+
+// Subscription is a stream of events from a Subscriber.
+type Subscription = eventbus.Subscription[*bookpb.BookingEvent]
+
+// Filter selects events by type and hotel. Zero values match everything.
+type Filter struct {
+	Types   []string
+	HotelID string
+}
+
+func (f Filter) Match(e *bookpb.BookingEvent) bool {
+	if len(f.Types) > 0 && !slices.Contains(f.Types, e.GetType()) {
+		return false
+	}
+	return f.HotelID == "" || f.HotelID == HotelID(e)
+}
+
+// New wraps data in an envelope from source. data must be one of the booking
+// event payload messages.
+func New(source string, data proto.Message, at time.Time) (*bookpb.BookingEvent, error) {
+	e := &bookpb.BookingEvent{
+		Id:              rand.Text(),
+		Source:          source,
+		SpecVersion:     SpecVersion,
+		Time:            timestamppb.New(at),
+		DataContentType: DataContentType,
+	}
+
+	switch d := data.(type) {
+	case *bookpb.BookingCreated:
+		e.Type, e.Subject = TypeCreated, d.GetBooking().GetBookingId()
+		e.Data = &bookpb.BookingEvent_Created{Created: d}
+	case *bookpb.BookingCancelled:
+		e.Type, e.Subject = TypeCancelled, d.GetBookingId()
+		e.Data = &bookpb.BookingEvent_Cancelled{Cancelled: d}
+	case *bookpb.BookingModified:
+		e.Type, e.Subject = TypeModified, d.GetBooking().GetBookingId()
+		e.Data = &bookpb.BookingEvent_Modified{Modified: d}
+	case *bookpb.BookingStatusChanged:
+		e.Type, e.Subject = TypeStatusChanged, d.GetBookingId()
+		e.Data = &bookpb.BookingEvent_StatusChanged{StatusChanged: d}
+	default:
+		return nil, fmt.Errorf("events: unsupported payload %T", data)
+	}
+	return e, nil
+}
+
+// HotelID returns the hotel the event's booking belongs to.
+func HotelID(e *bookpb.BookingEvent) string {
+	switch d := e.GetData().(type) {
+	case *bookpb.BookingEvent_Created:
+		return d.Created.GetBooking().GetHotelId()
+	case *bookpb.BookingEvent_Cancelled:
+		return d.Cancelled.GetHotelId()
+	case *bookpb.BookingEvent_Modified:
+		return d.Modified.GetBooking().GetHotelId()
+	case *bookpb.BookingEvent_StatusChanged:
+		return d.StatusChanged.GetHotelId()
+	}
+	return ""
+}
+
+func validate(e *bookpb.BookingEvent) error {
+	if e.GetId() == "" || e.GetType() == "" {
+		return ErrInvalidEvent
+	}
+	return nil
+}
+
+// Serve implements the StreamBookingEvents RPC on top of sub: it forwards
+// matching events to stream until the client goes away or the subscription
+// ends. A client that falls behind gets ErrSlowSubscriber.
+func Serve(req *bookpb.StreamBookingEventsRequest, stream bookpb.BookingService_StreamBookingEventsServer, sub Subscriber) error {
+	ctx := stream.Context()
+	s, err := sub.Subscribe(ctx, Filter{Types: req.GetTypes(), HotelID: req.GetHotelId()})
+	if err != nil {
+		return err
+	}
+	for e := range s.C {
+		if err := stream.Send(e); err != nil {
+			return err
+		}
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,48 @@
+package events
+
+import (
+	"context"
+	"fmt"
+
+	bookpb "github.com/JunBSer/services_proto/booking/gen/go"
+	"github.com/JunBSer/services_proto/outbox"
+)
+
+// CloudEvents binary-mode header names set on outbox records.
+const (
+	HeaderType    = "ce-type"
+	HeaderSource  = "ce-source"
+	HeaderSubject = "ce-subject"
+)
+
+// Record serializes e for the outbox, reusing the event ID as the record ID.
+func Record(e *bookpb.BookingEvent) (outbox.Record, error) {
+	if err := validate(e); err != nil {
+		return outbox.Record{}, err
+	}
+	return outbox.NewRecord(e.GetId(), e, map[string]string{
+		HeaderType:    e.GetType(),
+		HeaderSource:  e.GetSource(),
+		HeaderSubject: e.GetSubject(),
+	})
+}
+
+// OutboxSink publishes outbox records holding booking events through pub.
+func OutboxSink(pub Publisher) outbox.Sink {
+	return outbox.SinkFunc(func(ctx context.Context, records []outbox.Record) error {
+		for _, r := range records {
+			m, err := r.Unmarshal()
+			if err != nil {
+				return err
+			}
+			e, ok := m.(*bookpb.BookingEvent)
+			if !ok {
+				return fmt.Errorf("events: outbox record %s holds %s, not a booking event", r.ID, r.TypeURL)
+			}
+			if err := pub.Publish(ctx, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
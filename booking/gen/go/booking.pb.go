@@ -7,6 +7,7 @@
 package bookpb
 
 import (
+	_ "github.com/JunBSer/services_proto/options/auth_options/gen/go"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -86,6 +87,7 @@ type CreateBookingRequest struct {
 	RoomId        string                 `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
 	StartDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
 	EndDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	RatePlanId    string                 `protobuf:"bytes,6,opt,name=rate_plan_id,json=ratePlanId,proto3" json:"rate_plan_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -155,6 +157,13 @@ func (x *CreateBookingRequest) GetEndDate() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CreateBookingRequest) GetRatePlanId() string {
+	if x != nil {
+		return x.RatePlanId
+	}
+	return ""
+}
+
 type BookingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
@@ -268,16 +277,19 @@ func (x *GetBookingRequest) GetBookingId() string {
 }
 
 type BookingDetails struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
-	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	HotelId       string                 `protobuf:"bytes,3,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Status        Status                 `protobuf:"varint,5,opt,name=status,proto3,enum=booking.Status" json:"status,omitempty"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	BookingId  string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	RoomId     string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	HotelId    string                 `protobuf:"bytes,3,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	UserId     string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status     Status                 `protobuf:"varint,5,opt,name=status,proto3,enum=booking.Status" json:"status,omitempty"`
+	StartDate  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	RatePlanId string                 `protobuf:"bytes,10,opt,name=rate_plan_id,json=ratePlanId,proto3" json:"rate_plan_id,omitempty"`
+	// Price of the whole stay, fixed when the booking is created.
+	TotalPrice    float64 `protobuf:"fixed64,11,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -375,6 +387,20 @@ func (x *BookingDetails) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *BookingDetails) GetRatePlanId() string {
+	if x != nil {
+		return x.RatePlanId
+	}
+	return ""
+}
+
+func (x *BookingDetails) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
 type CancelBookingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -491,6 +517,7 @@ type ListBookingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	Page          string                 `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -539,6 +566,13 @@ func (x *ListBookingsRequest) GetPage() string {
 	return ""
 }
 
+func (x *ListBookingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type ListBookingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Bookings      []*BookingDetails      `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
@@ -583,18 +617,500 @@ func (x *ListBookingsResponse) GetBookings() []*BookingDetails {
 	return nil
 }
 
+type StreamBookingEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Types         []string               `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	HotelId       string                 `protobuf:"bytes,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamBookingEventsRequest) Reset() {
+	*x = StreamBookingEventsRequest{}
+	mi := &file_proto_booking_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamBookingEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBookingEventsRequest) ProtoMessage() {}
+
+func (x *StreamBookingEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_booking_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBookingEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamBookingEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_booking_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StreamBookingEventsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *StreamBookingEventsRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+// BookingEvent is a CloudEvents 1.0 envelope. Attribute names follow the
+// CloudEvents spec; the payload is carried in the data oneof.
+type BookingEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source          string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	SpecVersion     string                 `protobuf:"bytes,3,opt,name=spec_version,json=specVersion,proto3" json:"spec_version,omitempty"`
+	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Subject         string                 `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	Time            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	DataContentType string                 `protobuf:"bytes,7,opt,name=data_content_type,json=dataContentType,proto3" json:"data_content_type,omitempty"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*BookingEvent_Created
+	//	*BookingEvent_Cancelled
+	//	*BookingEvent_Modified
+	//	*BookingEvent_StatusChanged
+	Data          isBookingEvent_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingEvent) Reset() {
+	*x = BookingEvent{}
+	mi := &file_proto_booking_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingEvent) ProtoMessage() {}
+
+func (x *BookingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_booking_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingEvent.ProtoReflect.Descriptor instead.
+func (*BookingEvent) Descriptor() ([]byte, []int) {
+	return file_proto_booking_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BookingEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BookingEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *BookingEvent) GetSpecVersion() string {
+	if x != nil {
+		return x.SpecVersion
+	}
+	return ""
+}
+
+func (x *BookingEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BookingEvent) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *BookingEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *BookingEvent) GetDataContentType() string {
+	if x != nil {
+		return x.DataContentType
+	}
+	return ""
+}
+
+func (x *BookingEvent) GetData() isBookingEvent_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BookingEvent) GetCreated() *BookingCreated {
+	if x != nil {
+		if x, ok := x.Data.(*BookingEvent_Created); ok {
+			return x.Created
+		}
+	}
+	return nil
+}
+
+func (x *BookingEvent) GetCancelled() *BookingCancelled {
+	if x != nil {
+		if x, ok := x.Data.(*BookingEvent_Cancelled); ok {
+			return x.Cancelled
+		}
+	}
+	return nil
+}
+
+func (x *BookingEvent) GetModified() *BookingModified {
+	if x != nil {
+		if x, ok := x.Data.(*BookingEvent_Modified); ok {
+			return x.Modified
+		}
+	}
+	return nil
+}
+
+func (x *BookingEvent) GetStatusChanged() *BookingStatusChanged {
+	if x != nil {
+		if x, ok := x.Data.(*BookingEvent_StatusChanged); ok {
+			return x.StatusChanged
+		}
+	}
+	return nil
+}
+
+type isBookingEvent_Data interface {
+	isBookingEvent_Data()
+}
+
+type BookingEvent_Created struct {
+	Created *BookingCreated `protobuf:"bytes,10,opt,name=created,proto3,oneof"`
+}
+
+type BookingEvent_Cancelled struct {
+	Cancelled *BookingCancelled `protobuf:"bytes,11,opt,name=cancelled,proto3,oneof"`
+}
+
+type BookingEvent_Modified struct {
+	Modified *BookingModified `protobuf:"bytes,12,opt,name=modified,proto3,oneof"`
+}
+
+type BookingEvent_StatusChanged struct {
+	StatusChanged *BookingStatusChanged `protobuf:"bytes,13,opt,name=status_changed,json=statusChanged,proto3,oneof"`
+}
+
+func (*BookingEvent_Created) isBookingEvent_Data() {}
+
+func (*BookingEvent_Cancelled) isBookingEvent_Data() {}
+
+func (*BookingEvent_Modified) isBookingEvent_Data() {}
+
+func (*BookingEvent_StatusChanged) isBookingEvent_Data() {}
+
+type BookingCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *BookingDetails        `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingCreated) Reset() {
+	*x = BookingCreated{}
+	mi := &file_proto_booking_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingCreated) ProtoMessage() {}
+
+func (x *BookingCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_booking_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingCreated.ProtoReflect.Descriptor instead.
+func (*BookingCreated) Descriptor() ([]byte, []int) {
+	return file_proto_booking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BookingCreated) GetBooking() *BookingDetails {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type BookingCancelled struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	HotelId       string                 `protobuf:"bytes,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CancelledAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingCancelled) Reset() {
+	*x = BookingCancelled{}
+	mi := &file_proto_booking_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingCancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingCancelled) ProtoMessage() {}
+
+func (x *BookingCancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_booking_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingCancelled.ProtoReflect.Descriptor instead.
+func (*BookingCancelled) Descriptor() ([]byte, []int) {
+	return file_proto_booking_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BookingCancelled) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *BookingCancelled) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *BookingCancelled) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *BookingCancelled) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BookingCancelled) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+type BookingModified struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Booking           *BookingDetails        `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	PreviousRoomId    string                 `protobuf:"bytes,2,opt,name=previous_room_id,json=previousRoomId,proto3" json:"previous_room_id,omitempty"`
+	PreviousStartDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=previous_start_date,json=previousStartDate,proto3" json:"previous_start_date,omitempty"`
+	PreviousEndDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=previous_end_date,json=previousEndDate,proto3" json:"previous_end_date,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BookingModified) Reset() {
+	*x = BookingModified{}
+	mi := &file_proto_booking_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingModified) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingModified) ProtoMessage() {}
+
+func (x *BookingModified) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_booking_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingModified.ProtoReflect.Descriptor instead.
+func (*BookingModified) Descriptor() ([]byte, []int) {
+	return file_proto_booking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BookingModified) GetBooking() *BookingDetails {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *BookingModified) GetPreviousRoomId() string {
+	if x != nil {
+		return x.PreviousRoomId
+	}
+	return ""
+}
+
+func (x *BookingModified) GetPreviousStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousStartDate
+	}
+	return nil
+}
+
+func (x *BookingModified) GetPreviousEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousEndDate
+	}
+	return nil
+}
+
+type BookingStatusChanged struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BookingId      string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	PreviousStatus Status                 `protobuf:"varint,2,opt,name=previous_status,json=previousStatus,proto3,enum=booking.Status" json:"previous_status,omitempty"`
+	Status         Status                 `protobuf:"varint,3,opt,name=status,proto3,enum=booking.Status" json:"status,omitempty"`
+	HotelId        string                 `protobuf:"bytes,4,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BookingStatusChanged) Reset() {
+	*x = BookingStatusChanged{}
+	mi := &file_proto_booking_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingStatusChanged) ProtoMessage() {}
+
+func (x *BookingStatusChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_booking_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingStatusChanged.ProtoReflect.Descriptor instead.
+func (*BookingStatusChanged) Descriptor() ([]byte, []int) {
+	return file_proto_booking_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BookingStatusChanged) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *BookingStatusChanged) GetPreviousStatus() Status {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return Status_STATUS_UNKNOWN
+}
+
+func (x *BookingStatusChanged) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_UNKNOWN
+}
+
+func (x *BookingStatusChanged) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
 var File_proto_booking_proto protoreflect.FileDescriptor
 
 const file_proto_booking_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/booking.proto\x12\abooking\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x94\x03\n" +
+	"\x13proto/booking.proto\x12\abooking\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x12auth_options.proto\"\xfc\x03\n" +
 	"\x14CreateBookingRequest\x12<\n" +
 	"\auser_id\x18\x01 \x01(\tB#\x92A 2\x1eUnique identifier for the userR\x06userId\x12>\n" +
 	"\bhotel_id\x18\x02 \x01(\tB#\x92A 2\x1eUnique identifier for the roomR\ahotelId\x12<\n" +
 	"\aroom_id\x18\x03 \x01(\tB#\x92A 2\x1eUnique identifier for the roomR\x06roomId\x12b\n" +
 	"\n" +
 	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB'\x92A$2\"Booking start date and time in UTCR\tstartDate\x12\\\n" +
-	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB%\x92A\"2 Booking end date and time in UTCR\aendDate\"\xd5\x01\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB%\x92A\"2 Booking end date and time in UTCR\aendDate\x12f\n" +
+	"\frate_plan_id\x18\x06 \x01(\tBD\x92AA2?Rate plan to book under. Empty books the room at its base priceR\n" +
+	"ratePlanId\"\xd5\x01\n" +
 	"\x0fBookingResponse\x12=\n" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\tB\x1e\x92A\x1b2\x19Unique booking identifierR\tbookingId\x12'\n" +
@@ -604,7 +1120,7 @@ const file_proto_booking_proto_rawDesc = "" +
 	"\x11GetBookingRequest\x12<\n" +
 	"\auser_id\x18\x01 \x01(\tB#\x92A 2\x1eUnique identifier for the userR\x06userId\x12=\n" +
 	"\n" +
-	"booking_id\x18\x02 \x01(\tB\x1e\x92A\x1b2\x19Unique booking identifierR\tbookingId\"\x8d\x03\n" +
+	"booking_id\x18\x02 \x01(\tB\x1e\x92A\x1b2\x19Unique booking identifierR\tbookingId\"\xd0\x03\n" +
 	"\x0eBookingDetails\x12\x1d\n" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x17\n" +
@@ -618,7 +1134,12 @@ const file_proto_booking_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9d\x01\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12 \n" +
+	"\frate_plan_id\x18\n" +
+	" \x01(\tR\n" +
+	"ratePlanId\x12\x1f\n" +
+	"\vtotal_price\x18\v \x01(\x01R\n" +
+	"totalPrice\"\x9d\x01\n" +
 	"\x14CancelBookingRequest\x12<\n" +
 	"\auser_id\x18\x01 \x01(\tB#\x92A 2\x1eUnique identifier for the userR\x06userId\x12G\n" +
 	"\n" +
@@ -626,19 +1147,57 @@ const file_proto_booking_proto_rawDesc = "" +
 	"\x15CancelBookingResponse\x12<\n" +
 	"\auser_id\x18\x01 \x01(\tB#\x92A 2\x1eUnique identifier for the userR\x06userId\x12<\n" +
 	"\asuccess\x18\x02 \x01(\bB\"\x92A\x1f2\x1dCancellation operation resultR\asuccess\x12Z\n" +
-	"\fcancelled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x1b\x92A\x182\x16Cancellation timestampR\vcancelledAt\"\x82\x01\n" +
+	"\fcancelled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x1b\x92A\x182\x16Cancellation timestampR\vcancelledAt\"\xc3\x01\n" +
 	"\x13ListBookingsRequest\x12@\n" +
 	"\tpage_size\x18\x01 \x01(\x05B#\x92A 2\x1aNumber of results per page:\x0210R\bpageSize\x12)\n" +
-	"\x04page\x18\x02 \x01(\tB\x15\x92A\x122\x10Pagination valueR\x04page\"K\n" +
+	"\x04page\x18\x02 \x01(\tB\x15\x92A\x122\x10Pagination valueR\x04page\x12?\n" +
+	"\auser_id\x18\x03 \x01(\tB&\x92A#2!Only return bookings of this userR\x06userId\"K\n" +
 	"\x14ListBookingsResponse\x123\n" +
-	"\bbookings\x18\x01 \x03(\v2\x17.booking.BookingDetailsR\bbookings*T\n" +
+	"\bbookings\x18\x01 \x03(\v2\x17.booking.BookingDetailsR\bbookings\"\xba\x01\n" +
+	"\x1aStreamBookingEventsRequest\x12X\n" +
+	"\x05types\x18\x01 \x03(\tBB\x92A?2=Event types to receive, e.g. booking.created. Empty means allR\x05types\x12B\n" +
+	"\bhotel_id\x18\x02 \x01(\tB'\x92A$2\"Only receive events for this hotelR\ahotelId\"\x9d\x06\n" +
+	"\fBookingEvent\x12D\n" +
+	"\x02id\x18\x01 \x01(\tB4\x92A12/Unique event identifier, used for deduplicationR\x02id\x12L\n" +
+	"\x06source\x18\x02 \x01(\tB4\x92A12/URI reference identifying the producing serviceR\x06source\x12L\n" +
+	"\fspec_version\x18\x03 \x01(\tB)\x92A&2$CloudEvents spec version, always 1.0R\vspecVersion\x129\n" +
+	"\x04type\x18\x04 \x01(\tB%\x92A\"2 Event type, e.g. booking.createdR\x04type\x12D\n" +
+	"\asubject\x18\x05 \x01(\tB*\x92A'2%Booking identifier the event is aboutR\asubject\x12L\n" +
+	"\x04time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x1c\x92A\x192\x17Time the event occurredR\x04time\x12d\n" +
+	"\x11data_content_type\x18\a \x01(\tB8\x92A523Media type of the data, always application/protobufR\x0fdataContentType\x123\n" +
+	"\acreated\x18\n" +
+	" \x01(\v2\x17.booking.BookingCreatedH\x00R\acreated\x129\n" +
+	"\tcancelled\x18\v \x01(\v2\x19.booking.BookingCancelledH\x00R\tcancelled\x126\n" +
+	"\bmodified\x18\f \x01(\v2\x18.booking.BookingModifiedH\x00R\bmodified\x12F\n" +
+	"\x0estatus_changed\x18\r \x01(\v2\x1d.booking.BookingStatusChangedH\x00R\rstatusChangedB\x06\n" +
+	"\x04data\"C\n" +
+	"\x0eBookingCreated\x121\n" +
+	"\abooking\x18\x01 \x01(\v2\x17.booking.BookingDetailsR\abooking\"\xbd\x01\n" +
+	"\x10BookingCancelled\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x19\n" +
+	"\bhotel_id\x18\x02 \x01(\tR\ahotelId\x12\x17\n" +
+	"\aroom_id\x18\x03 \x01(\tR\x06roomId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12=\n" +
+	"\fcancelled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\"\xa7\x02\n" +
+	"\x0fBookingModified\x12V\n" +
+	"\abooking\x18\x01 \x01(\v2\x17.booking.BookingDetailsB#\x92A 2\x1eBooking after the modificationR\abooking\x12(\n" +
+	"\x10previous_room_id\x18\x02 \x01(\tR\x0epreviousRoomId\x12J\n" +
+	"\x13previous_start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11previousStartDate\x12F\n" +
+	"\x11previous_end_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0fpreviousEndDate\"\xb3\x01\n" +
+	"\x14BookingStatusChanged\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x128\n" +
+	"\x0fprevious_status\x18\x02 \x01(\x0e2\x0f.booking.StatusR\x0epreviousStatus\x12'\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x0f.booking.StatusR\x06status\x12\x19\n" +
+	"\bhotel_id\x18\x04 \x01(\tR\ahotelId*T\n" +
 	"\x06Status\x12\x12\n" +
 	"\x0eSTATUS_UNKNOWN\x10\x00\x12\r\n" +
 	"\tCONFIRMED\x10\x01\x12\r\n" +
 	"\tCANCELLED\x10\x03\x12\n" +
 	"\n" +
 	"\x06FAILED\x10\x04\x12\f\n" +
-	"\bMODIFIED\x10\x052\xa5\x06\n" +
+	"\bMODIFIED\x10\x052\xd2\b\n" +
 	"\x0eBookingService\x12\xb6\x01\n" +
 	"\rCreateBooking\x12\x1d.booking.CreateBookingRequest\x1a\x18.booking.BookingResponse\"l\x92AR\n" +
 	"\bbookings\x12\x12Create new booking\x1a2Creates a new booking for specified room and dates\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/bookings\x12\xa9\x01\n" +
@@ -646,18 +1205,25 @@ const file_proto_booking_proto_rawDesc = "" +
 	"GetBooking\x12\x1a.booking.GetBookingRequest\x1a\x17.booking.BookingDetails\"f\x92AJ\n" +
 	"\bbookings\x12\x13Get booking details\x1a)Returns full details of specified booking\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/getbooking\x12\xc0\x01\n" +
 	"\rCancelBooking\x12\x1d.booking.CancelBookingRequest\x1a\x1e.booking.CancelBookingResponse\"p\x92AK\n" +
-	"\bbookings\x12\x0eCancel booking\x1a/Cancels existing booking and releases resources\x82\xd3\xe4\x93\x02\x1c:\x01**\x17/v1/cancel/{booking_id}\x12\xea\x01\n" +
-	"\fListBookings\x12\x1c.booking.ListBookingsRequest\x1a\x1d.booking.ListBookingsResponse\"\x9c\x01\x92A\x7f\n" +
-	"\x05admin\x12\x1eList all bookings (Admin only)\x1aAReturns paginated list of all bookings. Requires admin privilegesb\x13\n" +
-	"\x11\n" +
-	"\x06bearer\x12\a\n" +
-	"\x05admin\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/admin/bookingsB\x8f\x02\x92A\xd2\x01\x12@\n" +
-	"\x13Booking Service API\x12$API for managing hotel room bookings2\x031.0*\x01\x012\x10application/json:\x10application/jsonZY\n" +
-	"W\n" +
-	"\x06bearer\x12M\b\x02\x128Authentication token, prefixed by Bearer: Bearer <token>\x1a\rAuthorization \x02b\f\n" +
+	"\bbookings\x12\x0eCancel booking\x1a/Cancels existing booking and releases resources\x82\xd3\xe4\x93\x02\x1c:\x01**\x17/v1/cancel/{booking_id}\x12\xf3\x01\n" +
+	"\fListBookings\x12\x1c.booking.ListBookingsRequest\x1a\x1d.booking.ListBookingsResponse\"\xa5\x01\x92A\x83\x01\n" +
+	"\x05admin\x12\x1eList all bookings (Admin only)\x1aAReturns paginated list of all bookings. Requires admin privilegesb\x17\n" +
+	"\x15\n" +
+	"\n" +
+	"bearerAuth\x12\a\n" +
+	"\x05admin\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/admin/bookings\x12\xa1\x02\n" +
+	"\x13StreamBookingEvents\x12#.booking.StreamBookingEventsRequest\x1a\x15.booking.BookingEvent\"\xcb\x01\x92A\xa2\x01\n" +
+	"\x05admin\x12-Stream booking events (Service accounts only)\x1aXStreams booking lifecycle events as they are published. Requires a service account tokenb\x10\n" +
+	"\x0e\n" +
 	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x03\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/admin/bookings/events0\x01B\x97\x02\x92A\xda\x01\x12@\n" +
+	"\x13Booking Service API\x12$API for managing hotel room bookings2\x031.0*\x01\x012\x10application/json:\x10application/jsonZ]\n" +
+	"[\n" +
 	"\n" +
-	"\x06bearer\x12\x00Z7github.com/JunBSer/services_proto/booking/gen/go;bookpbb\x06proto3"
+	"bearerAuth\x12M\b\x02\x128Authentication token, prefixed by Bearer: Bearer <token>\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00Z7github.com/JunBSer/services_proto/booking/gen/go;bookpbb\x06proto3"
 
 var (
 	file_proto_booking_proto_rawDescOnce sync.Once
@@ -672,44 +1238,64 @@ func file_proto_booking_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_booking_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_proto_booking_proto_goTypes = []any{
-	(Status)(0),                   // 0: booking.Status
-	(*CreateBookingRequest)(nil),  // 1: booking.CreateBookingRequest
-	(*BookingResponse)(nil),       // 2: booking.BookingResponse
-	(*GetBookingRequest)(nil),     // 3: booking.GetBookingRequest
-	(*BookingDetails)(nil),        // 4: booking.BookingDetails
-	(*CancelBookingRequest)(nil),  // 5: booking.CancelBookingRequest
-	(*CancelBookingResponse)(nil), // 6: booking.CancelBookingResponse
-	(*ListBookingsRequest)(nil),   // 7: booking.ListBookingsRequest
-	(*ListBookingsResponse)(nil),  // 8: booking.ListBookingsResponse
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(Status)(0),                        // 0: booking.Status
+	(*CreateBookingRequest)(nil),       // 1: booking.CreateBookingRequest
+	(*BookingResponse)(nil),            // 2: booking.BookingResponse
+	(*GetBookingRequest)(nil),          // 3: booking.GetBookingRequest
+	(*BookingDetails)(nil),             // 4: booking.BookingDetails
+	(*CancelBookingRequest)(nil),       // 5: booking.CancelBookingRequest
+	(*CancelBookingResponse)(nil),      // 6: booking.CancelBookingResponse
+	(*ListBookingsRequest)(nil),        // 7: booking.ListBookingsRequest
+	(*ListBookingsResponse)(nil),       // 8: booking.ListBookingsResponse
+	(*StreamBookingEventsRequest)(nil), // 9: booking.StreamBookingEventsRequest
+	(*BookingEvent)(nil),               // 10: booking.BookingEvent
+	(*BookingCreated)(nil),             // 11: booking.BookingCreated
+	(*BookingCancelled)(nil),           // 12: booking.BookingCancelled
+	(*BookingModified)(nil),            // 13: booking.BookingModified
+	(*BookingStatusChanged)(nil),       // 14: booking.BookingStatusChanged
+	(*timestamppb.Timestamp)(nil),      // 15: google.protobuf.Timestamp
 }
 var file_proto_booking_proto_depIdxs = []int32{
-	9,  // 0: booking.CreateBookingRequest.start_date:type_name -> google.protobuf.Timestamp
-	9,  // 1: booking.CreateBookingRequest.end_date:type_name -> google.protobuf.Timestamp
+	15, // 0: booking.CreateBookingRequest.start_date:type_name -> google.protobuf.Timestamp
+	15, // 1: booking.CreateBookingRequest.end_date:type_name -> google.protobuf.Timestamp
 	0,  // 2: booking.BookingResponse.status:type_name -> booking.Status
-	9,  // 3: booking.BookingResponse.created_at:type_name -> google.protobuf.Timestamp
+	15, // 3: booking.BookingResponse.created_at:type_name -> google.protobuf.Timestamp
 	0,  // 4: booking.BookingDetails.status:type_name -> booking.Status
-	9,  // 5: booking.BookingDetails.start_date:type_name -> google.protobuf.Timestamp
-	9,  // 6: booking.BookingDetails.end_date:type_name -> google.protobuf.Timestamp
-	9,  // 7: booking.BookingDetails.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 8: booking.BookingDetails.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 9: booking.CancelBookingResponse.cancelled_at:type_name -> google.protobuf.Timestamp
+	15, // 5: booking.BookingDetails.start_date:type_name -> google.protobuf.Timestamp
+	15, // 6: booking.BookingDetails.end_date:type_name -> google.protobuf.Timestamp
+	15, // 7: booking.BookingDetails.created_at:type_name -> google.protobuf.Timestamp
+	15, // 8: booking.BookingDetails.updated_at:type_name -> google.protobuf.Timestamp
+	15, // 9: booking.CancelBookingResponse.cancelled_at:type_name -> google.protobuf.Timestamp
 	4,  // 10: booking.ListBookingsResponse.bookings:type_name -> booking.BookingDetails
-	1,  // 11: booking.BookingService.CreateBooking:input_type -> booking.CreateBookingRequest
-	3,  // 12: booking.BookingService.GetBooking:input_type -> booking.GetBookingRequest
-	5,  // 13: booking.BookingService.CancelBooking:input_type -> booking.CancelBookingRequest
-	7,  // 14: booking.BookingService.ListBookings:input_type -> booking.ListBookingsRequest
-	2,  // 15: booking.BookingService.CreateBooking:output_type -> booking.BookingResponse
-	4,  // 16: booking.BookingService.GetBooking:output_type -> booking.BookingDetails
-	6,  // 17: booking.BookingService.CancelBooking:output_type -> booking.CancelBookingResponse
-	8,  // 18: booking.BookingService.ListBookings:output_type -> booking.ListBookingsResponse
-	15, // [15:19] is the sub-list for method output_type
-	11, // [11:15] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	15, // 11: booking.BookingEvent.time:type_name -> google.protobuf.Timestamp
+	11, // 12: booking.BookingEvent.created:type_name -> booking.BookingCreated
+	12, // 13: booking.BookingEvent.cancelled:type_name -> booking.BookingCancelled
+	13, // 14: booking.BookingEvent.modified:type_name -> booking.BookingModified
+	14, // 15: booking.BookingEvent.status_changed:type_name -> booking.BookingStatusChanged
+	4,  // 16: booking.BookingCreated.booking:type_name -> booking.BookingDetails
+	15, // 17: booking.BookingCancelled.cancelled_at:type_name -> google.protobuf.Timestamp
+	4,  // 18: booking.BookingModified.booking:type_name -> booking.BookingDetails
+	15, // 19: booking.BookingModified.previous_start_date:type_name -> google.protobuf.Timestamp
+	15, // 20: booking.BookingModified.previous_end_date:type_name -> google.protobuf.Timestamp
+	0,  // 21: booking.BookingStatusChanged.previous_status:type_name -> booking.Status
+	0,  // 22: booking.BookingStatusChanged.status:type_name -> booking.Status
+	1,  // 23: booking.BookingService.CreateBooking:input_type -> booking.CreateBookingRequest
+	3,  // 24: booking.BookingService.GetBooking:input_type -> booking.GetBookingRequest
+	5,  // 25: booking.BookingService.CancelBooking:input_type -> booking.CancelBookingRequest
+	7,  // 26: booking.BookingService.ListBookings:input_type -> booking.ListBookingsRequest
+	9,  // 27: booking.BookingService.StreamBookingEvents:input_type -> booking.StreamBookingEventsRequest
+	2,  // 28: booking.BookingService.CreateBooking:output_type -> booking.BookingResponse
+	4,  // 29: booking.BookingService.GetBooking:output_type -> booking.BookingDetails
+	6,  // 30: booking.BookingService.CancelBooking:output_type -> booking.CancelBookingResponse
+	8,  // 31: booking.BookingService.ListBookings:output_type -> booking.ListBookingsResponse
+	10, // 32: booking.BookingService.StreamBookingEvents:output_type -> booking.BookingEvent
+	28, // [28:33] is the sub-list for method output_type
+	23, // [23:28] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_proto_booking_proto_init() }
@@ -717,13 +1303,19 @@ func file_proto_booking_proto_init() {
 	if File_proto_booking_proto != nil {
 		return
 	}
+	file_proto_booking_proto_msgTypes[9].OneofWrappers = []any{
+		(*BookingEvent_Created)(nil),
+		(*BookingEvent_Cancelled)(nil),
+		(*BookingEvent_Modified)(nil),
+		(*BookingEvent_StatusChanged)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_booking_proto_rawDesc), len(file_proto_booking_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   8,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookingService_CreateBooking_FullMethodName = "/booking.BookingService/CreateBooking"
-	BookingService_GetBooking_FullMethodName    = "/booking.BookingService/GetBooking"
-	BookingService_CancelBooking_FullMethodName = "/booking.BookingService/CancelBooking"
-	BookingService_ListBookings_FullMethodName  = "/booking.BookingService/ListBookings"
+	BookingService_CreateBooking_FullMethodName       = "/booking.BookingService/CreateBooking"
+	BookingService_GetBooking_FullMethodName          = "/booking.BookingService/GetBooking"
+	BookingService_CancelBooking_FullMethodName       = "/booking.BookingService/CancelBooking"
+	BookingService_ListBookings_FullMethodName        = "/booking.BookingService/ListBookings"
+	BookingService_StreamBookingEvents_FullMethodName = "/booking.BookingService/StreamBookingEvents"
 )
 
 // BookingServiceClient is the client API for BookingService service.
@@ -33,6 +34,7 @@ type BookingServiceClient interface {
 	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*BookingDetails, error)
 	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error)
 	ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error)
+	StreamBookingEvents(ctx context.Context, in *StreamBookingEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BookingEvent], error)
 }
 
 type bookingServiceClient struct {
@@ -83,6 +85,25 @@ func (c *bookingServiceClient) ListBookings(ctx context.Context, in *ListBooking
 	return out, nil
 }
 
+func (c *bookingServiceClient) StreamBookingEvents(ctx context.Context, in *StreamBookingEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BookingEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BookingService_ServiceDesc.Streams[0], BookingService_StreamBookingEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamBookingEventsRequest, BookingEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookingService_StreamBookingEventsClient = grpc.ServerStreamingClient[BookingEvent]
+
 // BookingServiceServer is the server API for BookingService service.
 // All implementations must embed UnimplementedBookingServiceServer
 // for forward compatibility.
@@ -91,6 +112,7 @@ type BookingServiceServer interface {
 	GetBooking(context.Context, *GetBookingRequest) (*BookingDetails, error)
 	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
 	ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsResponse, error)
+	StreamBookingEvents(*StreamBookingEventsRequest, grpc.ServerStreamingServer[BookingEvent]) error
 	mustEmbedUnimplementedBookingServiceServer()
 }
 
@@ -113,6 +135,9 @@ func (UnimplementedBookingServiceServer) CancelBooking(context.Context, *CancelB
 func (UnimplementedBookingServiceServer) ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBookings not implemented")
 }
+func (UnimplementedBookingServiceServer) StreamBookingEvents(*StreamBookingEventsRequest, grpc.ServerStreamingServer[BookingEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBookingEvents not implemented")
+}
 func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
 func (UnimplementedBookingServiceServer) testEmbeddedByValue()                        {}
 
@@ -206,6 +231,17 @@ func _BookingService_ListBookings_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_StreamBookingEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBookingEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookingServiceServer).StreamBookingEvents(m, &grpc.GenericServerStream[StreamBookingEventsRequest, BookingEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookingService_StreamBookingEventsServer = grpc.ServerStreamingServer[BookingEvent]
+
 // BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -230,6 +266,12 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _BookingService_ListBookings_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBookingEvents",
+			Handler:       _BookingService_StreamBookingEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "proto/booking.proto",
 }
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	options "github.com/JunBSer/services_proto/options/auth_options/gen/go"
+	"github.com/JunBSer/services_proto/options/methodopts"
+)
+
+// TokenStore holds the JWT pair used by the client. Implementations may
+// persist tokens between runs.
+type TokenStore interface {
+	Get(ctx context.Context) (*authpb.JWTPair, error)
+	Set(ctx context.Context, tokens *authpb.JWTPair) error
+}
+
+type MemoryTokenStore struct {
+	mu     sync.RWMutex
+	tokens *authpb.JWTPair
+	// OnChange, if set, is called after every update, e.g. to persist
+	// refreshed tokens.
+	OnChange func(*authpb.JWTPair)
+}
+
+func NewMemoryTokenStore(tokens *authpb.JWTPair) *MemoryTokenStore {
+	return &MemoryTokenStore{tokens: tokens}
+}
+
+func (s *MemoryTokenStore) Get(context.Context) (*authpb.JWTPair, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.tokens, nil
+}
+
+func (s *MemoryTokenStore) Set(_ context.Context, tokens *authpb.JWTPair) error {
+	s.mu.Lock()
+	s.tokens = tokens
+	onChange := s.OnChange
+	s.mu.Unlock()
+
+	if onChange != nil {
+		onChange(tokens)
+	}
+	return nil
+}
+
+// requiresAuth reports whether method needs a bearer token. Methods without
+// an auth_level option (e.g. BookingService) are treated as authenticated.
+func requiresAuth(method string) bool {
+	level, ok := methodopts.AuthLevel(method)
+	return !ok || level != options.AuthLevel_NONE
+}
+
+func withBearer(ctx context.Context, access string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+access)
+}
+
+// authInterceptor attaches the access token to authenticated calls and, when
+// the server answers Unauthenticated, refreshes the tokens once and retries.
+func (c *Client) authInterceptor() grpc.UnaryClientInterceptor {
+	var refreshMu sync.Mutex
+
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !requiresAuth(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		tokens, err := c.tokens.Get(ctx)
+		if err != nil {
+			return err
+		}
+		if tokens.GetAccessToken() == "" {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		err = invoker(withBearer(ctx, tokens.GetAccessToken()), method, req, reply, cc, opts...)
+		if status.Code(err) != codes.Unauthenticated || tokens.GetRefreshToken() == "" {
+			return err
+		}
+
+		refreshed, rerr := c.refresh(ctx, &refreshMu, tokens)
+		if rerr != nil {
+			return err
+		}
+		return invoker(withBearer(ctx, refreshed.GetAccessToken()), method, req, reply, cc, opts...)
+	}
+}
+
+func (c *Client) authStreamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if requiresAuth(method) {
+			tokens, err := c.tokens.Get(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if tokens.GetAccessToken() != "" {
+				ctx = withBearer(ctx, tokens.GetAccessToken())
+			}
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+// refresh exchanges the refresh token for a new pair. Concurrent callers that
+// failed with the same stale token share a single RefreshToken call.
+func (c *Client) refresh(ctx context.Context, mu *sync.Mutex, stale *authpb.JWTPair) (*authpb.JWTPair, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	current, err := c.tokens.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if current.GetAccessToken() != stale.GetAccessToken() && current.GetAccessToken() != "" {
+		return current, nil
+	}
+
+	resp, err := c.Auth.RefreshToken(ctx, &authpb.RefreshRequest{RefreshToken: stale.GetRefreshToken()})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.tokens.Set(ctx, resp.GetTokens()); err != nil {
+		return nil, err
+	}
+	return resp.GetTokens(), nil
+}
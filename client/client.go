@@ -0,0 +1,228 @@
+// Package client is a typed Go SDK for the Auth, HotelService and
+// BookingService APIs. It attaches bearer tokens, refreshes them when they
+// expire and retries idempotent calls on transient failures.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/auth/reauth"
+	bookpb "github.com/JunBSer/services_proto/booking/gen/go"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+)
+
+// ErrMFARequired is returned by Login when the account has MFA enabled. Use
+// errors.As with *MFARequiredError to obtain the challenge.
+var ErrMFARequired = errors.New("client: multi-factor authentication required")
+
+type MFARequiredError struct {
+	Challenge *authpb.MFAChallenge
+}
+
+func (e *MFARequiredError) Error() string { return ErrMFARequired.Error() }
+
+func (e *MFARequiredError) Unwrap() error { return ErrMFARequired }
+
+type Config struct {
+	AuthTarget    string
+	HotelTarget   string
+	BookingTarget string
+
+	// DialOptions are used for every connection. Defaults to insecure
+	// transport credentials.
+	DialOptions []grpc.DialOption
+
+	Retry RetryPolicy
+
+	// Tokens stores the current JWT pair. Defaults to an in-memory store.
+	Tokens TokenStore
+}
+
+// Client bundles the three service clients. Its fields are ready to use for
+// any RPC; the helper methods cover flows that need client-side state.
+type Client struct {
+	Auth     authpb.AuthClient
+	Hotels   hotelpb.HotelServiceClient
+	Bookings bookpb.BookingServiceClient
+
+	tokens TokenStore
+	conns  []*grpc.ClientConn
+}
+
+func New(cfg Config) (*Client, error) {
+	if cfg.AuthTarget == "" {
+		return nil, errors.New("client: AuthTarget is required")
+	}
+
+	c := &Client{tokens: cfg.Tokens}
+	if c.tokens == nil {
+		c.tokens = NewMemoryTokenStore(nil)
+	}
+
+	// Copy so appending the interceptors never writes into the caller's
+	// backing array.
+	dialOpts := slices.Clone(cfg.DialOptions)
+	if len(dialOpts) == 0 {
+		dialOpts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+
+	dialOpts = append(dialOpts,
+		grpc.WithChainUnaryInterceptor(retryInterceptor(cfg.Retry), c.authInterceptor()),
+		grpc.WithChainStreamInterceptor(c.authStreamInterceptor()),
+	)
+
+	authConn, err := grpc.NewClient(cfg.AuthTarget, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("client: dial auth: %w", err)
+	}
+	c.conns = append(c.conns, authConn)
+	c.Auth = authpb.NewAuthClient(authConn)
+
+	dial := func(target string) (*grpc.ClientConn, error) {
+		if target == "" || target == cfg.AuthTarget {
+			return authConn, nil
+		}
+		conn, err := grpc.NewClient(target, dialOpts...)
+		if err != nil {
+			return nil, err
+		}
+		c.conns = append(c.conns, conn)
+		return conn, nil
+	}
+
+	hotelConn, err := dial(cfg.HotelTarget)
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("client: dial hotel: %w", err)
+	}
+	bookingConn, err := dial(cfg.BookingTarget)
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("client: dial booking: %w", err)
+	}
+	c.Hotels = hotelpb.NewHotelServiceClient(hotelConn)
+	c.Bookings = bookpb.NewBookingServiceClient(bookingConn)
+
+	return c, nil
+}
+
+// NewFromConn builds a Client that sends all three services over conn, e.g.
+// a connection to the gateway or an in-process bufconn server.
+func NewFromConn(conn grpc.ClientConnInterface, tokens TokenStore, retry RetryPolicy) *Client {
+	if tokens == nil {
+		tokens = NewMemoryTokenStore(nil)
+	}
+	c := &Client{tokens: tokens}
+	wrapped := &interceptedConn{
+		conn:         conn,
+		interceptors: []grpc.UnaryClientInterceptor{retryInterceptor(retry), c.authInterceptor()},
+		stream:       c.authStreamInterceptor(),
+	}
+	c.Auth = authpb.NewAuthClient(wrapped)
+	c.Hotels = hotelpb.NewHotelServiceClient(wrapped)
+	c.Bookings = bookpb.NewBookingServiceClient(wrapped)
+	return c
+}
+
+// Login authenticates with email and password and stores the tokens.
+func (c *Client) Login(ctx context.Context, email, password string) error {
+	resp, err := c.Auth.Login(ctx, &authpb.LoginRequest{Email: email, Password: password})
+	if err != nil {
+		return err
+	}
+	if ch := resp.GetMfaChallenge(); ch != nil && resp.GetTokens() == nil {
+		return &MFARequiredError{Challenge: ch}
+	}
+	return c.tokens.Set(ctx, resp.GetTokens())
+}
+
+// VerifyMFA completes a login that returned MFARequiredError.
+func (c *Client) VerifyMFA(ctx context.Context, challenge *authpb.MFAChallenge, totpCode string) error {
+	resp, err := c.Auth.VerifyMFA(ctx, &authpb.VerifyMFARequest{
+		MfaToken:   challenge.GetMfaToken(),
+		Credential: &authpb.VerifyMFARequest_TotpCode{TotpCode: totpCode},
+	})
+	if err != nil {
+		return err
+	}
+	return c.tokens.Set(ctx, resp.GetTokens())
+}
+
+// Reauthenticate confirms the user's identity and returns ctx carrying the
+// step-up token that ChangePassword, DeleteAccount and email changes require.
+// totpCode is needed only when MFA is enabled.
+func (c *Client) Reauthenticate(ctx context.Context, password, totpCode string) (context.Context, error) {
+	resp, err := c.Auth.Reauthenticate(ctx, &authpb.ReauthenticateRequest{Password: password, TotpCode: totpCode})
+	if err != nil {
+		return nil, err
+	}
+	return reauth.WithToken(ctx, resp.GetReauthToken()), nil
+}
+
+// Logout invalidates the refresh token on the server and clears local tokens.
+func (c *Client) Logout(ctx context.Context) error {
+	tokens, err := c.tokens.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if tokens.GetRefreshToken() != "" {
+		if _, err := c.Auth.Logout(ctx, &authpb.LogoutRequest{RefreshToken: tokens.GetRefreshToken()}); err != nil {
+			return err
+		}
+	}
+	return c.tokens.Set(ctx, nil)
+}
+
+// SetTokens replaces the stored tokens, e.g. with ones persisted earlier.
+func (c *Client) SetTokens(ctx context.Context, tokens *authpb.JWTPair) error {
+	return c.tokens.Set(ctx, tokens)
+}
+
+func (c *Client) Tokens(ctx context.Context) (*authpb.JWTPair, error) {
+	return c.tokens.Get(ctx)
+}
+
+// Close closes the connections opened by New.
+func (c *Client) Close() error {
+	var errs []error
+	for _, conn := range c.conns {
+		errs = append(errs, conn.Close())
+	}
+	c.conns = nil
+	return errors.Join(errs...)
+}
+
+// interceptedConn applies unary interceptors to a connection that was not
+// dialed by this package.
+type interceptedConn struct {
+	conn         grpc.ClientConnInterface
+	interceptors []grpc.UnaryClientInterceptor
+	stream       grpc.StreamClientInterceptor
+}
+
+func (ic *interceptedConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	invoker := func(ctx context.Context, method string, req, reply any, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return ic.conn.Invoke(ctx, method, req, reply, opts...)
+	}
+	for i := len(ic.interceptors) - 1; i >= 0; i-- {
+		next, interceptor := invoker, ic.interceptors[i]
+		invoker = func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			return interceptor(ctx, method, req, reply, cc, next, opts...)
+		}
+	}
+	return invoker(ctx, method, args, reply, nil, opts...)
+}
+
+func (ic *interceptedConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	streamer := func(ctx context.Context, desc *grpc.StreamDesc, _ *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return ic.conn.NewStream(ctx, desc, method, opts...)
+	}
+	return ic.stream(ctx, desc, nil, method, streamer, opts...)
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+)
+
+// usersServer serves ListUsers from a fixed list. It reports Total only when
+// reportTotal is set and fails the page named by failPage.
+type usersServer struct {
+	authpb.UnimplementedAuthServer
+	users       []*authpb.UserResponse
+	reportTotal bool
+	failPage    int32
+
+	mu    sync.Mutex
+	pages []int32
+}
+
+func (s *usersServer) ListUsers(_ context.Context, req *authpb.ListUsersRequest) (*authpb.ListUsersResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pages = append(s.pages, req.GetPage())
+	if req.GetPage() == s.failPage {
+		return nil, status.Error(codes.PermissionDenied, "no")
+	}
+	start := min(int((req.GetPage()-1)*req.GetLimit()), len(s.users))
+	end := min(start+int(req.GetLimit()), len(s.users))
+	resp := &authpb.ListUsersResponse{Users: s.users[start:end], Page: req.GetPage()}
+	if s.reportTotal {
+		resp.Total = int32(len(s.users))
+	}
+	return resp, nil
+}
+
+func startUsersServer(t *testing.T, srv *usersServer) *Client {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer()
+	authpb.RegisterAuthServer(gs, srv)
+	go gs.Serve(lis)
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return NewFromConn(conn, nil, RetryPolicy{})
+}
+
+func testUsers(n int) []*authpb.UserResponse {
+	users := make([]*authpb.UserResponse, n)
+	for i := range users {
+		users[i] = &authpb.UserResponse{Email: fmt.Sprintf("user%d@example.com", i)}
+	}
+	return users
+}
+
+func TestAllUsers(t *testing.T) {
+	tests := []struct {
+		name        string
+		users       int
+		pageSize    int32
+		reportTotal bool
+		failPage    int32
+		stopAfter   int
+		wantUsers   int
+		wantPages   []int32
+		wantErr     codes.Code
+	}{
+		{name: "empty", pageSize: 2, wantPages: []int32{1}},
+		{name: "short last page", users: 5, pageSize: 2, wantUsers: 5, wantPages: []int32{1, 2, 3}},
+		{name: "full last page", users: 4, pageSize: 2, wantUsers: 4, wantPages: []int32{1, 2, 3}},
+		{name: "total reported", users: 5, pageSize: 2, reportTotal: true, wantUsers: 5, wantPages: []int32{1, 2, 3}},
+		{name: "default page size", users: 60, wantUsers: 60, wantPages: []int32{1, 2}},
+		{name: "caller stops", users: 5, pageSize: 2, stopAfter: 3, wantUsers: 3, wantPages: []int32{1, 2}},
+		{
+			name: "error", users: 5, pageSize: 2, failPage: 2,
+			wantUsers: 2, wantPages: []int32{1, 2}, wantErr: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &usersServer{users: testUsers(tt.users), reportTotal: tt.reportTotal, failPage: tt.failPage}
+			c := startUsersServer(t, srv)
+
+			var (
+				got []string
+				err error
+			)
+			for u, e := range c.AllUsers(context.Background(), tt.pageSize) {
+				if e != nil {
+					err = e
+					break
+				}
+				got = append(got, u.GetEmail())
+				if len(got) == tt.stopAfter {
+					break
+				}
+			}
+
+			if status.Code(err) != tt.wantErr {
+				t.Fatalf("error = %v, want code %v", err, tt.wantErr)
+			}
+			if len(got) != tt.wantUsers {
+				t.Errorf("got %d users, want %d", len(got), tt.wantUsers)
+			}
+			for i, email := range got {
+				if want := srv.users[i].GetEmail(); email != want {
+					t.Errorf("user %d = %s, want %s", i, email, want)
+				}
+			}
+			if fmt.Sprint(srv.pages) != fmt.Sprint(tt.wantPages) {
+				t.Errorf("requested pages %v, want %v", srv.pages, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestNewKeepsDialOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []grpc.DialOption
+	}{
+		{"default", nil},
+		{"spare capacity", append(make([]grpc.DialOption, 0, 8), grpc.WithTransportCredentials(insecure.NewCredentials()))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(Config{AuthTarget: "passthrough:///auth", HotelTarget: "passthrough:///hotel", DialOptions: tt.opts})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer c.Close()
+
+			for i, o := range tt.opts[len(tt.opts):cap(tt.opts)] {
+				if o != nil {
+					t.Errorf("New wrote option %d into the caller's backing array", len(tt.opts)+i)
+				}
+			}
+			if len(c.conns) != 2 {
+				t.Errorf("New opened %d connections, want 2", len(c.conns))
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"strconv"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	bookpb "github.com/JunBSer/services_proto/booking/gen/go"
+)
+
+const defaultPageSize = 50
+
+// AllUsers iterates over all users page by page until the server returns a
+// short page; the response's Total is not relied on. Iteration stops at the
+// first error, which is yielded with a nil user.
+func (c *Client) AllUsers(ctx context.Context, pageSize int32) iter.Seq2[*authpb.UserResponse, error] {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	return func(yield func(*authpb.UserResponse, error) bool) {
+		for page := int32(1); ; page++ {
+			resp, err := c.Auth.ListUsers(ctx, &authpb.ListUsersRequest{Page: page, Limit: pageSize})
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, u := range resp.GetUsers() {
+				if !yield(u, nil) {
+					return
+				}
+			}
+			if len(resp.GetUsers()) < int(pageSize) {
+				return
+			}
+		}
+	}
+}
+
+// AllBookings iterates over all bookings (admin only). The page cursor is the
+// 1-based page number.
+func (c *Client) AllBookings(ctx context.Context, pageSize int32) iter.Seq2[*bookpb.BookingDetails, error] {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	return func(yield func(*bookpb.BookingDetails, error) bool) {
+		for page := 1; ; page++ {
+			resp, err := c.Bookings.ListBookings(ctx, &bookpb.ListBookingsRequest{
+				PageSize: pageSize,
+				Page:     strconv.Itoa(page),
+			})
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, b := range resp.GetBookings() {
+				if !yield(b, nil) {
+					return
+				}
+			}
+			if len(resp.GetBookings()) < int(pageSize) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/JunBSer/services_proto/options/methodopts"
+)
+
+// RetryPolicy controls retries of idempotent calls. The zero value disables
+// retries.
+type RetryPolicy struct {
+	// MaxAttempts includes the first call.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Codes are retried. Defaults to Unavailable.
+	Codes []codes.Code
+	// Idempotent decides which methods may be retried. Defaults to methods
+	// bound to HTTP GET.
+	Idempotent func(method string) bool
+}
+
+// DefaultRetryPolicy retries idempotent calls up to 4 times with exponential
+// backoff starting at 100ms.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+}
+
+func (p RetryPolicy) retryable(method string, err error) bool {
+	isIdempotent := p.Idempotent
+	if isIdempotent == nil {
+		isIdempotent = methodopts.IsSafe
+	}
+	if !isIdempotent(method) {
+		return false
+	}
+
+	retryCodes := p.Codes
+	if len(retryCodes) == 0 {
+		retryCodes = []codes.Code{codes.Unavailable}
+	}
+	code := status.Code(err)
+	for _, c := range retryCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the given retry (1-based) with full jitter.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	d := p.InitialBackoff
+	if d <= 0 {
+		d = 100 * time.Millisecond
+	}
+	for i := 1; i < retry; i++ {
+		d *= 2
+		if p.MaxBackoff > 0 && d >= p.MaxBackoff {
+			d = p.MaxBackoff
+			break
+		}
+	}
+	return time.Duration(rand.Int64N(int64(d)) + 1)
+}
+
+func retryInterceptor(p RetryPolicy) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		for attempt := 1; attempt < p.MaxAttempts && err != nil && p.retryable(method, err); attempt++ {
+			timer := time.NewTimer(p.backoff(attempt))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+			err = invoker(ctx, method, req, reply, cc, opts...)
+		}
+		return err
+	}
+}
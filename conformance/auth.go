@@ -0,0 +1,430 @@
+package conformance
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+)
+
+const testPassword = "conformance-password"
+
+// RunAuthConformance runs the Auth contract against the clients returned by
+// newClient. admin must be an existing admin account without MFA.
+func RunAuthConformance(t *testing.T, newClient func() authpb.AuthClient, admin Credentials) {
+	t.Helper()
+
+	// register creates a fresh account and returns its email and ID.
+	register := func(t *testing.T, c authpb.AuthClient) (string, string) {
+		t.Helper()
+
+		email := unique("user-") + "@example.com"
+		resp, err := c.Register(call(t, Principal{}), &authpb.RegisterRequest{
+			Name: "Conformance", Email: email, Password: testPassword,
+		})
+		wantOK(t, err)
+		if resp.GetUserId().GetValue() == "" {
+			t.Fatal("Register returned no user_id")
+		}
+		return email, resp.GetUserId().GetValue()
+	}
+
+	login := func(t *testing.T, c authpb.AuthClient, email, password string) *authpb.JWTPair {
+		t.Helper()
+
+		resp, err := c.Login(call(t, Principal{}), &authpb.LoginRequest{Email: email, Password: password})
+		wantOK(t, err)
+		if resp.GetTokens().GetAccessToken() == "" || resp.GetTokens().GetRefreshToken() == "" {
+			t.Fatalf("Login returned incomplete tokens: %v", resp)
+		}
+		return resp.GetTokens()
+	}
+
+	// stepUp returns p with a step-up token for password.
+	stepUp := func(t *testing.T, c authpb.AuthClient, p Principal, password string) Principal {
+		t.Helper()
+
+		resp, err := c.Reauthenticate(call(t, p), &authpb.ReauthenticateRequest{Password: password})
+		wantOK(t, err)
+		if resp.GetReauthToken() == "" {
+			t.Fatal("Reauthenticate returned no reauth_token")
+		}
+		p.ReauthToken = resp.GetReauthToken()
+		return p
+	}
+
+	adminPrincipal := func(t *testing.T, c authpb.AuthClient) Principal {
+		t.Helper()
+
+		p, err := Login(call(t, Principal{}), c, admin.Email, admin.Password)
+		wantOK(t, err)
+		return p
+	}
+
+	t.Run("Register", func(t *testing.T) {
+		c := newClient()
+		email, _ := register(t, c)
+
+		_, err := c.Register(call(t, Principal{}), &authpb.RegisterRequest{
+			Name: "Duplicate", Email: email, Password: testPassword,
+		})
+		wantError(t, err, codes.AlreadyExists, apierrors.ReasonUserAlreadyExists)
+
+		_, err = c.Register(call(t, Principal{}), &authpb.RegisterRequest{
+			Name: "Invalid", Email: "not-an-email", Password: "short",
+		})
+		wantError(t, err, codes.InvalidArgument, "")
+	})
+
+	t.Run("Login", func(t *testing.T) {
+		c := newClient()
+		email, _ := register(t, c)
+		login(t, c, email, testPassword)
+
+		_, err := c.Login(call(t, Principal{}), &authpb.LoginRequest{Email: email, Password: "wrong-password"})
+		wantError(t, err, codes.Unauthenticated, apierrors.ReasonInvalidCredentials)
+
+		// Unknown accounts must be indistinguishable from wrong passwords.
+		_, err = c.Login(call(t, Principal{}), &authpb.LoginRequest{
+			Email: unique("nobody-") + "@example.com", Password: testPassword,
+		})
+		wantError(t, err, codes.Unauthenticated, apierrors.ReasonInvalidCredentials)
+	})
+
+	t.Run("ValidateToken", func(t *testing.T) {
+		c := newClient()
+		email, id := register(t, c)
+		tokens := login(t, c, email, testPassword)
+
+		resp, err := c.ValidateToken(call(t, Principal{}), &authpb.ValidateTokenRequest{Token: tokens.GetAccessToken()})
+		wantOK(t, err)
+		if !resp.GetIsValid() || resp.GetUserId().GetValue() != id || resp.GetIsAdmin() {
+			t.Fatalf("ValidateToken = %v, want valid non-admin token for %s", resp, id)
+		}
+
+		resp, err = c.ValidateToken(call(t, Principal{}), &authpb.ValidateTokenRequest{Token: "garbage"})
+		wantOK(t, err)
+		if resp.GetIsValid() {
+			t.Fatal("ValidateToken accepted a malformed token")
+		}
+	})
+
+	t.Run("RefreshToken", func(t *testing.T) {
+		c := newClient()
+		email, _ := register(t, c)
+		tokens := login(t, c, email, testPassword)
+
+		resp, err := c.RefreshToken(call(t, Principal{}), &authpb.RefreshRequest{RefreshToken: tokens.GetRefreshToken()})
+		wantOK(t, err)
+		if resp.GetTokens().GetRefreshToken() == "" || resp.GetTokens().GetRefreshToken() == tokens.GetRefreshToken() {
+			t.Fatal("RefreshToken did not rotate the refresh token")
+		}
+
+		// Refresh tokens are single use.
+		_, err = c.RefreshToken(call(t, Principal{}), &authpb.RefreshRequest{RefreshToken: tokens.GetRefreshToken()})
+		wantError(t, err, codes.Unauthenticated, "")
+	})
+
+	t.Run("Logout", func(t *testing.T) {
+		c := newClient()
+		email, _ := register(t, c)
+		tokens := login(t, c, email, testPassword)
+		user := Principal{AccessToken: tokens.GetAccessToken()}
+
+		_, err := c.Logout(call(t, user), &authpb.LogoutRequest{RefreshToken: tokens.GetRefreshToken()})
+		wantOK(t, err)
+
+		_, err = c.RefreshToken(call(t, Principal{}), &authpb.RefreshRequest{RefreshToken: tokens.GetRefreshToken()})
+		wantError(t, err, codes.Unauthenticated, "")
+	})
+
+	t.Run("ChangePassword", func(t *testing.T) {
+		c := newClient()
+		email, _ := register(t, c)
+		tokens := login(t, c, email, testPassword)
+		user := Principal{AccessToken: tokens.GetAccessToken()}
+
+		_, err := c.ChangePassword(call(t, user), &authpb.ChangePasswordRequest{
+			AccessToken: tokens.GetAccessToken(), OldPassword: testPassword, NewPassword: "new-" + testPassword,
+		})
+		wantError(t, err, codes.Unauthenticated, apierrors.ReasonReauthRequired)
+
+		user = stepUp(t, c, user, testPassword)
+		_, err = c.ChangePassword(call(t, user), &authpb.ChangePasswordRequest{
+			AccessToken: tokens.GetAccessToken(), OldPassword: "wrong-password", NewPassword: "new-" + testPassword,
+		})
+		wantError(t, err, codes.InvalidArgument, apierrors.ReasonInvalidCredentials)
+
+		_, err = c.ChangePassword(call(t, user), &authpb.ChangePasswordRequest{
+			AccessToken: tokens.GetAccessToken(), OldPassword: testPassword, NewPassword: "new-" + testPassword,
+		})
+		wantOK(t, err)
+
+		login(t, c, email, "new-"+testPassword)
+		_, err = c.Login(call(t, Principal{}), &authpb.LoginRequest{Email: email, Password: testPassword})
+		wantError(t, err, codes.Unauthenticated, apierrors.ReasonInvalidCredentials)
+	})
+
+	t.Run("UpdateProfile", func(t *testing.T) {
+		c := newClient()
+		email, id := register(t, c)
+		tokens := login(t, c, email, testPassword)
+		user := Principal{AccessToken: tokens.GetAccessToken()}
+
+		resp, err := c.UpdateProfile(call(t, user), &authpb.UpdateProfileRequest{
+			AccessToken: tokens.GetAccessToken(), Name: "Renamed",
+		})
+		wantOK(t, err)
+		if resp.GetName() != "Renamed" || resp.GetUserId().GetValue() != id {
+			t.Fatalf("UpdateProfile = %v, want name Renamed for %s", resp, id)
+		}
+
+		newEmail := unique("renamed-") + "@example.com"
+		_, err = c.UpdateProfile(call(t, user), &authpb.UpdateProfileRequest{Email: newEmail})
+		wantError(t, err, codes.Unauthenticated, apierrors.ReasonReauthRequired)
+
+		resp, err = c.UpdateProfile(call(t, stepUp(t, c, user, testPassword)), &authpb.UpdateProfileRequest{Email: newEmail})
+		wantOK(t, err)
+		if resp.GetEmail() != newEmail {
+			t.Fatalf("UpdateProfile email = %q, want %q", resp.GetEmail(), newEmail)
+		}
+
+		_, err = c.UpdateProfile(call(t, Principal{}), &authpb.UpdateProfileRequest{Name: "Anonymous"})
+		wantError(t, err, codes.Unauthenticated, "")
+
+		// Another user's token in the body must not select that account.
+		attackerEmail, _ := register(t, c)
+		attacker := stepUp(t, c, Principal{AccessToken: login(t, c, attackerEmail, testPassword).GetAccessToken()}, testPassword)
+		_, err = c.UpdateProfile(call(t, attacker), &authpb.UpdateProfileRequest{
+			AccessToken: tokens.GetAccessToken(), Email: unique("owned-") + "@example.com",
+		})
+		wantError(t, err, codes.PermissionDenied, apierrors.ReasonPermissionDenied)
+		_, err = c.ChangePassword(call(t, attacker), &authpb.ChangePasswordRequest{
+			AccessToken: tokens.GetAccessToken(), OldPassword: testPassword, NewPassword: "Owned-password-1",
+		})
+		wantError(t, err, codes.PermissionDenied, apierrors.ReasonPermissionDenied)
+	})
+
+	t.Run("Reauthenticate", func(t *testing.T) {
+		c := newClient()
+		email, _ := register(t, c)
+		user := Principal{AccessToken: login(t, c, email, testPassword).GetAccessToken()}
+		otherEmail, _ := register(t, c)
+		other := Principal{AccessToken: login(t, c, otherEmail, testPassword).GetAccessToken()}
+
+		_, err := c.Reauthenticate(call(t, user), &authpb.ReauthenticateRequest{Password: "wrong-password"})
+		wantError(t, err, codes.Unauthenticated, apierrors.ReasonInvalidCredentials)
+
+		_, err = c.Reauthenticate(call(t, Principal{}), &authpb.ReauthenticateRequest{Password: testPassword})
+		wantError(t, err, codes.Unauthenticated, "")
+
+		// A step-up token only vouches for the user it was issued to.
+		other.ReauthToken = stepUp(t, c, user, testPassword).ReauthToken
+		_, err = c.ChangePassword(call(t, other), &authpb.ChangePasswordRequest{
+			OldPassword: testPassword, NewPassword: "new-" + testPassword,
+		})
+		wantError(t, err, codes.Unauthenticated, apierrors.ReasonReauthRequired)
+	})
+
+	t.Run("AuthLevels", func(t *testing.T) {
+		c := newClient()
+		email, _ := register(t, c)
+		user := Principal{AccessToken: login(t, c, email, testPassword).GetAccessToken()}
+
+		_, err := c.ListUsers(call(t, Principal{}), &authpb.ListUsersRequest{Page: 1, Limit: 10})
+		wantError(t, err, codes.Unauthenticated, "")
+
+		_, err = c.ListUsers(call(t, Principal{AccessToken: "garbage"}), &authpb.ListUsersRequest{Page: 1, Limit: 10})
+		wantError(t, err, codes.Unauthenticated, "")
+
+		_, err = c.ListUsers(call(t, user), &authpb.ListUsersRequest{Page: 1, Limit: 10})
+		wantError(t, err, codes.PermissionDenied, "")
+
+		_, err = c.ListUsers(call(t, adminPrincipal(t, c)), &authpb.ListUsersRequest{Page: 1, Limit: 10})
+		wantOK(t, err)
+	})
+
+	t.Run("AdminUsers", func(t *testing.T) {
+		c := newClient()
+		root := adminPrincipal(t, c)
+		email := unique("managed-") + "@example.com"
+
+		u, err := c.CreateUser(call(t, root), &authpb.CreateUserRequest{
+			Name: "Managed", Email: email, Password: testPassword,
+		})
+		wantOK(t, err)
+		id := u.GetUserId().GetValue()
+		if id == "" || u.GetEmail() != email || u.GetIsAdmin() {
+			t.Fatalf("CreateUser = %v", u)
+		}
+
+		_, err = c.CreateUser(call(t, root), &authpb.CreateUserRequest{
+			Name: "Managed", Email: email, Password: testPassword,
+		})
+		wantError(t, err, codes.AlreadyExists, apierrors.ReasonUserAlreadyExists)
+
+		u, err = c.UpdateUser(call(t, root), &authpb.UpdateUserRequest{UserId: id, Name: "Managed", IsAdmin: proto.Bool(true)})
+		wantOK(t, err)
+		if !u.GetIsAdmin() {
+			t.Fatalf("UpdateUser = %v, want promoted admin", u)
+		}
+
+		// Omitting is_admin leaves the role alone.
+		u, err = c.UpdateUser(call(t, root), &authpb.UpdateUserRequest{UserId: id, Name: "Promoted"})
+		wantOK(t, err)
+		if u.GetName() != "Promoted" || !u.GetIsAdmin() {
+			t.Fatalf("UpdateUser(name only) = %v, want renamed admin", u)
+		}
+
+		u, err = c.GetUser(call(t, root), &authpb.GetUserRequest{UserId: id})
+		wantOK(t, err)
+		if u.GetName() != "Promoted" {
+			t.Fatalf("GetUser = %v, want updated name", u)
+		}
+
+		deleted, err := c.DeleteUser(call(t, root), &authpb.DeleteRequest{UserId: id})
+		wantOK(t, err)
+		if deleted.GetPurgeAt() == nil {
+			t.Fatal("DeleteUser returned no purge_at")
+		}
+
+		u, err = c.GetUser(call(t, root), &authpb.GetUserRequest{UserId: id})
+		wantOK(t, err)
+		if u.GetStatus() != authpb.UserStatus_USER_STATUS_DELETED || u.GetPurgeAt() == nil {
+			t.Fatalf("GetUser after delete = %v, want DELETED with purge_at", u)
+		}
+
+		_, err = c.DeleteUser(call(t, root), &authpb.DeleteRequest{UserId: id})
+		wantError(t, err, codes.FailedPrecondition, apierrors.ReasonAccountDeleted)
+
+		u, err = c.RestoreUser(call(t, root), &authpb.RestoreUserRequest{UserId: id})
+		wantOK(t, err)
+		if u.GetStatus() != authpb.UserStatus_USER_STATUS_ACTIVE || u.GetDeletedAt() != nil {
+			t.Fatalf("RestoreUser = %v, want ACTIVE", u)
+		}
+
+		_, err = c.RestoreUser(call(t, root), &authpb.RestoreUserRequest{UserId: id})
+		wantError(t, err, codes.FailedPrecondition, apierrors.ReasonAccountNotDeleted)
+
+		_, err = c.DeleteUser(call(t, root), &authpb.DeleteRequest{UserId: missingID})
+		wantError(t, err, codes.NotFound, apierrors.ReasonUserNotFound)
+	})
+
+	t.Run("RestoreAccount", func(t *testing.T) {
+		c := newClient()
+		email, id := register(t, c)
+		tokens := login(t, c, email, testPassword)
+		user := Principal{AccessToken: tokens.GetAccessToken()}
+
+		_, err := c.DeleteAccount(call(t, user), &authpb.DeleteAccountRequest{Password: testPassword})
+		wantError(t, err, codes.Unauthenticated, apierrors.ReasonReauthRequired)
+
+		_, err = c.DeleteAccount(call(t, stepUp(t, c, user, testPassword)), &authpb.DeleteAccountRequest{Password: testPassword})
+		wantOK(t, err)
+
+		_, err = c.Login(call(t, Principal{}), &authpb.LoginRequest{Email: email, Password: testPassword})
+		wantError(t, err, codes.FailedPrecondition, apierrors.ReasonAccountDeleted)
+
+		_, err = c.RestoreAccount(call(t, Principal{}), &authpb.RestoreAccountRequest{Email: email, Password: "wrong-password"})
+		wantError(t, err, codes.Unauthenticated, apierrors.ReasonInvalidCredentials)
+
+		u, err := c.RestoreAccount(call(t, Principal{}), &authpb.RestoreAccountRequest{Email: email, Password: testPassword})
+		wantOK(t, err)
+		if u.GetUserId().GetValue() != id || u.GetStatus() == authpb.UserStatus_USER_STATUS_DELETED {
+			t.Fatalf("RestoreAccount = %v, want %s no longer DELETED", u, id)
+		}
+		login(t, c, email, testPassword)
+	})
+
+	t.Run("ListUsers", func(t *testing.T) {
+		c := newClient()
+		register(t, c)
+		register(t, c)
+		root := adminPrincipal(t, c)
+
+		resp, err := c.ListUsers(call(t, root), &authpb.ListUsersRequest{Page: 1, Limit: 1})
+		wantOK(t, err)
+		if len(resp.GetUsers()) != 1 || resp.GetTotal() < 3 {
+			t.Fatalf("ListUsers(limit 1) returned %d users of %d, want 1 of at least 3",
+				len(resp.GetUsers()), resp.GetTotal())
+		}
+
+		email, id := register(t, c)
+		resp, err = c.ListUsers(call(t, root), &authpb.ListUsersRequest{
+			EmailPrefix: strings.ToUpper(email), Role: authpb.UserRole_USER_ROLE_USER,
+		})
+		wantOK(t, err)
+		if len(resp.GetUsers()) != 1 || resp.GetUsers()[0].GetUserId().GetValue() != id {
+			t.Fatalf("ListUsers(email_prefix %q) = %v, want only %s", email, resp.GetUsers(), id)
+		}
+
+		resp, err = c.ListUsers(call(t, root), &authpb.ListUsersRequest{
+			EmailPrefix: email, Role: authpb.UserRole_USER_ROLE_ADMIN,
+		})
+		wantOK(t, err)
+		if len(resp.GetUsers()) != 0 {
+			t.Fatalf("ListUsers(role admin) = %v, want none", resp.GetUsers())
+		}
+	})
+
+	t.Run("SuspendUser", func(t *testing.T) {
+		c := newClient()
+		email, id := register(t, c)
+		tokens := login(t, c, email, testPassword)
+		root := adminPrincipal(t, c)
+
+		u, err := c.SuspendUser(call(t, root), &authpb.SuspendUserRequest{UserId: id, Reason: "conformance"})
+		wantOK(t, err)
+		if u.GetStatus() != authpb.UserStatus_USER_STATUS_SUSPENDED {
+			t.Fatalf("SuspendUser status = %v, want SUSPENDED", u.GetStatus())
+		}
+
+		_, err = c.Login(call(t, Principal{}), &authpb.LoginRequest{Email: email, Password: testPassword})
+		wantError(t, err, codes.PermissionDenied, apierrors.ReasonAccountSuspended)
+		_, err = c.RefreshToken(call(t, Principal{}), &authpb.RefreshRequest{RefreshToken: tokens.GetRefreshToken()})
+		if err == nil {
+			t.Fatal("RefreshToken succeeded for a suspended user")
+		}
+
+		resp, err := c.ListUsers(call(t, root), &authpb.ListUsersRequest{
+			EmailPrefix: email, Status: authpb.UserStatus_USER_STATUS_SUSPENDED,
+		})
+		wantOK(t, err)
+		if len(resp.GetUsers()) != 1 {
+			t.Fatalf("ListUsers(status SUSPENDED) returned %d users, want 1", len(resp.GetUsers()))
+		}
+
+		_, err = c.ReactivateUser(call(t, root), &authpb.ReactivateUserRequest{UserId: id})
+		wantOK(t, err)
+		login(t, c, email, testPassword)
+
+		_, err = c.SuspendUser(call(t, root), &authpb.SuspendUserRequest{UserId: missingID})
+		wantError(t, err, codes.NotFound, apierrors.ReasonUserNotFound)
+	})
+
+	t.Run("ForceLogout", func(t *testing.T) {
+		c := newClient()
+		email, id := register(t, c)
+		first := login(t, c, email, testPassword)
+		second := login(t, c, email, testPassword)
+		root := adminPrincipal(t, c)
+
+		resp, err := c.ForceLogout(call(t, root), &authpb.ForceLogoutRequest{UserId: id})
+		wantOK(t, err)
+		if resp.GetSessionsRevoked() < 2 {
+			t.Fatalf("ForceLogout revoked %d sessions, want at least 2", resp.GetSessionsRevoked())
+		}
+		for _, tokens := range []*authpb.JWTPair{first, second} {
+			_, err = c.RefreshToken(call(t, Principal{}), &authpb.RefreshRequest{RefreshToken: tokens.GetRefreshToken()})
+			wantError(t, err, codes.Unauthenticated, "")
+		}
+		login(t, c, email, testPassword)
+
+		_, err = c.ForceLogout(call(t, Principal{AccessToken: first.GetAccessToken()}), &authpb.ForceLogoutRequest{UserId: id})
+		wantError(t, err, codes.Unauthenticated, "")
+	})
+}
@@ -0,0 +1,214 @@
+package conformance
+
+import (
+	"strconv"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	bookpb "github.com/JunBSer/services_proto/booking/gen/go"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+)
+
+// RunBookingServiceConformance runs the BookingService contract against the
+// clients returned by newClient. Rooms to book are created through
+// newHotelClient, which must reach the hotel service the booking service
+// checks rooms against.
+func RunBookingServiceConformance(t *testing.T, newClient func() bookpb.BookingServiceClient,
+	newHotelClient func() hotelpb.HotelServiceClient, p Principals) {
+	t.Helper()
+
+	book := func(t *testing.T, c bookpb.BookingServiceClient, h *hotelpb.Hotel, r *hotelpb.Room, offset, nights int) (*bookpb.BookingResponse, error) {
+		t.Helper()
+
+		start, end := stay(offset, nights)
+		return c.CreateBooking(call(t, p.User), &bookpb.CreateBookingRequest{
+			UserId: p.User.UserID, HotelId: h.GetId(), RoomId: r.GetId(), StartDate: start, EndDate: end,
+		})
+	}
+
+	t.Run("CreateBooking", func(t *testing.T) {
+		c := newClient()
+		h, r := newHotel(t, newHotelClient(), p.Admin)
+
+		b, err := book(t, c, h, r, 10, 2)
+		wantOK(t, err)
+		if b.GetBookingId() == "" || b.GetStatus() != bookpb.Status_CONFIRMED {
+			t.Fatalf("CreateBooking = %v, want a confirmed booking", b)
+		}
+
+		got, err := c.GetBooking(call(t, p.User), &bookpb.GetBookingRequest{
+			UserId: p.User.UserID, BookingId: b.GetBookingId(),
+		})
+		wantOK(t, err)
+		start, end := stay(10, 2)
+		if got.GetHotelId() != h.GetId() || got.GetRoomId() != r.GetId() || got.GetUserId() != p.User.UserID ||
+			!got.GetStartDate().AsTime().Equal(start.AsTime()) || !got.GetEndDate().AsTime().Equal(end.AsTime()) {
+			t.Fatalf("GetBooking = %v, want the booking just created", got)
+		}
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		c := newClient()
+		h, r := newHotel(t, newHotelClient(), p.Admin)
+		start, end := stay(10, 2)
+
+		_, err := c.CreateBooking(call(t, p.User), &bookpb.CreateBookingRequest{
+			UserId: p.User.UserID, HotelId: h.GetId(), RoomId: r.GetId(), StartDate: end, EndDate: start,
+		})
+		wantError(t, err, codes.InvalidArgument, apierrors.ReasonInvalidDateRange)
+
+		_, err = c.CreateBooking(call(t, p.User), &bookpb.CreateBookingRequest{
+			UserId: p.User.UserID, HotelId: h.GetId(), RoomId: missingID, StartDate: start, EndDate: end,
+		})
+		wantError(t, err, codes.NotFound, "")
+
+		_, err = c.GetBooking(call(t, p.User), &bookpb.GetBookingRequest{UserId: p.User.UserID, BookingId: missingID})
+		wantError(t, err, codes.NotFound, apierrors.ReasonBookingNotFound)
+	})
+
+	t.Run("RatePlan", func(t *testing.T) {
+		c := newClient()
+		hc := newHotelClient()
+		h, plain := newHotel(t, hc, p.Admin)
+		_, r, plan := newRatePlan(t, hc, p.Admin, h)
+		start, end := stay(10, 3)
+
+		b, err := c.CreateBooking(call(t, p.User), &bookpb.CreateBookingRequest{
+			UserId: p.User.UserID, HotelId: h.GetId(), RoomId: r.GetId(), StartDate: start, EndDate: end,
+			RatePlanId: plan.GetId(),
+		})
+		wantOK(t, err)
+		got, err := c.GetBooking(call(t, p.User), &bookpb.GetBookingRequest{UserId: p.User.UserID, BookingId: b.GetBookingId()})
+		wantOK(t, err)
+		if got.GetRatePlanId() != plan.GetId() || got.GetTotalPrice() != 315 {
+			t.Fatalf("GetBooking = %v, want plan %s for 315", got, plan.GetId())
+		}
+		q, err := hc.QuotePrice(call(t, p.User), &hotelpb.QuotePriceRequest{
+			HotelId: h.GetId(), RoomId: r.GetId(), StartDate: start, EndDate: end, RatePlanId: plan.GetId(),
+		})
+		wantOK(t, err)
+		if q.GetTotalPrice() != got.GetTotalPrice() {
+			t.Fatalf("QuotePrice = %v, want the booked %v", q, got.GetTotalPrice())
+		}
+
+		// The plan is only sold for its room type.
+		_, err = c.CreateBooking(call(t, p.User), &bookpb.CreateBookingRequest{
+			UserId: p.User.UserID, HotelId: h.GetId(), RoomId: plain.GetId(), StartDate: start, EndDate: end,
+			RatePlanId: plan.GetId(),
+		})
+		wantError(t, err, codes.FailedPrecondition, apierrors.ReasonRatePlanUnavailable)
+
+		_, err = c.CreateBooking(call(t, p.User), &bookpb.CreateBookingRequest{
+			UserId: p.User.UserID, HotelId: h.GetId(), RoomId: plain.GetId(), StartDate: start, EndDate: end,
+			RatePlanId: missingID,
+		})
+		wantError(t, err, codes.NotFound, apierrors.ReasonRatePlanNotFound)
+	})
+
+	t.Run("Overlap", func(t *testing.T) {
+		c := newClient()
+		h, r := newHotel(t, newHotelClient(), p.Admin)
+
+		_, err := book(t, c, h, r, 10, 3)
+		wantOK(t, err)
+
+		_, err = book(t, c, h, r, 11, 1)
+		wantError(t, err, codes.AlreadyExists, apierrors.ReasonBookingConflict)
+
+		// A stay starting on the previous check-out day does not overlap.
+		_, err = book(t, c, h, r, 13, 2)
+		wantOK(t, err)
+	})
+
+	t.Run("CancelBooking", func(t *testing.T) {
+		c := newClient()
+		h, r := newHotel(t, newHotelClient(), p.Admin)
+
+		b, err := book(t, c, h, r, 10, 2)
+		wantOK(t, err)
+
+		resp, err := c.CancelBooking(call(t, p.User), &bookpb.CancelBookingRequest{
+			UserId: p.User.UserID, BookingId: b.GetBookingId(),
+		})
+		wantOK(t, err)
+		if !resp.GetSuccess() || resp.GetCancelledAt() == nil {
+			t.Fatalf("CancelBooking = %v", resp)
+		}
+
+		got, err := c.GetBooking(call(t, p.User), &bookpb.GetBookingRequest{
+			UserId: p.User.UserID, BookingId: b.GetBookingId(),
+		})
+		wantOK(t, err)
+		if got.GetStatus() != bookpb.Status_CANCELLED {
+			t.Fatalf("GetBooking status = %v, want CANCELLED", got.GetStatus())
+		}
+
+		_, err = c.CancelBooking(call(t, p.User), &bookpb.CancelBookingRequest{
+			UserId: p.User.UserID, BookingId: b.GetBookingId(),
+		})
+		wantError(t, err, codes.FailedPrecondition, apierrors.ReasonBookingAlreadyCancelled)
+
+		// Cancelled bookings release the room.
+		_, err = book(t, c, h, r, 10, 2)
+		wantOK(t, err)
+	})
+
+	t.Run("Ownership", func(t *testing.T) {
+		c := newClient()
+		h, r := newHotel(t, newHotelClient(), p.Admin)
+
+		b, err := book(t, c, h, r, 10, 2)
+		wantOK(t, err)
+
+		hidden := func(err error) {
+			t.Helper()
+
+			if code := status.Code(err); code != codes.NotFound && code != codes.PermissionDenied {
+				t.Fatalf("got %v, want NotFound or PermissionDenied for another user's booking", err)
+			}
+		}
+		_, err = c.GetBooking(call(t, p.Other), &bookpb.GetBookingRequest{
+			UserId: p.Other.UserID, BookingId: b.GetBookingId(),
+		})
+		hidden(err)
+		_, err = c.CancelBooking(call(t, p.Other), &bookpb.CancelBookingRequest{
+			UserId: p.Other.UserID, BookingId: b.GetBookingId(),
+		})
+		hidden(err)
+
+		_, err = c.GetBooking(call(t, Principal{}), &bookpb.GetBookingRequest{
+			UserId: p.User.UserID, BookingId: b.GetBookingId(),
+		})
+		wantError(t, err, codes.Unauthenticated, "")
+	})
+
+	t.Run("ListBookings", func(t *testing.T) {
+		c := newClient()
+		h, r := newHotel(t, newHotelClient(), p.Admin)
+
+		b, err := book(t, c, h, r, 10, 2)
+		wantOK(t, err)
+
+		_, err = c.ListBookings(call(t, p.User), &bookpb.ListBookingsRequest{PageSize: 10})
+		wantError(t, err, codes.PermissionDenied, "")
+
+		const pageSize = 100
+		for page := 1; ; page++ {
+			resp, err := c.ListBookings(call(t, p.Admin), &bookpb.ListBookingsRequest{
+				PageSize: pageSize, Page: strconv.Itoa(page),
+			})
+			wantOK(t, err)
+			for _, got := range resp.GetBookings() {
+				if got.GetBookingId() == b.GetBookingId() {
+					return
+				}
+			}
+			if len(resp.GetBookings()) < pageSize {
+				t.Fatalf("ListBookings never returned booking %s", b.GetBookingId())
+			}
+		}
+	})
+}
@@ -0,0 +1,134 @@
+// Package conformance is an executable specification of the Auth,
+// HotelService and BookingService contracts. Server implementations run the
+// suites from their own tests against a live server:
+//
+//	func TestHotelConformance(t *testing.T) {
+//		env := fakes.MustStart(t, fakes.Options{})
+//		admin, err := conformance.Login(ctx, env.AuthClient, "admin@example.com", "admin-password")
+//		...
+//		conformance.RunHotelServiceConformance(t, func() hotelpb.HotelServiceClient {
+//			return env.HotelClient
+//		}, conformance.Principals{Admin: admin, User: user, Other: other})
+//	}
+//
+// Every suite creates the data it needs under unique names, so it can run
+// against a shared, non-empty deployment.
+package conformance
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/auth/reauth"
+)
+
+// callTimeout bounds every RPC made by the suites.
+const callTimeout = 10 * time.Second
+
+// Credentials identify an existing account by email and password.
+type Credentials struct {
+	Email    string
+	Password string
+}
+
+// Principal is an authenticated caller the suites act as.
+type Principal struct {
+	UserID      string
+	AccessToken string
+	// ReauthToken is a step-up token from Reauthenticate, sent with every
+	// call when set.
+	ReauthToken string
+}
+
+// Principals are the callers the hotel and booking suites need. Admin must
+// have is_admin set; User and Other must be two distinct non-admin users.
+type Principals struct {
+	Admin Principal
+	User  Principal
+	Other Principal
+}
+
+// Login signs in with email and password and resolves the caller's user ID.
+// Accounts with MFA enabled are not supported.
+func Login(ctx context.Context, c authpb.AuthClient, email, password string) (Principal, error) {
+	resp, err := c.Login(ctx, &authpb.LoginRequest{Email: email, Password: password})
+	if err != nil {
+		return Principal{}, err
+	}
+	if resp.GetTokens() == nil {
+		return Principal{}, errors.New("conformance: login requires MFA")
+	}
+	access := resp.GetTokens().GetAccessToken()
+
+	v, err := c.ValidateToken(ctx, &authpb.ValidateTokenRequest{Token: access})
+	if err != nil {
+		return Principal{}, err
+	}
+	if !v.GetIsValid() {
+		return Principal{}, errors.New("conformance: issued access token is not valid")
+	}
+	return Principal{UserID: v.GetUserId().GetValue(), AccessToken: access}, nil
+}
+
+// call returns a context for a single RPC made as p. The zero Principal
+// makes an anonymous call.
+func call(t testing.TB, p Principal) context.Context {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	t.Cleanup(cancel)
+	if p.ReauthToken != "" {
+		ctx = reauth.WithToken(ctx, p.ReauthToken)
+	}
+	if p.AccessToken == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+p.AccessToken)
+}
+
+// wantOK fails the test if err is non-nil.
+func wantOK(t testing.TB, err error) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// wantError fails the test unless err carries code and, when reason is
+// non-empty, the apierrors reason.
+func wantError(t testing.TB, err error, code codes.Code, reason apierrors.Reason) {
+	t.Helper()
+
+	if err == nil {
+		t.Fatalf("got no error, want %v", code)
+	}
+	if got := status.Code(err); got != code {
+		t.Fatalf("got code %v (%v), want %v", got, err, code)
+	}
+	if reason != "" {
+		if got := apierrors.ReasonOf(err); got != reason {
+			t.Fatalf("got reason %q (%v), want %q", got, err, reason)
+		}
+	}
+}
+
+// unique returns prefix followed by a random suffix.
+func unique(prefix string) string {
+	b := make([]byte, 6)
+	rand.Read(b)
+	return prefix + hex.EncodeToString(b)
+}
+
+// missingID is a well-formed UUID that no server is expected to know.
+const missingID = "00000000-0000-4000-8000-000000000000"
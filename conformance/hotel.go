@@ -0,0 +1,592 @@
+package conformance
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"image"
+	"image/png"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+)
+
+// newHotel creates a hotel with one room as admin.
+func newHotel(t *testing.T, c hotelpb.HotelServiceClient, admin Principal, amenities ...string) (*hotelpb.Hotel, *hotelpb.Room) {
+	t.Helper()
+
+	h, err := c.CreateHotel(call(t, admin), &hotelpb.CreateHotelRequest{
+		Name: unique("Hotel "), Address: unique("Street "), Amenities: amenities,
+	})
+	wantOK(t, err)
+	r, err := c.AddRoom(call(t, admin), &hotelpb.AddRoomRequest{
+		HotelId: h.GetId(), Type: "double", PricePerNight: 100,
+	})
+	wantOK(t, err)
+	return h, r
+}
+
+// newRatePlan adds a room type to h, a room of that type priced at 100 and
+// an active plan for it with breakfast, 10% off and a 2 night minimum, which
+// prices a night at 105.
+func newRatePlan(t *testing.T, c hotelpb.HotelServiceClient, admin Principal, h *hotelpb.Hotel) (*hotelpb.RoomType, *hotelpb.Room, *hotelpb.RatePlan) {
+	t.Helper()
+
+	rt, err := c.CreateRoomType(call(t, admin), &hotelpb.CreateRoomTypeRequest{
+		HotelId: h.GetId(),
+		RoomType: &hotelpb.RoomType{
+			Name: "Deluxe King", MaxOccupancy: 2, SizeSqm: 32,
+			Beds: []*hotelpb.BedConfiguration{{Type: "king", Count: 1}},
+		},
+	})
+	wantOK(t, err)
+	r, err := c.AddRoom(call(t, admin), &hotelpb.AddRoomRequest{
+		HotelId: h.GetId(), RoomTypeId: rt.GetId(), PricePerNight: 100,
+	})
+	wantOK(t, err)
+	plan, err := c.CreateRatePlan(call(t, admin), &hotelpb.CreateRatePlanRequest{
+		HotelId: h.GetId(),
+		RatePlan: &hotelpb.RatePlan{
+			Name: "Breakfast saver", RoomTypeIds: []string{rt.GetId()}, MealPlan: hotelpb.MealPlan_MEAL_PLAN_BREAKFAST,
+			CancellationPolicy: &hotelpb.CancellationPolicy{Refundable: true, FreeCancellationHours: 48, PenaltyPercent: 50},
+			PriceMultiplier:    0.9, NightlySupplement: 15, MinNights: 2, Active: true,
+		},
+	})
+	wantOK(t, err)
+	return rt, r, plan
+}
+
+// stay returns a date range of the given nights starting offset days ahead.
+func stay(offset, nights int) (*timestamppb.Timestamp, *timestamppb.Timestamp) {
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, offset)
+	return timestamppb.New(start), timestamppb.New(start.AddDate(0, 0, nights))
+}
+
+// testPNG returns a blank PNG image of the given size.
+func testPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	var b bytes.Buffer
+	if err := png.Encode(&b, image.NewGray(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+// uploadPhoto declares img, streams it in chunks of chunkSize bytes and
+// returns the upload ID. roomID is empty for a hotel photo.
+func uploadPhoto(t *testing.T, c hotelpb.HotelServiceClient, admin Principal, hotelID, roomID string, img []byte, chunkSize int) (string, error) {
+	t.Helper()
+
+	sum := sha256.Sum256(img)
+	session, err := c.CreateUploadSession(call(t, admin), &hotelpb.CreateUploadSessionRequest{
+		HotelId: hotelID, RoomId: roomID, ContentType: "image/png",
+		SizeBytes: int64(len(img)), Sha256: hex.EncodeToString(sum[:]),
+	})
+	wantOK(t, err)
+
+	stream, err := c.UploadPhoto(call(t, admin))
+	wantOK(t, err)
+	for off := 0; off < len(img); off += chunkSize {
+		req := &hotelpb.UploadPhotoRequest{Offset: int64(off), Data: img[off:min(off+chunkSize, len(img))]}
+		if off == 0 {
+			req.UploadId = session.GetUploadId()
+		}
+		if err := stream.Send(req); err != nil {
+			break // the server failed the stream; CloseAndRecv reports why
+		}
+	}
+	_, err = stream.CloseAndRecv()
+	return session.GetUploadId(), err
+}
+
+// RunHotelServiceConformance runs the HotelService contract against the
+// clients returned by newClient.
+func RunHotelServiceConformance(t *testing.T, newClient func() hotelpb.HotelServiceClient, p Principals) {
+	t.Helper()
+
+	t.Run("CreateHotel", func(t *testing.T) {
+		c := newClient()
+		name := unique("Hotel ")
+
+		h, err := c.CreateHotel(call(t, p.Admin), &hotelpb.CreateHotelRequest{
+			Name: name, Address: "1 Main Street", Amenities: []string{"wifi"},
+		})
+		wantOK(t, err)
+		if h.GetId() == "" || h.GetName() != name || !slices.Equal(h.GetAmenities(), []string{"wifi"}) {
+			t.Fatalf("CreateHotel = %v", h)
+		}
+
+		got, err := c.GetHotel(call(t, p.User), &hotelpb.GetHotelRequest{Id: h.GetId()})
+		wantOK(t, err)
+		if got.GetName() != name {
+			t.Fatalf("GetHotel = %v, want name %q", got, name)
+		}
+
+		_, err = c.CreateHotel(call(t, p.Admin), &hotelpb.CreateHotelRequest{Address: "No name"})
+		wantError(t, err, codes.InvalidArgument, "")
+	})
+
+	t.Run("AuthLevels", func(t *testing.T) {
+		c := newClient()
+		h, _ := newHotel(t, c, p.Admin)
+
+		_, err := c.CreateHotel(call(t, Principal{}), &hotelpb.CreateHotelRequest{Name: unique("Hotel ")})
+		wantError(t, err, codes.Unauthenticated, "")
+
+		_, err = c.CreateHotel(call(t, p.User), &hotelpb.CreateHotelRequest{Name: unique("Hotel ")})
+		wantError(t, err, codes.PermissionDenied, "")
+
+		_, err = c.AddRoom(call(t, p.User), &hotelpb.AddRoomRequest{HotelId: h.GetId(), Type: "single", PricePerNight: 50})
+		wantError(t, err, codes.PermissionDenied, "")
+
+		_, err = c.DeleteHotel(call(t, p.User), &hotelpb.DeleteHotelRequest{Id: h.GetId()})
+		wantError(t, err, codes.PermissionDenied, "")
+
+		_, err = c.GetHotel(call(t, Principal{}), &hotelpb.GetHotelRequest{Id: h.GetId()})
+		wantError(t, err, codes.Unauthenticated, "")
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		c := newClient()
+		h, _ := newHotel(t, c, p.Admin)
+
+		_, err := c.GetHotel(call(t, p.User), &hotelpb.GetHotelRequest{Id: missingID})
+		wantError(t, err, codes.NotFound, apierrors.ReasonHotelNotFound)
+
+		_, err = c.UpdateHotel(call(t, p.Admin), &hotelpb.UpdateHotelRequest{Id: missingID, Name: "Ghost"})
+		wantError(t, err, codes.NotFound, apierrors.ReasonHotelNotFound)
+
+		_, err = c.AddRoom(call(t, p.Admin), &hotelpb.AddRoomRequest{HotelId: missingID, Type: "single", PricePerNight: 50})
+		wantError(t, err, codes.NotFound, apierrors.ReasonHotelNotFound)
+
+		_, err = c.GetRoom(call(t, p.User), &hotelpb.GetRoomRequest{HotelId: h.GetId(), Id: missingID})
+		wantError(t, err, codes.NotFound, apierrors.ReasonRoomNotFound)
+	})
+
+	t.Run("UpdateHotel", func(t *testing.T) {
+		c := newClient()
+		h, _ := newHotel(t, c, p.Admin, "wifi")
+
+		got, err := c.UpdateHotel(call(t, p.Admin), &hotelpb.UpdateHotelRequest{
+			Id: h.GetId(), Name: "Renamed", Amenities: []string{"pool"},
+		})
+		wantOK(t, err)
+		if got.GetName() != "Renamed" || got.GetAddress() != h.GetAddress() ||
+			!slices.Equal(got.GetAmenities(), []string{"pool"}) {
+			t.Fatalf("UpdateHotel = %v", got)
+		}
+	})
+
+	t.Run("Rooms", func(t *testing.T) {
+		c := newClient()
+		h, r := newHotel(t, c, p.Admin)
+		if r.GetId() == "" || r.GetType() != "double" || r.GetPricePerNight() != 100 {
+			t.Fatalf("AddRoom = %v", r)
+		}
+
+		_, err := c.AddRoom(call(t, p.Admin), &hotelpb.AddRoomRequest{HotelId: h.GetId(), Type: "free", PricePerNight: -1})
+		wantError(t, err, codes.InvalidArgument, "")
+
+		got, err := c.UpdateRoom(call(t, p.Admin), &hotelpb.UpdateRoomRequest{
+			HotelId: h.GetId(), Id: r.GetId(), Type: "suite", PricePerNight: 250,
+		})
+		wantOK(t, err)
+		if got.GetType() != "suite" || got.GetPricePerNight() != 250 {
+			t.Fatalf("UpdateRoom = %v", got)
+		}
+
+		rooms, err := c.ListRooms(call(t, p.User), &hotelpb.ListRoomsRequest{HotelId: h.GetId()})
+		wantOK(t, err)
+		if len(rooms.GetRooms()) != 1 || rooms.GetRooms()[0].GetType() != "suite" {
+			t.Fatalf("ListRooms = %v, want the updated room", rooms)
+		}
+
+		_, err = c.DeleteRoom(call(t, p.Admin), &hotelpb.DeleteRoomRequest{HotelId: h.GetId(), Id: r.GetId()})
+		wantOK(t, err)
+
+		_, err = c.GetRoom(call(t, p.User), &hotelpb.GetRoomRequest{HotelId: h.GetId(), Id: r.GetId()})
+		wantError(t, err, codes.NotFound, apierrors.ReasonRoomNotFound)
+	})
+
+	t.Run("DeleteHotel", func(t *testing.T) {
+		c := newClient()
+		h, r := newHotel(t, c, p.Admin)
+
+		resp, err := c.DeleteHotel(call(t, p.Admin), &hotelpb.DeleteHotelRequest{Id: h.GetId()})
+		wantOK(t, err)
+		if !resp.GetSuccess() {
+			t.Fatal("DeleteHotel reported no success")
+		}
+
+		_, err = c.GetHotel(call(t, p.User), &hotelpb.GetHotelRequest{Id: h.GetId()})
+		wantError(t, err, codes.NotFound, apierrors.ReasonHotelNotFound)
+
+		// Deleting a hotel removes its rooms.
+		_, err = c.GetRoom(call(t, p.User), &hotelpb.GetRoomRequest{HotelId: h.GetId(), Id: r.GetId()})
+		wantError(t, err, codes.NotFound, "")
+
+		list, err := c.ListHotels(call(t, p.User), &emptypb.Empty{})
+		wantOK(t, err)
+		for _, other := range list.GetHotels() {
+			if other.GetId() == h.GetId() {
+				t.Fatal("ListHotels still returns the deleted hotel")
+			}
+		}
+
+		_, err = c.DeleteHotel(call(t, p.Admin), &hotelpb.DeleteHotelRequest{Id: h.GetId()})
+		wantError(t, err, codes.NotFound, apierrors.ReasonHotelNotFound)
+	})
+
+	t.Run("SearchHotels", func(t *testing.T) {
+		c := newClient()
+		amenity := unique("amenity-")
+		h, _ := newHotel(t, c, p.Admin, amenity, "wifi")
+		newHotel(t, c, p.Admin, "wifi")
+
+		found, err := c.SearchHotels(call(t, p.User), &hotelpb.SearchRequest{RequiredAmenities: []string{amenity, "wifi"}})
+		wantOK(t, err)
+		if len(found.GetHotels()) != 1 || found.GetHotels()[0].GetId() != h.GetId() {
+			t.Fatalf("SearchHotels = %v, want only %s", found, h.GetId())
+		}
+
+		found, err = c.SearchHotels(call(t, p.User), &hotelpb.SearchRequest{Location: h.GetAddress()})
+		wantOK(t, err)
+		if len(found.GetHotels()) != 1 || found.GetHotels()[0].GetId() != h.GetId() {
+			t.Fatalf("SearchHotels(location) = %v, want only %s", found, h.GetId())
+		}
+	})
+
+	t.Run("Photos", func(t *testing.T) {
+		c := newClient()
+		h, r := newHotel(t, c, p.Admin)
+		img := testPNG(t, 40, 30)
+
+		first, err := uploadPhoto(t, c, p.Admin, h.GetId(), "", img, len(img)/3+1)
+		wantOK(t, err)
+		photo, err := c.AttachPhoto(call(t, p.Admin), &hotelpb.AttachPhotoRequest{
+			HotelId: h.GetId(), UploadId: first, Caption: "Lobby",
+		})
+		wantOK(t, err)
+		if photo.GetUrl() == "" || photo.GetWidth() != 40 || photo.GetHeight() != 30 || photo.GetPosition() != 0 {
+			t.Fatalf("AttachPhoto = %v, want a 40x30 photo at position 0", photo)
+		}
+
+		second, err := uploadPhoto(t, c, p.Admin, h.GetId(), "", testPNG(t, 8, 8), 1<<20)
+		wantOK(t, err)
+		_, err = c.AttachPhoto(call(t, p.Admin), &hotelpb.AttachPhotoRequest{HotelId: h.GetId(), UploadId: second})
+		wantOK(t, err)
+
+		list, err := c.ReorderPhotos(call(t, p.Admin), &hotelpb.ReorderPhotosRequest{
+			HotelId: h.GetId(), PhotoIds: []string{second, first},
+		})
+		wantOK(t, err)
+		if len(list.GetPhotos()) != 2 || list.GetPhotos()[0].GetId() != second || list.GetPhotos()[1].GetPosition() != 1 {
+			t.Fatalf("ReorderPhotos = %v, want %s first", list, second)
+		}
+		_, err = c.ReorderPhotos(call(t, p.Admin), &hotelpb.ReorderPhotosRequest{
+			HotelId: h.GetId(), PhotoIds: []string{first},
+		})
+		wantError(t, err, codes.InvalidArgument, "")
+
+		_, err = c.DeletePhoto(call(t, p.Admin), &hotelpb.DeletePhotoRequest{HotelId: h.GetId(), PhotoId: second})
+		wantOK(t, err)
+		got, err := c.GetHotel(call(t, p.User), &hotelpb.GetHotelRequest{Id: h.GetId()})
+		wantOK(t, err)
+		if len(got.GetPhotos()) != 1 || got.GetPhotos()[0].GetId() != first || got.GetPhotos()[0].GetPosition() != 0 {
+			t.Fatalf("GetHotel photos = %v, want only %s", got.GetPhotos(), first)
+		}
+		_, err = c.DeletePhoto(call(t, p.Admin), &hotelpb.DeletePhotoRequest{HotelId: h.GetId(), PhotoId: second})
+		wantError(t, err, codes.NotFound, apierrors.ReasonPhotoNotFound)
+
+		roomUpload, err := uploadPhoto(t, c, p.Admin, h.GetId(), r.GetId(), img, 100)
+		wantOK(t, err)
+		_, err = c.AttachPhoto(call(t, p.Admin), &hotelpb.AttachPhotoRequest{HotelId: h.GetId(), UploadId: roomUpload})
+		wantError(t, err, codes.NotFound, apierrors.ReasonUploadNotFound)
+		_, err = c.AttachPhoto(call(t, p.Admin), &hotelpb.AttachPhotoRequest{
+			HotelId: h.GetId(), RoomId: r.GetId(), UploadId: roomUpload,
+		})
+		wantOK(t, err)
+		room, err := c.GetRoom(call(t, p.User), &hotelpb.GetRoomRequest{HotelId: h.GetId(), Id: r.GetId()})
+		wantOK(t, err)
+		if len(room.GetPhotos()) != 1 {
+			t.Fatalf("GetRoom photos = %v, want one", room.GetPhotos())
+		}
+	})
+
+	t.Run("UploadPhotoValidation", func(t *testing.T) {
+		c := newClient()
+		h, _ := newHotel(t, c, p.Admin)
+		img := testPNG(t, 4, 4)
+
+		_, err := c.CreateUploadSession(call(t, p.Admin), &hotelpb.CreateUploadSessionRequest{
+			HotelId: h.GetId(), ContentType: "text/plain", SizeBytes: 10, Sha256: "abc",
+		})
+		wantError(t, err, codes.InvalidArgument, apierrors.ReasonValidationFailed)
+
+		sum := sha256.Sum256([]byte("something else"))
+		session, err := c.CreateUploadSession(call(t, p.Admin), &hotelpb.CreateUploadSessionRequest{
+			HotelId: h.GetId(), ContentType: "image/png", SizeBytes: int64(len(img)), Sha256: hex.EncodeToString(sum[:]),
+		})
+		wantOK(t, err)
+		stream, err := c.UploadPhoto(call(t, p.Admin))
+		wantOK(t, err)
+		_ = stream.Send(&hotelpb.UploadPhotoRequest{UploadId: session.GetUploadId(), Data: img})
+		_, err = stream.CloseAndRecv()
+		wantError(t, err, codes.InvalidArgument, apierrors.ReasonChecksumMismatch)
+
+		_, err = c.AttachPhoto(call(t, p.Admin), &hotelpb.AttachPhotoRequest{HotelId: h.GetId(), UploadId: session.GetUploadId()})
+		wantError(t, err, codes.FailedPrecondition, "")
+
+		_, err = c.CreateUploadSession(call(t, p.User), &hotelpb.CreateUploadSessionRequest{
+			HotelId: h.GetId(), ContentType: "image/png", SizeBytes: 1, Sha256: hex.EncodeToString(sum[:]),
+		})
+		wantError(t, err, codes.PermissionDenied, "")
+	})
+
+	t.Run("RoomTypes", func(t *testing.T) {
+		c := newClient()
+		h, _ := newHotel(t, c, p.Admin)
+		rt, r, _ := newRatePlan(t, c, p.Admin, h)
+		if rt.GetId() == "" || rt.GetHotelId() != h.GetId() || len(rt.GetBeds()) != 1 {
+			t.Fatalf("CreateRoomType = %v", rt)
+		}
+		if r.GetRoomTypeId() != rt.GetId() || r.GetType() != "Deluxe King" {
+			t.Fatalf("AddRoom = %v, want type %s named after it", r, rt.GetId())
+		}
+
+		_, err := c.CreateRoomType(call(t, p.Admin), &hotelpb.CreateRoomTypeRequest{
+			HotelId: h.GetId(), RoomType: &hotelpb.RoomType{Name: "Empty"},
+		})
+		wantError(t, err, codes.InvalidArgument, "")
+
+		_, err = c.CreateRoomType(call(t, p.User), &hotelpb.CreateRoomTypeRequest{
+			HotelId: h.GetId(), RoomType: &hotelpb.RoomType{Name: "Suite", MaxOccupancy: 4},
+		})
+		wantError(t, err, codes.PermissionDenied, "")
+
+		got, err := c.UpdateRoomType(call(t, p.Admin), &hotelpb.UpdateRoomTypeRequest{
+			HotelId: h.GetId(), Id: rt.GetId(), RoomType: &hotelpb.RoomType{Name: "Superior King"},
+		})
+		wantOK(t, err)
+		if got.GetName() != "Superior King" || got.GetMaxOccupancy() != 2 {
+			t.Fatalf("UpdateRoomType = %v", got)
+		}
+		room, err := c.GetRoom(call(t, p.User), &hotelpb.GetRoomRequest{HotelId: h.GetId(), Id: r.GetId()})
+		wantOK(t, err)
+		if room.GetType() != "Superior King" {
+			t.Fatalf("GetRoom = %v, want the renamed type", room)
+		}
+
+		list, err := c.ListRoomTypes(call(t, p.User), &hotelpb.ListRoomTypesRequest{HotelId: h.GetId()})
+		wantOK(t, err)
+		if len(list.GetRoomTypes()) != 1 || list.GetRoomTypes()[0].GetId() != rt.GetId() {
+			t.Fatalf("ListRoomTypes = %v", list)
+		}
+
+		_, err = c.DeleteRoomType(call(t, p.Admin), &hotelpb.DeleteRoomTypeRequest{HotelId: h.GetId(), Id: rt.GetId()})
+		wantError(t, err, codes.FailedPrecondition, apierrors.ReasonRoomTypeInUse)
+
+		_, err = c.AddRoom(call(t, p.Admin), &hotelpb.AddRoomRequest{HotelId: h.GetId(), RoomTypeId: missingID, PricePerNight: 50})
+		wantError(t, err, codes.NotFound, apierrors.ReasonRoomTypeNotFound)
+
+		_, err = c.GetRoomType(call(t, p.User), &hotelpb.GetRoomTypeRequest{HotelId: h.GetId(), Id: missingID})
+		wantError(t, err, codes.NotFound, apierrors.ReasonRoomTypeNotFound)
+	})
+
+	t.Run("RatePlans", func(t *testing.T) {
+		c := newClient()
+		h, plain := newHotel(t, c, p.Admin)
+		rt, r, plan := newRatePlan(t, c, p.Admin, h)
+		if plan.GetId() == "" || plan.GetHotelId() != h.GetId() || plan.GetCancellationPolicy().GetFreeCancellationHours() != 48 {
+			t.Fatalf("CreateRatePlan = %v", plan)
+		}
+
+		_, err := c.CreateRatePlan(call(t, p.Admin), &hotelpb.CreateRatePlanRequest{
+			HotelId: h.GetId(), RatePlan: &hotelpb.RatePlan{Name: "Ghost", RoomTypeIds: []string{missingID}},
+		})
+		wantError(t, err, codes.InvalidArgument, "")
+
+		start, end := stay(30, 3)
+		resp, err := c.CheckAvailability(call(t, p.User), &hotelpb.AvailabilityRequest{
+			HotelId: h.GetId(), StartDate: start, EndDate: end,
+		})
+		wantOK(t, err)
+		if len(resp.GetAvailableRooms()) != 2 || resp.GetTotalPrice() != 300 {
+			t.Fatalf("CheckAvailability = %v, want both rooms from 300", resp)
+		}
+		if len(resp.GetOffers()) != 1 || resp.GetOffers()[0].GetRatePlanId() != plan.GetId() ||
+			resp.GetOffers()[0].GetRoomTypeId() != rt.GetId() || resp.GetOffers()[0].GetTotalPrice() != 315 {
+			t.Fatalf("CheckAvailability offers = %v, want the plan for 315", resp.GetOffers())
+		}
+
+		resp, err = c.CheckAvailability(call(t, p.User), &hotelpb.AvailabilityRequest{
+			HotelId: h.GetId(), StartDate: start, EndDate: end, RatePlanId: plan.GetId(),
+		})
+		wantOK(t, err)
+		if len(resp.GetAvailableRooms()) != 1 || resp.GetAvailableRooms()[0].GetId() != r.GetId() || resp.GetTotalPrice() != 315 {
+			t.Fatalf("CheckAvailability with plan = %v, want room %s (not %s) for 315", resp, r.GetId(), plain.GetId())
+		}
+
+		// The plan needs two nights.
+		start, end = stay(30, 1)
+		resp, err = c.CheckAvailability(call(t, p.User), &hotelpb.AvailabilityRequest{
+			HotelId: h.GetId(), StartDate: start, EndDate: end, RatePlanId: plan.GetId(),
+		})
+		wantOK(t, err)
+		if resp.GetIsAvailable() || len(resp.GetOffers()) != 0 {
+			t.Fatalf("CheckAvailability below min_nights = %v, want nothing", resp)
+		}
+
+		plan.Active = false
+		_, err = c.UpdateRatePlan(call(t, p.Admin), &hotelpb.UpdateRatePlanRequest{HotelId: h.GetId(), Id: plan.GetId(), RatePlan: plan})
+		wantOK(t, err)
+		_, err = c.CheckAvailability(call(t, p.User), &hotelpb.AvailabilityRequest{
+			HotelId: h.GetId(), StartDate: start, EndDate: end, RatePlanId: plan.GetId(),
+		})
+		wantError(t, err, codes.FailedPrecondition, apierrors.ReasonRatePlanUnavailable)
+
+		list, err := c.ListRatePlans(call(t, p.User), &hotelpb.ListRatePlansRequest{HotelId: h.GetId()})
+		wantOK(t, err)
+		if len(list.GetRatePlans()) != 1 || list.GetRatePlans()[0].GetActive() {
+			t.Fatalf("ListRatePlans = %v, want the deactivated plan", list)
+		}
+
+		_, err = c.DeleteRatePlan(call(t, p.Admin), &hotelpb.DeleteRatePlanRequest{HotelId: h.GetId(), Id: plan.GetId()})
+		wantOK(t, err)
+		_, err = c.GetRatePlan(call(t, p.User), &hotelpb.GetRatePlanRequest{HotelId: h.GetId(), Id: plan.GetId()})
+		wantError(t, err, codes.NotFound, apierrors.ReasonRatePlanNotFound)
+	})
+
+	t.Run("PricingRules", func(t *testing.T) {
+		c := newClient()
+		h, r := newHotel(t, c, p.Admin)
+		start, end := stay(30, 3)
+		createRule := func(rule *hotelpb.PricingRule) *hotelpb.PricingRule {
+			t.Helper()
+
+			rule.Active = true
+			got, err := c.CreatePricingRule(call(t, p.Admin), &hotelpb.CreatePricingRuleRequest{HotelId: h.GetId(), PricingRule: rule})
+			wantOK(t, err)
+			return got
+		}
+
+		weekend := createRule(&hotelpb.PricingRule{
+			Name: "Weekend", WeekdayMask: 1<<time.Friday | 1<<time.Saturday,
+			AdjustmentType: hotelpb.AdjustmentType_ADJUSTMENT_TYPE_PERCENT, Adjustment: 20,
+		})
+		lastMinute := createRule(&hotelpb.PricingRule{
+			Name: "Last minute", ArrivalWithinDays: 2, Priority: 5,
+			AdjustmentType: hotelpb.AdjustmentType_ADJUSTMENT_TYPE_AMOUNT, Adjustment: -30,
+		})
+		event := createRule(&hotelpb.PricingRule{
+			Name: "Event", Priority: 10,
+			StartDate: timestamppb.New(start.AsTime().AddDate(0, 0, 1)), EndDate: timestamppb.New(start.AsTime().AddDate(0, 0, 2)),
+			AdjustmentType: hotelpb.AdjustmentType_ADJUSTMENT_TYPE_FIXED_PRICE, Adjustment: 80,
+		})
+
+		// base is the room price after the weekend surcharge.
+		base := func(n *hotelpb.NightlyPrice) float64 {
+			if wd := n.GetDate().AsTime().Weekday(); wd == time.Friday || wd == time.Saturday {
+				return 120
+			}
+			return 100
+		}
+
+		q, err := c.QuotePrice(call(t, p.User), &hotelpb.QuotePriceRequest{
+			HotelId: h.GetId(), RoomId: r.GetId(), StartDate: start, EndDate: end,
+		})
+		wantOK(t, err)
+		if len(q.GetNights()) != 3 {
+			t.Fatalf("QuotePrice = %v, want 3 nights", q)
+		}
+		var total float64
+		for i, n := range q.GetNights() {
+			want := base(n)
+			if i == 1 {
+				want = 80
+			}
+			if n.GetPrice() != want || n.GetBasePrice() != 100 {
+				t.Fatalf("QuotePrice night %d = %v, want %v", i, n, want)
+			}
+			total += want
+		}
+		if q.GetTotalPrice() != total || q.GetNights()[1].GetPricingRuleIds()[len(q.GetNights()[1].GetPricingRuleIds())-1] != event.GetId() {
+			t.Fatalf("QuotePrice = %v, want %v with the event rule last on night 1", q, total)
+		}
+
+		resp, err := c.CheckAvailability(call(t, p.User), &hotelpb.AvailabilityRequest{
+			HotelId: h.GetId(), StartDate: start, EndDate: end,
+		})
+		wantOK(t, err)
+		if resp.GetTotalPrice() != total {
+			t.Fatalf("CheckAvailability total = %v, want the quoted %v", resp.GetTotalPrice(), total)
+		}
+
+		start, end = stay(1, 1)
+		q, err = c.QuotePrice(call(t, p.User), &hotelpb.QuotePriceRequest{
+			HotelId: h.GetId(), RoomId: r.GetId(), StartDate: start, EndDate: end,
+		})
+		wantOK(t, err)
+		if n := q.GetNights()[0]; n.GetPrice() != base(n)-30 {
+			t.Fatalf("QuotePrice tomorrow = %v, want the last-minute deal", q)
+		}
+
+		_, err = c.CreatePricingRule(call(t, p.Admin), &hotelpb.CreatePricingRuleRequest{
+			HotelId: h.GetId(), PricingRule: &hotelpb.PricingRule{Name: "No adjustment"},
+		})
+		wantError(t, err, codes.InvalidArgument, "")
+
+		_, err = c.CreatePricingRule(call(t, p.User), &hotelpb.CreatePricingRuleRequest{
+			HotelId: h.GetId(), PricingRule: weekend,
+		})
+		wantError(t, err, codes.PermissionDenied, "")
+
+		list, err := c.ListPricingRules(call(t, p.Admin), &hotelpb.ListPricingRulesRequest{HotelId: h.GetId()})
+		wantOK(t, err)
+		var ids []string
+		for _, rule := range list.GetPricingRules() {
+			ids = append(ids, rule.GetId())
+		}
+		if !slices.Equal(ids, []string{weekend.GetId(), lastMinute.GetId(), event.GetId()}) {
+			t.Fatalf("ListPricingRules = %v, want them in priority order", list)
+		}
+
+		_, err = c.DeletePricingRule(call(t, p.Admin), &hotelpb.DeletePricingRuleRequest{HotelId: h.GetId(), Id: event.GetId()})
+		wantOK(t, err)
+		_, err = c.GetPricingRule(call(t, p.Admin), &hotelpb.GetPricingRuleRequest{HotelId: h.GetId(), Id: event.GetId()})
+		wantError(t, err, codes.NotFound, apierrors.ReasonPricingRuleNotFound)
+	})
+
+	t.Run("CheckAvailability", func(t *testing.T) {
+		c := newClient()
+		h, r := newHotel(t, c, p.Admin)
+		start, end := stay(30, 3)
+
+		resp, err := c.CheckAvailability(call(t, p.User), &hotelpb.AvailabilityRequest{
+			HotelId: h.GetId(), StartDate: start, EndDate: end,
+		})
+		wantOK(t, err)
+		if !resp.GetIsAvailable() || len(resp.GetAvailableRooms()) != 1 ||
+			resp.GetAvailableRooms()[0].GetId() != r.GetId() || resp.GetTotalPrice() != 300 {
+			t.Fatalf("CheckAvailability = %v, want room %s for 300", resp, r.GetId())
+		}
+
+		_, err = c.CheckAvailability(call(t, p.User), &hotelpb.AvailabilityRequest{
+			HotelId: h.GetId(), StartDate: end, EndDate: start,
+		})
+		wantError(t, err, codes.InvalidArgument, apierrors.ReasonInvalidDateRange)
+
+		_, err = c.CheckAvailability(call(t, p.User), &hotelpb.AvailabilityRequest{
+			HotelId: missingID, StartDate: start, EndDate: end,
+		})
+		wantError(t, err, codes.NotFound, apierrors.ReasonHotelNotFound)
+	})
+}
@@ -0,0 +1,136 @@
+// Package eventbus is the in-process publish/subscribe bus behind the
+// ChannelBus of the auth and booking event packages. Publish never blocks: a
+// subscriber whose buffer is full is disconnected with ErrSlowSubscriber so
+// that one stalled consumer cannot hold up the producers.
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrClosed = errors.New("eventbus: bus closed")
+	// ErrSlowSubscriber ends a subscription that stopped keeping up with
+	// the published events.
+	ErrSlowSubscriber = status.Error(codes.ResourceExhausted, "eventbus: subscriber fell behind and was disconnected")
+)
+
+// Subscription is a stream of events from a Bus.
+type Subscription[T any] struct {
+	// C receives the matching events and is closed when the subscription
+	// ends.
+	C   <-chan T
+	err error
+}
+
+// Err reports why the subscription ended once C is closed: ErrSlowSubscriber
+// if the subscriber was disconnected for falling behind, nil otherwise.
+func (s *Subscription[T]) Err() error {
+	return s.err
+}
+
+// Bus delivers events of type T to the subscribers whose match function
+// accepts them.
+type Bus[T any] struct {
+	buffer int
+	done   chan struct{}
+
+	mu     sync.Mutex
+	subs   map[*subscriber[T]]struct{}
+	closed bool
+}
+
+type subscriber[T any] struct {
+	match func(T) bool
+	ch    chan T
+	sub   *Subscription[T]
+}
+
+// New returns a bus whose subscriber channels hold up to buffer undelivered
+// events.
+func New[T any](buffer int) *Bus[T] {
+	return &Bus[T]{buffer: buffer, done: make(chan struct{}), subs: make(map[*subscriber[T]]struct{})}
+}
+
+// Publish hands e to every matching subscriber, disconnecting those that
+// have no room for it.
+func (b *Bus[T]) Publish(e T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return ErrClosed
+	}
+	for s := range b.subs {
+		if s.match != nil && !s.match(e) {
+			continue
+		}
+		select {
+		case s.ch <- e:
+		default:
+			b.end(s, ErrSlowSubscriber)
+		}
+	}
+	return nil
+}
+
+// Subscribe streams the events accepted by match until ctx is done. A nil
+// match accepts every event.
+func (b *Bus[T]) Subscribe(ctx context.Context, match func(T) bool) (*Subscription[T], error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, ErrClosed
+	}
+	ch := make(chan T, b.buffer)
+	s := &subscriber[T]{match: match, ch: ch, sub: &Subscription[T]{C: ch}}
+	b.subs[s] = struct{}{}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			b.remove(s)
+		case <-b.done:
+		}
+	}()
+	return s.sub, nil
+}
+
+func (b *Bus[T]) remove(s *subscriber[T]) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.end(s, nil)
+}
+
+// end closes the channel of s after recording err. b.mu must be held.
+func (b *Bus[T]) end(s *subscriber[T], err error) {
+	if _, ok := b.subs[s]; ok {
+		delete(b.subs, s)
+		s.sub.err = err
+		close(s.ch)
+	}
+}
+
+// Close ends every subscription. Later calls to Publish and Subscribe fail
+// with ErrClosed.
+func (b *Bus[T]) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil
+	}
+	b.closed = true
+	close(b.done)
+	for s := range b.subs {
+		b.end(s, nil)
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// drain returns the events buffered in s without waiting for more.
+func drain[T any](s *Subscription[T]) (got []T, closed bool) {
+	for {
+		select {
+		case e, ok := <-s.C:
+			if !ok {
+				return got, true
+			}
+			got = append(got, e)
+		default:
+			return got, false
+		}
+	}
+}
+
+func even(n int) bool { return n%2 == 0 }
+
+func TestBusPublish(t *testing.T) {
+	tests := []struct {
+		name       string
+		buffer     int
+		match      func(int) bool
+		publish    []int
+		want       []int
+		wantClosed bool
+		wantErr    error
+	}{
+		{"nil match accepts all", 4, nil, []int{1, 2, 3}, []int{1, 2, 3}, false, nil},
+		{"match filters", 4, even, []int{1, 2, 3, 4}, []int{2, 4}, false, nil},
+		{"unmatched events keep the buffer free", 1, even, []int{1, 3, 5, 2}, []int{2}, false, nil},
+		{"exact fit", 2, nil, []int{1, 2}, []int{1, 2}, false, nil},
+		{"overflow disconnects", 2, nil, []int{1, 2, 3, 4}, []int{1, 2}, true, ErrSlowSubscriber},
+		{"unbuffered subscriber is dropped", 0, nil, []int{1}, nil, true, ErrSlowSubscriber},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := New[int](tt.buffer)
+			defer b.Close()
+
+			s, err := b.Subscribe(context.Background(), tt.match)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, e := range tt.publish {
+				if err := b.Publish(e); err != nil {
+					t.Fatalf("Publish(%d): %v", e, err)
+				}
+			}
+			got, closed := drain(s)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("received %v, want %v", got, tt.want)
+			}
+			if closed != tt.wantClosed {
+				t.Errorf("closed = %v, want %v", closed, tt.wantClosed)
+			}
+			if closed && !errors.Is(s.Err(), tt.wantErr) {
+				t.Errorf("Err = %v, want %v", s.Err(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBusSlowSubscriberDoesNotBlockOthers(t *testing.T) {
+	b := New[int](1)
+	defer b.Close()
+
+	slow, _ := b.Subscribe(context.Background(), nil)
+	fast, _ := b.Subscribe(context.Background(), nil)
+
+	for i := range 10 {
+		if err := b.Publish(i); err != nil {
+			t.Fatalf("Publish(%d): %v", i, err)
+		}
+		if got, closed := drain(fast); closed || !slices.Equal(got, []int{i}) {
+			t.Fatalf("fast subscriber got %v (closed %v), want [%d]", got, closed, i)
+		}
+	}
+	if _, closed := drain(slow); !closed {
+		t.Fatal("slow subscriber was not disconnected")
+	}
+	if got := status.Code(slow.Err()); got != codes.ResourceExhausted {
+		t.Errorf("slow Err code = %v, want ResourceExhausted", got)
+	}
+}
+
+func TestBusEnd(t *testing.T) {
+	tests := []struct {
+		name string
+		end  func(b *Bus[int], cancel context.CancelFunc)
+	}{
+		{"context cancelled", func(_ *Bus[int], cancel context.CancelFunc) { cancel() }},
+		{"bus closed", func(b *Bus[int], _ context.CancelFunc) { b.Close() }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := New[int](1)
+			defer b.Close()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			s, err := b.Subscribe(ctx, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.end(b, cancel)
+
+			select {
+			case _, ok := <-s.C:
+				if ok {
+					t.Fatal("received an event, want a closed channel")
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("subscription was not closed")
+			}
+			if err := s.Err(); err != nil {
+				t.Errorf("Err = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestBusClosed(t *testing.T) {
+	b := New[int](1)
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Close(); err != nil {
+		t.Errorf("second Close = %v, want nil", err)
+	}
+	if err := b.Publish(1); !errors.Is(err, ErrClosed) {
+		t.Errorf("Publish = %v, want ErrClosed", err)
+	}
+	if _, err := b.Subscribe(context.Background(), nil); !errors.Is(err, ErrClosed) {
+		t.Errorf("Subscribe = %v, want ErrClosed", err)
+	}
+}
+
+func TestBusConcurrentPublish(t *testing.T) {
+	b := New[int](8)
+	defer b.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s, _ := b.Subscribe(ctx, nil)
+	done := make(chan struct{})
+	for range 4 {
+		go func() {
+			for i := range 100 {
+				_ = b.Publish(i)
+			}
+			done <- struct{}{}
+		}()
+	}
+	go func() {
+		for range s.C {
+		}
+	}()
+	for range 4 {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Publish blocked")
+		}
+	}
+	cancel()
+}
@@ -0,0 +1,40 @@
+package export
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+
+	bookpb "github.com/JunBSer/services_proto/booking/gen/go"
+)
+
+// bookingsPageSize is the page size asked of ListBookings. Servers may
+// return smaller pages, so only an empty page ends the export.
+const bookingsPageSize = 100
+
+// Bookings exports a user's bookings through ListBookings. ListBookings is
+// admin-only, so client must carry admin credentials, e.g. through a
+// per-RPC credentials dial option. Bookings of other users are dropped even
+// if the server ignores the user filter.
+func Bookings(client bookpb.BookingServiceClient) Exporter {
+	return ExporterFunc(func(ctx context.Context, userID string) (proto.Message, error) {
+		all := &bookpb.ListBookingsResponse{}
+		for page := 1; ; page++ {
+			resp, err := client.ListBookings(ctx, &bookpb.ListBookingsRequest{
+				PageSize: bookingsPageSize, Page: strconv.Itoa(page), UserId: userID,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, b := range resp.GetBookings() {
+				if b.GetUserId() == userID {
+					all.Bookings = append(all.Bookings, b)
+				}
+			}
+			if len(resp.GetBookings()) == 0 {
+				return all, nil
+			}
+		}
+	})
+}
@@ -0,0 +1,131 @@
+// Package export builds GDPR data exports. Every service contributes what it
+// holds about a user through an Exporter; Archive zips the results as one
+// JSON document per exporter plus a manifest.json.
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// ContentType is the media type of archives built by Archive.
+const ContentType = "application/zip"
+
+var ErrNoUser = errors.New("export: user ID is required")
+
+// Exporter returns the data a service holds about a user. A nil message means
+// there is none and the document is left out.
+type Exporter interface {
+	Export(ctx context.Context, userID string) (proto.Message, error)
+}
+
+type ExporterFunc func(ctx context.Context, userID string) (proto.Message, error)
+
+func (f ExporterFunc) Export(ctx context.Context, userID string) (proto.Message, error) {
+	return f(ctx, userID)
+}
+
+// Manifest is written to manifest.json and lists the documents of an archive.
+type Manifest struct {
+	UserID      string    `json:"user_id"`
+	GeneratedAt time.Time `json:"generated_at"`
+	Files       []string  `json:"files"`
+}
+
+type part struct {
+	name     string
+	exporter Exporter
+}
+
+// Archive collects registered exporters. It is safe for concurrent use.
+type Archive struct {
+	mu    sync.RWMutex
+	parts []part
+}
+
+func NewArchive() *Archive {
+	return &Archive{}
+}
+
+// Register adds an exporter whose document is stored as name.json.
+// Registering a name again replaces the previous exporter.
+func (a *Archive) Register(name string, e Exporter) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for i, p := range a.parts {
+		if p.name == name {
+			a.parts[i].exporter = e
+			return
+		}
+	}
+	a.parts = append(a.parts, part{name: name, exporter: e})
+}
+
+// Build runs every exporter for the user and returns the zip archive. It
+// fails if any exporter fails, so users never receive a partial export, and
+// with ErrNoUser for an empty userID, which exporters could read as "every
+// user".
+func (a *Archive) Build(ctx context.Context, userID string, now time.Time) ([]byte, error) {
+	if userID == "" {
+		return nil, ErrNoUser
+	}
+
+	a.mu.RLock()
+	parts := append([]part(nil), a.parts...)
+	a.mu.RUnlock()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	manifest := Manifest{UserID: userID, GeneratedAt: now.UTC(), Files: []string{}}
+	marshal := protojson.MarshalOptions{Multiline: true, Indent: "  ", UseProtoNames: true}
+
+	for _, p := range parts {
+		m, err := p.exporter.Export(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("export: %s: %w", p.name, err)
+		}
+		if m == nil {
+			continue
+		}
+		b, err := marshal.Marshal(m)
+		if err != nil {
+			return nil, fmt.Errorf("export: %s: %w", p.name, err)
+		}
+		name := p.name + ".json"
+		if err := writeFile(zw, name, b, now); err != nil {
+			return nil, err
+		}
+		manifest.Files = append(manifest.Files, name)
+	}
+
+	b, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFile(zw, "manifest.json", b, now); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeFile(zw *zip.Writer, name string, data []byte, modified time.Time) error {
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
@@ -0,0 +1,204 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	bookpb "github.com/JunBSer/services_proto/booking/gen/go"
+)
+
+var buildTime = time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func readArchive(t *testing.T, b []byte) map[string][]byte {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		t.Fatalf("open archive: %v", err)
+	}
+	files := make(map[string][]byte)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[f.Name] = data
+	}
+	return files
+}
+
+func constant(m proto.Message, err error) Exporter {
+	return ExporterFunc(func(context.Context, string) (proto.Message, error) { return m, err })
+}
+
+func TestArchiveBuild(t *testing.T) {
+	errBoom := errors.New("boom")
+	tests := []struct {
+		name      string
+		userID    string
+		parts     map[string]Exporter
+		wantFiles []string
+		wantErr   error
+	}{
+		{
+			name:      "no exporters",
+			userID:    "u-1",
+			wantFiles: []string{},
+		},
+		{
+			name:   "one document per exporter",
+			userID: "u-1",
+			parts: map[string]Exporter{
+				"profile": constant(wrapperspb.String("alice"), nil),
+				"empty":   constant(nil, nil),
+			},
+			wantFiles: []string{"profile.json"},
+		},
+		{
+			name:    "exporter failure fails the build",
+			userID:  "u-1",
+			parts:   map[string]Exporter{"profile": constant(nil, errBoom)},
+			wantErr: errBoom,
+		},
+		{
+			name:    "empty user ID",
+			parts:   map[string]Exporter{"profile": constant(wrapperspb.String("everyone"), nil)},
+			wantErr: ErrNoUser,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewArchive()
+			for name, e := range tt.parts {
+				a.Register(name, e)
+			}
+			b, err := a.Build(context.Background(), tt.userID, buildTime)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Build error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			files := readArchive(t, b)
+			var m Manifest
+			if err := json.Unmarshal(files["manifest.json"], &m); err != nil {
+				t.Fatalf("manifest.json: %v", err)
+			}
+			if m.UserID != tt.userID || !m.GeneratedAt.Equal(buildTime) || !slices.Equal(m.Files, tt.wantFiles) {
+				t.Errorf("manifest = %+v, want user %s, time %v and files %v", m, tt.userID, buildTime, tt.wantFiles)
+			}
+			for _, name := range tt.wantFiles {
+				if _, ok := files[name]; !ok {
+					t.Errorf("archive lacks %s", name)
+				}
+			}
+		})
+	}
+}
+
+func TestArchiveRegisterReplaces(t *testing.T) {
+	a := NewArchive()
+	a.Register("profile", constant(wrapperspb.String("old"), nil))
+	a.Register("profile", constant(wrapperspb.String("new"), nil))
+
+	b, err := a.Build(context.Background(), "u-1", buildTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := readArchive(t, b)
+	if got := string(files["profile.json"]); !strings.Contains(got, "new") {
+		t.Errorf("profile.json = %s, want the replacement exporter's document", got)
+	}
+}
+
+// bookingsClient serves ListBookings pages from bookings, ignoring the
+// user filter like a misbehaving server would when ignoreUser is set and
+// capping the page size at maxPageSize when it is not zero.
+type bookingsClient struct {
+	bookpb.BookingServiceClient
+	bookings    []*bookpb.BookingDetails
+	ignoreUser  bool
+	maxPageSize int
+	pages       int
+}
+
+func (c *bookingsClient) ListBookings(_ context.Context, req *bookpb.ListBookingsRequest, _ ...grpc.CallOption) (*bookpb.ListBookingsResponse, error) {
+	c.pages++
+	var matching []*bookpb.BookingDetails
+	for _, b := range c.bookings {
+		if c.ignoreUser || b.GetUserId() == req.GetUserId() {
+			matching = append(matching, b)
+		}
+	}
+	size := int(req.GetPageSize())
+	if c.maxPageSize != 0 {
+		size = min(size, c.maxPageSize)
+	}
+	page, _ := strconv.Atoi(req.GetPage())
+	start := min((page-1)*size, len(matching))
+	end := min(start+size, len(matching))
+	return &bookpb.ListBookingsResponse{Bookings: matching[start:end]}, nil
+}
+
+func TestBookings(t *testing.T) {
+	var bookings []*bookpb.BookingDetails
+	for i := range 250 {
+		user := "u-1"
+		if i%2 == 1 {
+			user = "u-2"
+		}
+		bookings = append(bookings, &bookpb.BookingDetails{BookingId: strconv.Itoa(i), UserId: user})
+	}
+
+	tests := []struct {
+		name        string
+		ignoreUser  bool
+		maxPageSize int
+		wantCount   int
+		wantPages   int
+	}{
+		{"server filters", false, 0, 125, 3},
+		{"server ignores the filter", true, 0, 125, 4},
+		{"server caps the page size", false, 20, 125, 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &bookingsClient{bookings: bookings, ignoreUser: tt.ignoreUser, maxPageSize: tt.maxPageSize}
+			m, err := Bookings(c).Export(context.Background(), "u-1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := m.(*bookpb.ListBookingsResponse).GetBookings()
+			if len(got) != tt.wantCount {
+				t.Errorf("exported %d bookings, want %d", len(got), tt.wantCount)
+			}
+			for _, b := range got {
+				if b.GetUserId() != "u-1" {
+					t.Fatalf("exported booking %s of %s", b.GetBookingId(), b.GetUserId())
+				}
+			}
+			if c.pages != tt.wantPages {
+				t.Errorf("fetched %d pages, want %d", c.pages, tt.wantPages)
+			}
+		})
+	}
+}
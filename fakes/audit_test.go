@@ -0,0 +1,120 @@
+package fakes_test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/fakes"
+)
+
+func TestAuditTrail(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	p := principals(t, env)
+	ctx := context.Background()
+	admin := fakes.WithToken(ctx, p.Admin.AccessToken)
+
+	if _, err := env.AuthClient.SuspendUser(admin, &authpb.SuspendUserRequest{UserId: p.User.UserID, Reason: "spam"}); err != nil {
+		t.Fatalf("SuspendUser: %v", err)
+	}
+	if _, err := env.AuthClient.SuspendUser(admin, &authpb.SuspendUserRequest{UserId: "missing"}); err == nil {
+		t.Fatal("SuspendUser(missing) succeeded")
+	}
+	// Not an admin method, so not audited.
+	if _, err := env.AuthClient.UpdateProfile(fakes.WithToken(ctx, p.Other.AccessToken),
+		&authpb.UpdateProfileRequest{Name: "Renamed"}); err != nil {
+		t.Fatalf("UpdateProfile: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		token       string
+		req         *authpb.ListAuditEntriesRequest
+		wantCode    codes.Code
+		wantOutcome []authpb.AuditOutcome
+	}{
+		{
+			name:  "suspensions newest first",
+			token: p.Admin.AccessToken,
+			req:   &authpb.ListAuditEntriesRequest{Method: authpb.Auth_SuspendUser_FullMethodName},
+			wantOutcome: []authpb.AuditOutcome{
+				authpb.AuditOutcome_AUDIT_OUTCOME_FAILURE, authpb.AuditOutcome_AUDIT_OUTCOME_SUCCESS,
+			},
+		},
+		{
+			name:        "by resource",
+			token:       p.Admin.AccessToken,
+			req:         &authpb.ListAuditEntriesRequest{Resource: "/v1/admin/users/" + p.User.UserID},
+			wantOutcome: []authpb.AuditOutcome{authpb.AuditOutcome_AUDIT_OUTCOME_SUCCESS},
+		},
+		{
+			name:  "no user methods",
+			token: p.Admin.AccessToken,
+			req:   &authpb.ListAuditEntriesRequest{Method: authpb.Auth_UpdateProfile_FullMethodName},
+		},
+		{name: "user caller", token: p.Other.AccessToken, req: &authpb.ListAuditEntriesRequest{}, wantCode: codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := env.AuthClient.ListAuditEntries(fakes.WithToken(ctx, tt.token), tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("ListAuditEntries = %v, want %v", err, tt.wantCode)
+			}
+			if len(resp.GetEntries()) != len(tt.wantOutcome) {
+				t.Fatalf("got %d entries, want %d", len(resp.GetEntries()), len(tt.wantOutcome))
+			}
+			for i, e := range resp.GetEntries() {
+				if e.GetOutcome() != tt.wantOutcome[i] {
+					t.Errorf("entry %d outcome = %v, want %v", i, e.GetOutcome(), tt.wantOutcome[i])
+				}
+				if e.GetActorId() != p.Admin.UserID || e.GetActorType() != authpb.SubjectType_SUBJECT_TYPE_USER {
+					t.Errorf("entry %d actor = %s %v, want the admin", i, e.GetActorId(), e.GetActorType())
+				}
+			}
+		})
+	}
+}
+
+func TestAuditDeniedCalls(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	p := principals(t, env)
+	ctx := context.Background()
+
+	req := &authpb.SuspendUserRequest{UserId: p.User.UserID}
+	if _, err := env.AuthClient.SuspendUser(fakes.WithToken(ctx, p.Other.AccessToken), req); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("SuspendUser as user = %v, want PermissionDenied", err)
+	}
+	if _, err := env.AuthClient.SuspendUser(ctx, req); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("SuspendUser anonymously = %v, want Unauthenticated", err)
+	}
+
+	resp, err := env.AuthClient.ListAuditEntries(fakes.WithToken(ctx, p.Admin.AccessToken),
+		&authpb.ListAuditEntriesRequest{Method: authpb.Auth_SuspendUser_FullMethodName})
+	if err != nil {
+		t.Fatalf("ListAuditEntries: %v", err)
+	}
+	want := []struct {
+		actor, code, reason string
+	}{
+		{"", "Unauthenticated", "TOKEN_INVALID"},
+		{p.Other.UserID, "PermissionDenied", "PERMISSION_DENIED"},
+	}
+	if len(resp.GetEntries()) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(resp.GetEntries()), len(want))
+	}
+	for i, e := range resp.GetEntries() {
+		if e.GetActorId() != want[i].actor || e.GetStatusCode() != want[i].code || e.GetErrorReason() != want[i].reason {
+			t.Errorf("entry %d = actor %q, %s %s; want actor %q, %s %s", i,
+				e.GetActorId(), e.GetStatusCode(), e.GetErrorReason(), want[i].actor, want[i].code, want[i].reason)
+		}
+		if e.GetOutcome() != authpb.AuditOutcome_AUDIT_OUTCOME_FAILURE {
+			t.Errorf("entry %d outcome = %v, want FAILURE", i, e.GetOutcome())
+		}
+		if got := e.GetParams()["user_id"]; got != `"`+p.User.UserID+`"` {
+			t.Errorf("entry %d user_id param = %s", i, got)
+		}
+	}
+}
@@ -0,0 +1,1540 @@
+package fakes
+
+import (
+	"context"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	"github.com/JunBSer/services_proto/auth/audit"
+	userevents "github.com/JunBSer/services_proto/auth/events"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/auth/mailer"
+	"github.com/JunBSer/services_proto/auth/oauth"
+	"github.com/JunBSer/services_proto/auth/reauth"
+	"github.com/JunBSer/services_proto/auth/totp"
+	"github.com/JunBSer/services_proto/export"
+)
+
+const (
+	pbkdf2Iterations = 4096
+	userEventSource  = "/fakes/auth"
+	oneTimeTTL       = time.Hour
+	mfaTTL           = 5 * time.Minute
+	reauthTTL        = 5 * time.Minute
+	recoveryCodes    = 10
+	// maxMFAAttempts is the number of wrong codes after which an MFA
+	// challenge is invalidated.
+	maxMFAAttempts = 5
+)
+
+type user struct {
+	id            string
+	name          string
+	email         string
+	salt          []byte
+	hash          []byte
+	admin         bool
+	emailVerified bool
+	createdAt     time.Time
+
+	suspended        bool
+	suspensionReason string
+
+	// deletedAt is set while the account awaits purging.
+	deletedAt      time.Time
+	purgeAt        time.Time
+	deletedByAdmin bool
+
+	totpSecret   string
+	totpPending  string
+	totpCounter  uint64
+	recoveryHash []string
+
+	identities []*authpb.LinkedIdentity
+}
+
+type session struct {
+	userID    string
+	createdAt time.Time
+}
+
+type oneTimeToken struct {
+	userID  string
+	kind    mailer.Kind
+	expires time.Time
+}
+
+type oauthState struct {
+	provider string
+	expires  time.Time
+}
+
+type serviceAccount struct {
+	account        *authpb.ServiceAccount
+	secretHash     string
+	prevSecretHash string
+	prevValidUntil time.Time
+}
+
+// AuthServer is an in-memory authpb.AuthServer issuing HS256 JWTs. Account
+// lifecycle events are published on Events.
+type AuthServer struct {
+	authpb.UnimplementedAuthServer
+
+	Events *userevents.ChannelBus
+	// Export builds ExportMyData archives. The fake registers the account
+	// document; NewServers adds bookings.
+	Export *export.Archive
+	// Audit holds the entries recorded for admin calls and served by
+	// ListAuditEntries.
+	Audit *audit.MemoryStore
+
+	opts      Options
+	signer    signer
+	templates mailer.Templates
+	totp      totp.Options
+	// OAuth holds the providers accepted by StartOAuthLogin. Register a
+	// provider built with oauth.FakeIDP to test social login.
+	OAuth *oauth.Registry
+
+	mu          sync.RWMutex
+	users       map[string]*user
+	byEmail     map[string]string
+	sessions    map[string]session
+	usedTokens  map[string]bool
+	mfaFailures map[string]int // MFA challenge ID -> wrong codes
+	oneTime     map[string]oneTimeToken
+	oauthStates map[string]oauthState
+	accounts    map[string]*serviceAccount
+	// pending holds event payloads recorded under mu until flush publishes
+	// them.
+	pending []proto.Message
+}
+
+func NewAuthServer(opts Options) *AuthServer {
+	opts.defaults()
+
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+
+	s := &AuthServer{
+		Events:      userevents.NewChannelBus(64),
+		Audit:       audit.NewMemoryStore(),
+		Export:      export.NewArchive(),
+		opts:        opts,
+		signer:      signer{key: key, issuer: "fakes"},
+		templates:   mailer.Templates{BaseURL: "http://localhost"},
+		totp:        totp.Options{Issuer: "fakes", Clock: clockFunc(opts.Clock)},
+		OAuth:       oauth.NewRegistry(),
+		users:       make(map[string]*user),
+		byEmail:     make(map[string]string),
+		sessions:    make(map[string]session),
+		usedTokens:  make(map[string]bool),
+		mfaFailures: make(map[string]int),
+		oneTime:     make(map[string]oneTimeToken),
+		oauthStates: make(map[string]oauthState),
+		accounts:    make(map[string]*serviceAccount),
+	}
+	s.Export.Register("account", export.ExporterFunc(s.exportAccount))
+	admin := s.addUser("Admin", opts.AdminEmail, opts.AdminPassword, true)
+	admin.emailVerified = true
+	return s
+}
+
+type clockFunc func() time.Time
+
+func (c clockFunc) Now() time.Time { return c() }
+
+func (s *AuthServer) now() time.Time { return s.opts.Clock() }
+
+// Mailer returns the mailer used for verification and reset emails.
+func (s *AuthServer) Mailer() mailer.Mailer { return s.opts.Mailer }
+
+func hashPassword(password string, salt []byte) []byte {
+	key, _ := pbkdf2.Key(sha256.New, password, salt, pbkdf2Iterations, 32)
+	return key
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func (u *user) checkPassword(password string) bool {
+	return subtle.ConstantTimeCompare(u.hash, hashPassword(password, u.salt)) == 1
+}
+
+func (u *user) setPassword(password string) {
+	u.salt = make([]byte, 16)
+	_, _ = rand.Read(u.salt)
+	u.hash = hashPassword(password, u.salt)
+}
+
+// addUser must be called with s.mu held or before the server is shared.
+func (s *AuthServer) addUser(name, email, password string, admin bool) *user {
+	u := &user{
+		id:        newUUID(),
+		name:      name,
+		email:     strings.ToLower(email),
+		admin:     admin,
+		createdAt: s.now(),
+	}
+	u.setPassword(password)
+	s.users[u.id] = u
+	s.byEmail[u.email] = u.id
+	return u
+}
+
+func (s *AuthServer) removeUser(u *user) {
+	delete(s.users, u.id)
+	delete(s.byEmail, u.email)
+	s.endSessions(u.id)
+}
+
+func (s *AuthServer) userByEmail(email string) (*user, bool) {
+	id, ok := s.byEmail[strings.ToLower(email)]
+	if !ok {
+		return nil, false
+	}
+	return s.users[id], true
+}
+
+// emit records an event payload. It must be called with s.mu held.
+func (s *AuthServer) emit(payload proto.Message) {
+	s.pending = append(s.pending, payload)
+}
+
+// flush publishes the payloads recorded by emit. Handlers defer it before
+// taking s.mu, so it runs after the lock is released.
+func (s *AuthServer) flush(ctx context.Context) {
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = nil
+	s.mu.Unlock()
+
+	for _, p := range pending {
+		e, err := userevents.New(userEventSource, p, s.now())
+		if err != nil {
+			panic(err)
+		}
+		_ = s.Events.Publish(ctx, e)
+	}
+}
+
+func (u *user) deleted() bool { return !u.deletedAt.IsZero() }
+
+func (u *user) status() authpb.UserStatus {
+	switch {
+	case u.deleted():
+		return authpb.UserStatus_USER_STATUS_DELETED
+	case u.suspended:
+		return authpb.UserStatus_USER_STATUS_SUSPENDED
+	case !u.emailVerified:
+		return authpb.UserStatus_USER_STATUS_PENDING_VERIFICATION
+	default:
+		return authpb.UserStatus_USER_STATUS_ACTIVE
+	}
+}
+
+func (u *user) toProto() *authpb.UserResponse {
+	resp := &authpb.UserResponse{
+		UserId:           &authpb.UUID{Value: u.id},
+		Name:             u.name,
+		Email:            u.email,
+		IsAdmin:          u.admin,
+		CreatedAt:        timestamppb.New(u.createdAt),
+		EmailVerified:    u.emailVerified,
+		MfaEnabled:       u.totpSecret != "",
+		LinkedIdentities: u.identities,
+		Status:           u.status(),
+		SuspensionReason: u.suspensionReason,
+	}
+	if u.deleted() {
+		resp.DeletedAt, resp.PurgeAt = timestamppb.New(u.deletedAt), timestamppb.New(u.purgeAt)
+	}
+	return resp
+}
+
+// canSignIn rejects suspended accounts and accounts awaiting deletion.
+func canSignIn(u *user) error {
+	if u.deleted() {
+		return apierrors.WithMetadata(codes.FailedPrecondition, apierrors.ReasonAccountDeleted,
+			"account is scheduled for deletion; restore it to sign in",
+			map[string]string{"purge_at": u.purgeAt.UTC().Format(time.RFC3339)})
+	}
+	if u.suspended {
+		return apierrors.New(codes.PermissionDenied, apierrors.ReasonAccountSuspended, "account suspended")
+	}
+	return nil
+}
+
+func okStatus(msg string) *authpb.Status {
+	return &authpb.Status{Success: true, Message: msg, Code: int32(codes.OK)}
+}
+
+func validateCredentials(email, password string) error {
+	var v []apierrors.FieldViolation
+	if !strings.Contains(email, "@") {
+		v = append(v, apierrors.FieldViolation{Field: "email", Description: "must be a valid email address"})
+	}
+	if len(password) < 8 {
+		v = append(v, apierrors.FieldViolation{Field: "password", Description: "must be at least 8 characters"})
+	}
+	if len(v) > 0 {
+		return apierrors.Validation("invalid credentials format", v...)
+	}
+	return nil
+}
+
+// Tokens
+
+func (s *AuthServer) issuePair(u *user) *authpb.JWTPair {
+	now := s.now()
+	sid := randomID()
+	s.sessions[sid] = session{userID: u.id, createdAt: now}
+
+	base := Claims{Subject: u.id, Admin: u.admin, SessionID: sid, IssuedAt: now.Unix()}
+
+	access := base
+	access.Type, access.ID, access.ExpiresAt = tokenAccess, randomID(), now.Add(s.opts.AccessTTL).Unix()
+	refresh := base
+	refresh.Type, refresh.ID, refresh.ExpiresAt = tokenRefresh, randomID(), now.Add(s.opts.RefreshTTL).Unix()
+
+	return &authpb.JWTPair{AccessToken: s.signer.sign(access), RefreshToken: s.signer.sign(refresh)}
+}
+
+func (s *AuthServer) verify(token, typ string) (*Claims, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.verifyLocked(token, typ)
+}
+
+func (s *AuthServer) verifyLocked(token, typ string) (*Claims, error) {
+	c, err := s.signer.parse(token, s.now())
+	if err != nil || c.Type != typ {
+		return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonTokenInvalid, "invalid or expired token")
+	}
+
+	if c.Service {
+		if sa, ok := s.accounts[c.ClientID]; !ok || sa.account.GetRevoked() {
+			return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonServiceAccountRevoked, "service account revoked")
+		}
+		return c, nil
+	}
+	u, ok := s.users[c.Subject]
+	if !ok {
+		return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonTokenInvalid, "user no longer exists")
+	}
+	if u.deleted() {
+		return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonTokenInvalid, "user no longer exists")
+	}
+	if err := canSignIn(u); err != nil {
+		return nil, err
+	}
+	// The adm claim is only as fresh as the token: a demoted admin must
+	// sign in again.
+	if c.Admin && !u.admin {
+		return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonTokenInvalid, "role changed, sign in again")
+	}
+	if _, ok := s.sessions[c.SessionID]; c.SessionID != "" && !ok {
+		return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonTokenInvalid, "session ended")
+	}
+	return c, nil
+}
+
+// endSessions invalidates every session of a user and returns how many there
+// were. s.mu must be held.
+func (s *AuthServer) endSessions(userID string) int {
+	return s.endOtherSessions(userID, "")
+}
+
+// endOtherSessions invalidates every session of a user but keep and returns
+// how many there were. s.mu must be held.
+func (s *AuthServer) endOtherSessions(userID, keep string) int {
+	n := 0
+	for sid, sess := range s.sessions {
+		if sess.userID == userID && sid != keep {
+			delete(s.sessions, sid)
+			n++
+		}
+	}
+	return n
+}
+
+// currentUser resolves the authenticated caller. An access token passed in
+// the request body must belong to the same user: it is checked but never
+// chosen over the caller, so a stolen token cannot act on its owner's
+// account through someone else's session. s.mu must be held.
+func (s *AuthServer) currentUser(ctx context.Context, bodyToken string) (*user, error) {
+	id, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if bodyToken != "" {
+		c, err := s.verifyLocked(bodyToken, tokenAccess)
+		if err != nil {
+			return nil, err
+		}
+		if c.Subject != id {
+			return nil, apierrors.New(codes.PermissionDenied, apierrors.ReasonPermissionDenied,
+				"access_token does not belong to the caller")
+		}
+	}
+
+	u, ok := s.users[id]
+	if !ok {
+		return nil, apierrors.New(codes.NotFound, apierrors.ReasonUserNotFound, "user not found")
+	}
+	return u, nil
+}
+
+// Public endpoints
+
+func (s *AuthServer) Login(_ context.Context, req *authpb.LoginRequest) (*authpb.LoginResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.userByEmail(req.GetEmail())
+	if !ok || !u.checkPassword(req.GetPassword()) {
+		return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonInvalidCredentials, "invalid email or password")
+	}
+	if err := canSignIn(u); err != nil {
+		return nil, err
+	}
+	if u.totpSecret != "" {
+		return &authpb.LoginResponse{MfaChallenge: s.mfaChallenge(u)}, nil
+	}
+	return &authpb.LoginResponse{Tokens: s.issuePair(u)}, nil
+}
+
+func (s *AuthServer) mfaChallenge(u *user) *authpb.MFAChallenge {
+	now := s.now()
+	expires := now.Add(mfaTTL)
+	token := s.signer.sign(Claims{
+		Subject: u.id, Type: tokenMFA, ID: randomID(), IssuedAt: now.Unix(), ExpiresAt: expires.Unix(),
+	})
+	return &authpb.MFAChallenge{
+		MfaToken:  token,
+		ExpiresAt: timestamppb.New(expires),
+		Methods:   []authpb.MFAMethod{authpb.MFAMethod_MFA_METHOD_TOTP, authpb.MFAMethod_MFA_METHOD_RECOVERY_CODE},
+	}
+}
+
+func (s *AuthServer) Register(ctx context.Context, req *authpb.RegisterRequest) (*authpb.RegisterResponse, error) {
+	if err := validateCredentials(req.GetEmail(), req.GetPassword()); err != nil {
+		return nil, err
+	}
+
+	defer s.flush(ctx)
+	s.mu.Lock()
+	if _, exists := s.userByEmail(req.GetEmail()); exists {
+		s.mu.Unlock()
+		return nil, apierrors.New(codes.AlreadyExists, apierrors.ReasonUserAlreadyExists, "user already exists")
+	}
+	u := s.addUser(req.GetName(), req.GetEmail(), req.GetPassword(), false)
+	s.emit(&authpb.UserRegistered{UserId: &authpb.UUID{Value: u.id}, Name: u.name, Email: u.email})
+	msg := s.templates.Verification(u.email, s.newOneTime(u.id, mailer.KindVerification))
+	s.mu.Unlock()
+
+	if err := s.opts.Mailer.Send(ctx, msg); err != nil {
+		return nil, status.Errorf(codes.Internal, "send verification: %v", err)
+	}
+	return &authpb.RegisterResponse{UserId: &authpb.UUID{Value: u.id}}, nil
+}
+
+func (s *AuthServer) newOneTime(userID string, kind mailer.Kind) string {
+	token := randomID()
+	s.oneTime[token] = oneTimeToken{userID: userID, kind: kind, expires: s.now().Add(oneTimeTTL)}
+	return token
+}
+
+func (s *AuthServer) consumeOneTime(token string, kind mailer.Kind) (*user, error) {
+	t, ok := s.oneTime[token]
+	if !ok || t.kind != kind || !s.now().Before(t.expires) {
+		return nil, apierrors.New(codes.InvalidArgument, apierrors.ReasonTokenInvalid, "invalid or expired token")
+	}
+	delete(s.oneTime, token)
+
+	u, ok := s.users[t.userID]
+	if !ok {
+		return nil, apierrors.New(codes.NotFound, apierrors.ReasonUserNotFound, "user not found")
+	}
+	return u, nil
+}
+
+func (s *AuthServer) VerifyEmail(_ context.Context, req *authpb.VerifyEmailRequest) (*authpb.VerifyEmailResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.consumeOneTime(req.GetToken(), mailer.KindVerification)
+	if err != nil {
+		return nil, err
+	}
+	u.emailVerified = true
+	return &authpb.VerifyEmailResponse{Status: okStatus("email verified")}, nil
+}
+
+func (s *AuthServer) ResendVerification(ctx context.Context, req *authpb.ResendVerificationRequest) (*authpb.ResendVerificationResponse, error) {
+	s.mu.Lock()
+	u, ok := s.userByEmail(req.GetEmail())
+	if !ok || u.emailVerified {
+		s.mu.Unlock()
+		return &authpb.ResendVerificationResponse{Status: okStatus("verification sent")}, nil
+	}
+	msg := s.templates.Verification(u.email, s.newOneTime(u.id, mailer.KindVerification))
+	s.mu.Unlock()
+
+	if err := s.opts.Mailer.Send(ctx, msg); err != nil {
+		return nil, status.Errorf(codes.Internal, "send verification: %v", err)
+	}
+	return &authpb.ResendVerificationResponse{Status: okStatus("verification sent")}, nil
+}
+
+func (s *AuthServer) RequestPasswordReset(ctx context.Context, req *authpb.RequestPasswordResetRequest) (*authpb.RequestPasswordResetResponse, error) {
+	s.mu.Lock()
+	u, ok := s.userByEmail(req.GetEmail())
+	if !ok {
+		s.mu.Unlock()
+		return &authpb.RequestPasswordResetResponse{Status: okStatus("reset email sent")}, nil
+	}
+	msg := s.templates.PasswordReset(u.email, s.newOneTime(u.id, mailer.KindPasswordReset))
+	s.mu.Unlock()
+
+	if err := s.opts.Mailer.Send(ctx, msg); err != nil {
+		return nil, status.Errorf(codes.Internal, "send reset: %v", err)
+	}
+	return &authpb.RequestPasswordResetResponse{Status: okStatus("reset email sent")}, nil
+}
+
+// ResetPassword ends every session of the user, since the old password may
+// have been compromised.
+func (s *AuthServer) ResetPassword(ctx context.Context, req *authpb.ResetPasswordRequest) (*authpb.ResetPasswordResponse, error) {
+	if len(req.GetNewPassword()) < 8 {
+		return nil, apierrors.Validation("invalid password",
+			apierrors.FieldViolation{Field: "new_password", Description: "must be at least 8 characters"})
+	}
+
+	defer s.flush(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.consumeOneTime(req.GetToken(), mailer.KindPasswordReset)
+	if err != nil {
+		return nil, err
+	}
+	u.setPassword(req.GetNewPassword())
+	s.endSessions(u.id)
+	s.emit(&authpb.PasswordChanged{
+		UserId: &authpb.UUID{Value: u.id}, Method: authpb.PasswordChangeMethod_PASSWORD_CHANGE_METHOD_RESET,
+	})
+	return &authpb.ResetPasswordResponse{Status: okStatus("password reset")}, nil
+}
+
+// RefreshToken verifies the token under the write lock, so a user purged or
+// signed out concurrently cannot be issued new tokens.
+func (s *AuthServer) RefreshToken(_ context.Context, req *authpb.RefreshRequest) (*authpb.RefreshResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, err := s.verifyLocked(req.GetRefreshToken(), tokenRefresh)
+	if err != nil {
+		return nil, err
+	}
+	if s.usedTokens[c.ID] {
+		return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonTokenInvalid, "refresh token already used")
+	}
+	s.usedTokens[c.ID] = true
+	delete(s.sessions, c.SessionID)
+
+	return &authpb.RefreshResponse{Tokens: s.issuePair(s.users[c.Subject])}, nil
+}
+
+func (s *AuthServer) VerifyMFA(_ context.Context, req *authpb.VerifyMFARequest) (*authpb.VerifyMFAResponse, error) {
+	c, err := s.signer.parse(req.GetMfaToken(), s.now())
+	if err != nil || c.Type != tokenMFA {
+		return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonTokenInvalid, "invalid or expired MFA challenge")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[c.Subject]
+	if !ok || s.usedTokens[c.ID] {
+		return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonTokenInvalid, "invalid or expired MFA challenge")
+	}
+	if err := canSignIn(u); err != nil {
+		return nil, err
+	}
+	if err := s.checkSecondFactor(u, req.GetTotpCode(), req.GetRecoveryCode()); err != nil {
+		// Burn the challenge after a few wrong codes so a 6-digit code
+		// cannot be guessed within its lifetime.
+		if s.mfaFailures[c.ID]++; s.mfaFailures[c.ID] >= maxMFAAttempts {
+			delete(s.mfaFailures, c.ID)
+			s.usedTokens[c.ID] = true
+		}
+		return nil, err
+	}
+	delete(s.mfaFailures, c.ID)
+	s.usedTokens[c.ID] = true
+	return &authpb.VerifyMFAResponse{Tokens: s.issuePair(u)}, nil
+}
+
+func (s *AuthServer) Reauthenticate(ctx context.Context, req *authpb.ReauthenticateRequest) (*authpb.ReauthenticateResponse, error) {
+	c, ok := ClaimsFromContext(ctx)
+	if !ok || c.Service {
+		return nil, status.Error(codes.Unauthenticated, "user token required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[c.Subject]
+	if !ok {
+		return nil, apierrors.New(codes.NotFound, apierrors.ReasonUserNotFound, "user not found")
+	}
+	if !u.checkPassword(req.GetPassword()) {
+		return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonInvalidCredentials, "password is incorrect")
+	}
+	if u.totpSecret != "" {
+		if req.GetTotpCode() == "" {
+			return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonMFARequired, "totp_code is required")
+		}
+		if err := s.checkSecondFactor(u, req.GetTotpCode(), ""); err != nil {
+			return nil, err
+		}
+	}
+
+	now := s.now()
+	expires := now.Add(reauthTTL)
+	token := s.signer.sign(Claims{
+		Subject: u.id, Type: tokenReauth, SessionID: c.SessionID, ID: randomID(),
+		IssuedAt: now.Unix(), ExpiresAt: expires.Unix(),
+	})
+	return &authpb.ReauthenticateResponse{ReauthToken: token, ExpiresAt: timestamppb.New(expires)}, nil
+}
+
+// VerifyReauth implements reauth.Verifier. Step-up tokens die with the
+// session they were issued in.
+func (s *AuthServer) VerifyReauth(_ context.Context, token string) (reauth.Grant, error) {
+	c, err := s.verify(token, tokenReauth)
+	if err != nil {
+		return reauth.Grant{}, err
+	}
+	return reauth.Grant{Subject: c.Subject, AuthTime: time.Unix(c.IssuedAt, 0)}, nil
+}
+
+// checkSecondFactor accepts a TOTP code or consumes a recovery code.
+func (s *AuthServer) checkSecondFactor(u *user, code, recovery string) error {
+	if code != "" {
+		counter, err := s.totp.Validate(u.totpSecret, code, u.totpCounter)
+		if err == nil {
+			u.totpCounter = counter
+			return nil
+		}
+	}
+	if recovery != "" {
+		if i := totp.MatchRecoveryCode(u.recoveryHash, recovery); i >= 0 {
+			u.recoveryHash = slices.Delete(u.recoveryHash, i, i+1)
+			return nil
+		}
+	}
+	return apierrors.New(codes.Unauthenticated, apierrors.ReasonInvalidMFACode, "invalid MFA code")
+}
+
+func (s *AuthServer) StartOAuthLogin(_ context.Context, req *authpb.StartOAuthLoginRequest) (*authpb.StartOAuthLoginResponse, error) {
+	p, err := s.OAuth.Get(req.GetProvider())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	start, err := oauth.Begin(p, req.GetRedirectUri())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.mu.Lock()
+	s.oauthStates[start.State] = oauthState{provider: p.Name(), expires: s.now().Add(10 * time.Minute)}
+	s.mu.Unlock()
+
+	return &authpb.StartOAuthLoginResponse{
+		AuthorizeUrl: start.URL,
+		State:        start.State,
+		CodeVerifier: start.CodeVerifier,
+	}, nil
+}
+
+func (s *AuthServer) CompleteOAuthLogin(ctx context.Context, req *authpb.CompleteOAuthLoginRequest) (*authpb.CompleteOAuthLoginResponse, error) {
+	s.mu.Lock()
+	st, ok := s.oauthStates[req.GetState()]
+	delete(s.oauthStates, req.GetState())
+	s.mu.Unlock()
+
+	if !ok || st.provider != req.GetProvider() || !s.now().Before(st.expires) {
+		return nil, apierrors.New(codes.InvalidArgument, apierrors.ReasonTokenInvalid, "invalid or expired state")
+	}
+	p, err := s.OAuth.Get(req.GetProvider())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	id, err := p.Exchange(ctx, req.GetCode(), req.GetCodeVerifier(), req.GetRedirectUri())
+	if err != nil {
+		return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonInvalidCredentials, err.Error())
+	}
+
+	defer s.flush(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, created := s.linkIdentity(id)
+	if u == nil {
+		return nil, apierrors.New(codes.FailedPrecondition, apierrors.ReasonEmailNotVerified,
+			"provider email is not verified and cannot be linked")
+	}
+	if err := canSignIn(u); err != nil {
+		return nil, err
+	}
+	if u.totpSecret != "" {
+		return &authpb.CompleteOAuthLoginResponse{MfaChallenge: s.mfaChallenge(u), Created: created}, nil
+	}
+	return &authpb.CompleteOAuthLoginResponse{Tokens: s.issuePair(u), Created: created}, nil
+}
+
+// linkIdentity finds the user linked to id, links it to an existing account
+// with the same verified email, or registers a new account.
+func (s *AuthServer) linkIdentity(id *oauth.Identity) (*user, bool) {
+	for _, u := range s.users {
+		for _, li := range u.identities {
+			if li.GetProvider() == id.Provider && li.GetSubject() == id.Subject {
+				return u, false
+			}
+		}
+	}
+
+	link := &authpb.LinkedIdentity{
+		Provider: id.Provider,
+		Subject:  id.Subject,
+		Email:    id.Email,
+		LinkedAt: timestamppb.New(s.now()),
+	}
+	if u, ok := s.userByEmail(id.Email); ok {
+		if !id.EmailVerified {
+			return nil, false
+		}
+		u.identities = append(u.identities, link)
+		return u, false
+	}
+
+	u := s.addUser(id.Name, id.Email, randomID(), false)
+	u.emailVerified = id.EmailVerified
+	u.identities = append(u.identities, link)
+	s.emit(&authpb.UserRegistered{
+		UserId: &authpb.UUID{Value: u.id}, Name: u.name, Email: u.email, Provider: id.Provider,
+	})
+	return u, true
+}
+
+// Authenticated user endpoints
+
+func (s *AuthServer) Logout(_ context.Context, req *authpb.LogoutRequest) (*authpb.LogoutResponse, error) {
+	c, err := s.verify(req.GetRefreshToken(), tokenRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	delete(s.sessions, c.SessionID)
+	s.mu.Unlock()
+
+	return &authpb.LogoutResponse{Status: okStatus("logged out")}, nil
+}
+
+// ChangePassword ends the user's other sessions. The session making the
+// change stays signed in.
+func (s *AuthServer) ChangePassword(ctx context.Context, req *authpb.ChangePasswordRequest) (*authpb.ChangePasswordResponse, error) {
+	if len(req.GetNewPassword()) < 8 {
+		return nil, apierrors.Validation("invalid password",
+			apierrors.FieldViolation{Field: "new_password", Description: "must be at least 8 characters"})
+	}
+
+	defer s.flush(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.currentUser(ctx, req.GetAccessToken())
+	if err != nil {
+		return nil, err
+	}
+	if !u.checkPassword(req.GetOldPassword()) {
+		return nil, apierrors.New(codes.InvalidArgument, apierrors.ReasonInvalidCredentials, "old password is incorrect")
+	}
+	u.setPassword(req.GetNewPassword())
+	var current string
+	if c, ok := ClaimsFromContext(ctx); ok {
+		current = c.SessionID
+	}
+	s.endOtherSessions(u.id, current)
+	s.emit(&authpb.PasswordChanged{
+		UserId: &authpb.UUID{Value: u.id}, Method: authpb.PasswordChangeMethod_PASSWORD_CHANGE_METHOD_CHANGE,
+	})
+	return &authpb.ChangePasswordResponse{Status: okStatus("password changed")}, nil
+}
+
+func (s *AuthServer) DeleteAccount(ctx context.Context, req *authpb.DeleteAccountRequest) (*authpb.Status, error) {
+	defer s.flush(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.currentUser(ctx, req.GetAccessToken())
+	if err != nil {
+		return nil, err
+	}
+	if !u.checkPassword(req.GetPassword()) {
+		return nil, apierrors.New(codes.InvalidArgument, apierrors.ReasonInvalidCredentials, "password is incorrect")
+	}
+	s.softDelete(u, "")
+	return okStatus("account scheduled for deletion"), nil
+}
+
+// softDelete marks the account deleted until the grace period ends. actor is
+// the admin who deleted it, or empty for self-service deletion. s.mu must be
+// held.
+func (s *AuthServer) softDelete(u *user, actor string) {
+	previous := u.status()
+	u.deletedAt = s.now()
+	u.purgeAt = u.deletedAt.Add(s.opts.DeletionGracePeriod)
+	u.deletedByAdmin = actor != ""
+	s.endSessions(u.id)
+
+	changed := &authpb.UserStatusChanged{
+		UserId: &authpb.UUID{Value: u.id}, PreviousStatus: previous, Status: u.status(),
+	}
+	if actor != "" {
+		changed.ChangedBy = &authpb.UUID{Value: actor}
+	}
+	s.emit(changed)
+}
+
+// restore cancels a pending deletion. s.mu must be held.
+func (s *AuthServer) restore(u *user, actor string) {
+	u.deletedAt, u.purgeAt, u.deletedByAdmin = time.Time{}, time.Time{}, false
+
+	changed := &authpb.UserStatusChanged{
+		UserId: &authpb.UUID{Value: u.id}, PreviousStatus: authpb.UserStatus_USER_STATUS_DELETED, Status: u.status(),
+	}
+	if actor != "" {
+		changed.ChangedBy = &authpb.UUID{Value: actor}
+	}
+	s.emit(changed)
+}
+
+func errNotDeleted() error {
+	return apierrors.New(codes.FailedPrecondition, apierrors.ReasonAccountNotDeleted, "account is not pending deletion")
+}
+
+func (s *AuthServer) RestoreAccount(ctx context.Context, req *authpb.RestoreAccountRequest) (*authpb.UserResponse, error) {
+	defer s.flush(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.userByEmail(req.GetEmail())
+	if !ok || !u.checkPassword(req.GetPassword()) {
+		return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonInvalidCredentials, "invalid email or password")
+	}
+	if !u.deleted() {
+		return nil, errNotDeleted()
+	}
+	if u.deletedByAdmin {
+		return nil, apierrors.New(codes.PermissionDenied, apierrors.ReasonPermissionDenied,
+			"account was deleted by an admin and can only be restored by an admin")
+	}
+	s.restore(u, "")
+	return u.toProto(), nil
+}
+
+func (s *AuthServer) ExportMyData(ctx context.Context, _ *authpb.ExportMyDataRequest) (*httpbody.HttpBody, error) {
+	id, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := s.Export.Build(ctx, id, s.now())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "build export: %v", err)
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs("content-disposition",
+		fmt.Sprintf(`attachment; filename="export-%s.zip"`, id)))
+	return &httpbody.HttpBody{ContentType: export.ContentType, Data: archive}, nil
+}
+
+// exportAccount implements the account.json document of ExportMyData.
+func (s *AuthServer) exportAccount(_ context.Context, userID string) (proto.Message, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	u, ok := s.users[userID]
+	if !ok {
+		return nil, apierrors.New(codes.NotFound, apierrors.ReasonUserNotFound, "user not found")
+	}
+	doc := &authpb.AccountExport{Profile: u.toProto()}
+	for sid, sess := range s.sessions {
+		if sess.userID == userID {
+			doc.Sessions = append(doc.Sessions, &authpb.SessionInfo{SessionId: sid, CreatedAt: timestamppb.New(sess.createdAt)})
+		}
+	}
+	sort.Slice(doc.Sessions, func(i, j int) bool {
+		return doc.Sessions[i].GetCreatedAt().AsTime().Before(doc.Sessions[j].GetCreatedAt().AsTime())
+	})
+	return doc, nil
+}
+
+func (s *AuthServer) UpdateProfile(ctx context.Context, req *authpb.UpdateProfileRequest) (*authpb.UserResponse, error) {
+	defer s.flush(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.currentUser(ctx, req.GetAccessToken())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.updateProfile(u, req.GetName(), req.GetEmail()); err != nil {
+		return nil, err
+	}
+	return u.toProto(), nil
+}
+
+// updateProfile applies non-empty name and email changes and emits
+// UserUpdated when anything changed.
+func (s *AuthServer) updateProfile(u *user, name, email string) error {
+	oldName, oldEmail := u.name, u.email
+	if name != "" {
+		u.name = name
+	}
+	if err := s.changeEmail(u, email); err != nil {
+		u.name = oldName
+		return err
+	}
+	if u.name == oldName && u.email == oldEmail {
+		return nil
+	}
+
+	ev := &authpb.UserUpdated{UserId: &authpb.UUID{Value: u.id}, Name: u.name, Email: u.email}
+	if u.email != oldEmail {
+		ev.PreviousEmail = oldEmail
+	}
+	s.emit(ev)
+	return nil
+}
+
+func (s *AuthServer) changeEmail(u *user, email string) error {
+	email = strings.ToLower(email)
+	if email == "" || email == u.email {
+		return nil
+	}
+	if _, taken := s.userByEmail(email); taken {
+		return apierrors.New(codes.AlreadyExists, apierrors.ReasonUserAlreadyExists, "email already in use")
+	}
+	delete(s.byEmail, u.email)
+	u.email = email
+	u.emailVerified = false
+	s.byEmail[email] = u.id
+	return nil
+}
+
+func (s *AuthServer) EnrollTOTP(ctx context.Context, _ *authpb.EnrollTOTPRequest) (*authpb.EnrollTOTPResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.currentUser(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if u.totpSecret != "" {
+		return nil, status.Error(codes.AlreadyExists, "TOTP already enabled")
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	u.totpPending = secret
+	return &authpb.EnrollTOTPResponse{Secret: secret, ProvisioningUri: s.totp.ProvisioningURI(u.email, secret)}, nil
+}
+
+func (s *AuthServer) ConfirmTOTP(ctx context.Context, req *authpb.ConfirmTOTPRequest) (*authpb.ConfirmTOTPResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.currentUser(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if u.totpPending == "" {
+		return nil, status.Error(codes.FailedPrecondition, "TOTP enrollment not started")
+	}
+	counter, err := s.totp.Validate(u.totpPending, req.GetCode(), 0)
+	if err != nil {
+		return nil, apierrors.New(codes.InvalidArgument, apierrors.ReasonInvalidMFACode, "invalid code")
+	}
+	recovery, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	u.totpSecret, u.totpPending, u.totpCounter, u.recoveryHash = u.totpPending, "", counter, hashes
+	return &authpb.ConfirmTOTPResponse{Status: okStatus("TOTP enabled"), RecoveryCodes: recovery}, nil
+}
+
+func newRecoveryCodes() ([]string, []string, error) {
+	recovery, err := totp.GenerateRecoveryCodes(recoveryCodes)
+	if err != nil {
+		return nil, nil, err
+	}
+	hashes := make([]string, len(recovery))
+	for i, c := range recovery {
+		hashes[i] = totp.HashRecoveryCode(c)
+	}
+	return recovery, hashes, nil
+}
+
+func (s *AuthServer) DisableTOTP(ctx context.Context, req *authpb.DisableTOTPRequest) (*authpb.DisableTOTPResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.currentUser(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if u.totpSecret == "" {
+		return nil, status.Error(codes.FailedPrecondition, "TOTP is not enabled")
+	}
+	if !u.checkPassword(req.GetPassword()) {
+		return nil, apierrors.New(codes.InvalidArgument, apierrors.ReasonInvalidCredentials, "password is incorrect")
+	}
+	if err := s.checkSecondFactor(u, req.GetCode(), req.GetCode()); err != nil {
+		return nil, err
+	}
+
+	u.totpSecret, u.totpCounter, u.recoveryHash = "", 0, nil
+	return &authpb.DisableTOTPResponse{Status: okStatus("TOTP disabled")}, nil
+}
+
+func (s *AuthServer) RegenerateRecoveryCodes(ctx context.Context, req *authpb.RegenerateRecoveryCodesRequest) (*authpb.RegenerateRecoveryCodesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, err := s.currentUser(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if u.totpSecret == "" {
+		return nil, status.Error(codes.FailedPrecondition, "TOTP is not enabled")
+	}
+	if err := s.checkSecondFactor(u, req.GetCode(), ""); err != nil {
+		return nil, err
+	}
+	recovery, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	u.recoveryHash = hashes
+	return &authpb.RegenerateRecoveryCodesResponse{RecoveryCodes: recovery}, nil
+}
+
+// Token validation
+
+func (s *AuthServer) ValidateToken(_ context.Context, req *authpb.ValidateTokenRequest) (*authpb.ValidateTokenResponse, error) {
+	c, err := s.verify(req.GetToken(), tokenAccess)
+	if err != nil {
+		return &authpb.ValidateTokenResponse{IsValid: false}, nil
+	}
+
+	resp := &authpb.ValidateTokenResponse{
+		IsValid:     true,
+		ExpiresAt:   timestamppb.New(time.Unix(c.ExpiresAt, 0)),
+		IsAdmin:     c.Admin,
+		SubjectType: authpb.SubjectType_SUBJECT_TYPE_USER,
+		Scopes:      c.Scopes,
+	}
+	if c.Service {
+		resp.SubjectType = authpb.SubjectType_SUBJECT_TYPE_SERVICE
+		resp.ClientId = c.ClientID
+	} else {
+		resp.UserId = &authpb.UUID{Value: c.Subject}
+	}
+	return resp, nil
+}
+
+func (s *AuthServer) IntrospectToken(_ context.Context, req *authpb.IntrospectTokenRequest) (*authpb.IntrospectTokenResponse, error) {
+	c, err := s.signer.parse(req.GetToken(), s.now())
+	if err != nil || (c.Type != tokenAccess && c.Type != tokenRefresh) {
+		return &authpb.IntrospectTokenResponse{Active: false}, nil
+	}
+	if _, err := s.verify(req.GetToken(), c.Type); err != nil {
+		return &authpb.IntrospectTokenResponse{Active: false}, nil
+	}
+
+	resp := &authpb.IntrospectTokenResponse{
+		Active:      true,
+		Subject:     c.Subject,
+		SubjectType: authpb.SubjectType_SUBJECT_TYPE_USER,
+		TokenType:   authpb.TokenType_TOKEN_TYPE_ACCESS,
+		Scopes:      c.Scopes,
+		SessionId:   c.SessionID,
+		ClientId:    c.ClientID,
+		IsAdmin:     c.Admin,
+		IssuedAt:    timestamppb.New(time.Unix(c.IssuedAt, 0)),
+		ExpiresAt:   timestamppb.New(time.Unix(c.ExpiresAt, 0)),
+		Issuer:      c.Issuer,
+		TokenId:     c.ID,
+	}
+	if c.Type == tokenRefresh {
+		resp.TokenType = authpb.TokenType_TOKEN_TYPE_REFRESH
+	}
+	if c.Service {
+		resp.SubjectType = authpb.SubjectType_SUBJECT_TYPE_SERVICE
+	}
+	return resp, nil
+}
+
+// Admin endpoints
+
+func (s *AuthServer) CreateUser(ctx context.Context, req *authpb.CreateUserRequest) (*authpb.UserResponse, error) {
+	if err := validateCredentials(req.GetEmail(), req.GetPassword()); err != nil {
+		return nil, err
+	}
+
+	defer s.flush(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.userByEmail(req.GetEmail()); exists {
+		return nil, apierrors.New(codes.AlreadyExists, apierrors.ReasonUserAlreadyExists, "user already exists")
+	}
+	u := s.addUser(req.GetName(), req.GetEmail(), req.GetPassword(), req.GetIsAdmin())
+	u.emailVerified = true
+	s.emit(&authpb.UserRegistered{UserId: &authpb.UUID{Value: u.id}, Name: u.name, Email: u.email})
+	return u.toProto(), nil
+}
+
+func (s *AuthServer) GetUser(_ context.Context, req *authpb.GetUserRequest) (*authpb.UserResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	u, ok := s.users[req.GetUserId()]
+	if !ok {
+		return nil, apierrors.New(codes.NotFound, apierrors.ReasonUserNotFound, "user not found")
+	}
+	return u.toProto(), nil
+}
+
+func (s *AuthServer) ListUsers(_ context.Context, req *authpb.ListUsersRequest) (*authpb.ListUsersResponse, error) {
+	page, limit := req.GetPage(), req.GetLimit()
+	if page < 0 {
+		return nil, apierrors.Validation("invalid page",
+			apierrors.FieldViolation{Field: "page", Description: "must be a positive page number"})
+	}
+	if page == 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	after, before := req.GetCreatedAfter(), req.GetCreatedBefore()
+	if after != nil && before != nil && !after.AsTime().Before(before.AsTime()) {
+		return nil, apierrors.Validation("invalid created-at range",
+			apierrors.FieldViolation{Field: "created_before", Description: "must be after created_after"})
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	all := make([]*user, 0, len(s.users))
+	for _, u := range s.users {
+		if matchUser(req, u) {
+			all = append(all, u)
+		}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if !all[i].createdAt.Equal(all[j].createdAt) {
+			return all[i].createdAt.Before(all[j].createdAt)
+		}
+		return all[i].id < all[j].id
+	})
+
+	resp := &authpb.ListUsersResponse{Total: int32(len(all)), Page: page}
+	// In int64: (page-1)*limit overflows int32 for very large pages.
+	start := (int64(page) - 1) * int64(limit)
+	if start >= int64(len(all)) {
+		return resp, nil
+	}
+	for _, u := range all[start:min(start+int64(limit), int64(len(all)))] {
+		resp.Users = append(resp.Users, u.toProto())
+	}
+	return resp, nil
+}
+
+func matchUser(req *authpb.ListUsersRequest, u *user) bool {
+	if !hasPrefixFold(u.email, req.GetEmailPrefix()) || !hasPrefixFold(u.name, req.GetNamePrefix()) {
+		return false
+	}
+	switch req.GetRole() {
+	case authpb.UserRole_USER_ROLE_USER:
+		if u.admin {
+			return false
+		}
+	case authpb.UserRole_USER_ROLE_ADMIN:
+		if !u.admin {
+			return false
+		}
+	}
+	if t := req.GetCreatedAfter(); t != nil && u.createdAt.Before(t.AsTime()) {
+		return false
+	}
+	if t := req.GetCreatedBefore(); t != nil && !u.createdAt.Before(t.AsTime()) {
+		return false
+	}
+	return req.GetStatus() == authpb.UserStatus_USER_STATUS_UNSPECIFIED || req.GetStatus() == u.status()
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return strings.HasPrefix(strings.ToLower(s), strings.ToLower(prefix))
+}
+
+func (s *AuthServer) UpdateUser(ctx context.Context, req *authpb.UpdateUserRequest) (*authpb.UserResponse, error) {
+	defer s.flush(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[req.GetUserId()]
+	if !ok {
+		return nil, apierrors.New(codes.NotFound, apierrors.ReasonUserNotFound, "user not found")
+	}
+	if err := s.updateProfile(u, req.GetName(), req.GetEmail()); err != nil {
+		return nil, err
+	}
+	if req.GetPassword() != "" {
+		u.setPassword(req.GetPassword())
+		s.endSessions(u.id)
+		s.emit(&authpb.PasswordChanged{
+			UserId: &authpb.UUID{Value: u.id}, Method: authpb.PasswordChangeMethod_PASSWORD_CHANGE_METHOD_ADMIN,
+		})
+	}
+	if req.IsAdmin != nil && u.admin != req.GetIsAdmin() {
+		u.admin = req.GetIsAdmin()
+		admin, _ := callerID(ctx)
+		s.emit(&authpb.UserRoleChanged{
+			UserId: &authpb.UUID{Value: u.id}, IsAdmin: u.admin, ChangedBy: &authpb.UUID{Value: admin},
+		})
+	}
+	return u.toProto(), nil
+}
+
+func (s *AuthServer) DeleteUser(ctx context.Context, req *authpb.DeleteRequest) (*authpb.DeleteResponse, error) {
+	defer s.flush(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[req.GetUserId()]
+	if !ok {
+		return nil, apierrors.New(codes.NotFound, apierrors.ReasonUserNotFound, "user not found")
+	}
+	if u.deleted() {
+		return nil, apierrors.New(codes.FailedPrecondition, apierrors.ReasonAccountDeleted, "user is already scheduled for deletion")
+	}
+	admin, _ := callerID(ctx)
+	s.softDelete(u, admin)
+	return &authpb.DeleteResponse{Status: okStatus("user scheduled for deletion"), PurgeAt: timestamppb.New(u.purgeAt)}, nil
+}
+
+func (s *AuthServer) RestoreUser(ctx context.Context, req *authpb.RestoreUserRequest) (*authpb.UserResponse, error) {
+	admin, _ := callerID(ctx)
+
+	defer s.flush(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[req.GetUserId()]
+	if !ok {
+		return nil, apierrors.New(codes.NotFound, apierrors.ReasonUserNotFound, "user not found")
+	}
+	if !u.deleted() {
+		return nil, errNotDeleted()
+	}
+	s.restore(u, admin)
+	return u.toProto(), nil
+}
+
+// PurgeDeletedAccounts removes accounts whose grace period has ended. Only
+// then is UserDeleted published, so consumers can erase their copies.
+func (s *AuthServer) PurgeDeletedAccounts(ctx context.Context, _ *authpb.PurgeDeletedAccountsRequest) (*authpb.PurgeDeletedAccountsResponse, error) {
+	defer s.flush(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	var due []*user
+	for _, u := range s.users {
+		if u.deleted() && !now.Before(u.purgeAt) {
+			due = append(due, u)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].id < due[j].id })
+
+	resp := &authpb.PurgeDeletedAccountsResponse{}
+	for _, u := range due {
+		s.removeUser(u)
+		s.emit(&authpb.UserDeleted{UserId: &authpb.UUID{Value: u.id}, ByAdmin: u.deletedByAdmin})
+		resp.UserIds = append(resp.UserIds, &authpb.UUID{Value: u.id})
+	}
+	return resp, nil
+}
+
+func (s *AuthServer) SuspendUser(ctx context.Context, req *authpb.SuspendUserRequest) (*authpb.UserResponse, error) {
+	admin, _ := callerID(ctx)
+	if req.GetUserId() == admin {
+		return nil, apierrors.Validation("invalid user",
+			apierrors.FieldViolation{Field: "user_id", Description: "admins cannot suspend themselves"})
+	}
+
+	defer s.flush(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[req.GetUserId()]
+	if !ok {
+		return nil, apierrors.New(codes.NotFound, apierrors.ReasonUserNotFound, "user not found")
+	}
+	if u.suspended {
+		return u.toProto(), nil
+	}
+	previous := u.status()
+	u.suspended, u.suspensionReason = true, req.GetReason()
+	s.endSessions(u.id)
+	s.emit(&authpb.UserStatusChanged{
+		UserId: &authpb.UUID{Value: u.id}, PreviousStatus: previous, Status: u.status(),
+		ChangedBy: &authpb.UUID{Value: admin}, Reason: req.GetReason(),
+	})
+	return u.toProto(), nil
+}
+
+func (s *AuthServer) ReactivateUser(ctx context.Context, req *authpb.ReactivateUserRequest) (*authpb.UserResponse, error) {
+	admin, _ := callerID(ctx)
+
+	defer s.flush(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[req.GetUserId()]
+	if !ok {
+		return nil, apierrors.New(codes.NotFound, apierrors.ReasonUserNotFound, "user not found")
+	}
+	if !u.suspended {
+		return u.toProto(), nil
+	}
+	u.suspended, u.suspensionReason = false, ""
+	s.emit(&authpb.UserStatusChanged{
+		UserId: &authpb.UUID{Value: u.id}, PreviousStatus: authpb.UserStatus_USER_STATUS_SUSPENDED, Status: u.status(),
+		ChangedBy: &authpb.UUID{Value: admin},
+	})
+	return u.toProto(), nil
+}
+
+func (s *AuthServer) ForceLogout(_ context.Context, req *authpb.ForceLogoutRequest) (*authpb.ForceLogoutResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[req.GetUserId()]; !ok {
+		return nil, apierrors.New(codes.NotFound, apierrors.ReasonUserNotFound, "user not found")
+	}
+	n := s.endSessions(req.GetUserId())
+	return &authpb.ForceLogoutResponse{Status: okStatus("sessions invalidated"), SessionsRevoked: int32(n)}, nil
+}
+
+// Service accounts
+
+func (s *AuthServer) CreateServiceAccount(_ context.Context, req *authpb.CreateServiceAccountRequest) (*authpb.ServiceAccountCredentials, error) {
+	if req.GetName() == "" {
+		return nil, apierrors.Validation("invalid service account",
+			apierrors.FieldViolation{Field: "name", Description: "is required"})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sa := range s.accounts {
+		if sa.account.GetName() == req.GetName() && !sa.account.GetRevoked() {
+			return nil, status.Error(codes.AlreadyExists, "service account already exists")
+		}
+	}
+
+	secret := randomID()
+	sa := &serviceAccount{
+		account: &authpb.ServiceAccount{
+			ClientId:  newUUID(),
+			Name:      req.GetName(),
+			Scopes:    req.GetScopes(),
+			CreatedAt: timestamppb.New(s.now()),
+		},
+		secretHash: hashSecret(secret),
+	}
+	s.accounts[sa.account.GetClientId()] = sa
+	return &authpb.ServiceAccountCredentials{Account: sa.account, ClientSecret: secret}, nil
+}
+
+func (s *AuthServer) IssueClientCredentialsToken(_ context.Context, req *authpb.ClientCredentialsRequest) (*authpb.ClientCredentialsResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sa, ok := s.accounts[req.GetClientId()]
+	if !ok || sa.account.GetRevoked() || !sa.checkSecret(req.GetClientSecret(), s.now()) {
+		return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonInvalidCredentials, "invalid client credentials")
+	}
+
+	scopes := req.GetScopes()
+	if len(scopes) == 0 {
+		scopes = sa.account.GetScopes()
+	}
+	for _, sc := range scopes {
+		if !slices.Contains(sa.account.GetScopes(), sc) {
+			return nil, apierrors.New(codes.PermissionDenied, apierrors.ReasonPermissionDenied, "scope not granted: "+sc)
+		}
+	}
+
+	now := s.now()
+	expires := now.Add(s.opts.AccessTTL)
+	token := s.signer.sign(Claims{
+		Subject:   sa.account.GetClientId(),
+		Type:      tokenAccess,
+		Service:   true,
+		ClientID:  sa.account.GetClientId(),
+		Scopes:    scopes,
+		ID:        randomID(),
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expires.Unix(),
+	})
+	return &authpb.ClientCredentialsResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresAt:   timestamppb.New(expires),
+		Scopes:      scopes,
+	}, nil
+}
+
+func (sa *serviceAccount) checkSecret(secret string, now time.Time) bool {
+	h := hashSecret(secret)
+	if subtle.ConstantTimeCompare([]byte(h), []byte(sa.secretHash)) == 1 {
+		return true
+	}
+	return sa.prevSecretHash != "" && now.Before(sa.prevValidUntil) &&
+		subtle.ConstantTimeCompare([]byte(h), []byte(sa.prevSecretHash)) == 1
+}
+
+func (s *AuthServer) RotateServiceAccountKey(_ context.Context, req *authpb.RotateServiceAccountKeyRequest) (*authpb.ServiceAccountCredentials, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sa, ok := s.accounts[req.GetClientId()]
+	if !ok || sa.account.GetRevoked() {
+		return nil, status.Error(codes.NotFound, "service account not found")
+	}
+
+	secret := randomID()
+	now := s.now()
+	sa.prevSecretHash = sa.secretHash
+	sa.prevValidUntil = now.Add(time.Duration(req.GetGracePeriodSeconds()) * time.Second)
+	sa.secretHash = hashSecret(secret)
+	sa.account.KeyRotatedAt = timestamppb.New(now)
+	return &authpb.ServiceAccountCredentials{Account: sa.account, ClientSecret: secret}, nil
+}
+
+func (s *AuthServer) RevokeServiceAccount(_ context.Context, req *authpb.RevokeServiceAccountRequest) (*authpb.RevokeServiceAccountResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sa, ok := s.accounts[req.GetClientId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "service account not found")
+	}
+	sa.account.Revoked = true
+	return &authpb.RevokeServiceAccountResponse{Status: okStatus("service account revoked")}, nil
+}
+
+func (s *AuthServer) ListAuditEntries(ctx context.Context, req *authpb.ListAuditEntriesRequest) (*authpb.ListAuditEntriesResponse, error) {
+	return s.Audit.List(ctx, req)
+}
+
+// AuditRecorder records admin calls into Audit. Start installs its
+// interceptors ahead of the auth interceptors, so denied calls are recorded
+// with the caller of their bearer token, if valid.
+func (s *AuthServer) AuditRecorder() *audit.Recorder {
+	r := audit.NewRecorder(s.Audit, s.auditActor)
+	r.Now = s.opts.Clock
+	return r
+}
+
+// ReauthGuard enforces require_recent_auth with step-up tokens from
+// Reauthenticate. Start installs its interceptor after the auth interceptor.
+// Sending the caller's current email is not a change and needs no step-up.
+func (s *AuthServer) ReauthGuard() *reauth.Guard {
+	g := reauth.NewGuard(s, func(ctx context.Context) (string, bool) {
+		id, err := callerID(ctx)
+		return id, err == nil
+	})
+	g.Unchanged = s.unchangedField
+	g.Now = s.opts.Clock
+	return g
+}
+
+func (s *AuthServer) unchangedField(ctx context.Context, fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+	if fd.Name() != "email" {
+		return false
+	}
+	id, err := callerID(ctx)
+	if err != nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, ok := s.users[id]
+	return ok && strings.EqualFold(u.email, v.String())
+}
+
+func (s *AuthServer) auditActor(ctx context.Context) (audit.Actor, bool) {
+	c, ok := ClaimsFromContext(ctx)
+	if !ok {
+		var err error
+		if c, err = s.bearerClaims(ctx); err != nil {
+			return audit.Actor{}, false
+		}
+	}
+	if c.Service {
+		return audit.Actor{ID: c.ClientID, Type: authpb.SubjectType_SUBJECT_TYPE_SERVICE}, true
+	}
+	return audit.Actor{ID: c.Subject, Type: authpb.SubjectType_SUBJECT_TYPE_USER}, true
+}
+
+func (s *AuthServer) StreamUserEvents(req *authpb.StreamUserEventsRequest, stream authpb.Auth_StreamUserEventsServer) error {
+	return userevents.Serve(req, stream, s.Events)
+}
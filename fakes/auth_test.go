@@ -0,0 +1,154 @@
+package fakes_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/auth/mailer"
+	"github.com/JunBSer/services_proto/auth/reauth"
+	"github.com/JunBSer/services_proto/fakes"
+)
+
+func login(t *testing.T, env *fakes.Env, email, password string) *authpb.JWTPair {
+	t.Helper()
+
+	resp, err := env.AuthClient.Login(context.Background(), &authpb.LoginRequest{Email: email, Password: password})
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	return resp.GetTokens()
+}
+
+func refresh(env *fakes.Env, pair *authpb.JWTPair) error {
+	_, err := env.AuthClient.RefreshToken(context.Background(), &authpb.RefreshRequest{RefreshToken: pair.GetRefreshToken()})
+	return err
+}
+
+func TestPasswordChangesEndSessions(t *testing.T) {
+	const email, password = "user@example.com", "user-password"
+
+	tests := []struct {
+		name string
+		// change changes the password through the session current.
+		change      func(t *testing.T, env *fakes.Env, current *authpb.JWTPair)
+		wantCurrent codes.Code
+	}{
+		{
+			name: "ChangePassword keeps the current session",
+			change: func(t *testing.T, env *fakes.Env, current *authpb.JWTPair) {
+				ctx := fakes.WithToken(context.Background(), current.GetAccessToken())
+				ra, err := env.AuthClient.Reauthenticate(ctx, &authpb.ReauthenticateRequest{Password: password})
+				if err != nil {
+					t.Fatalf("Reauthenticate: %v", err)
+				}
+				_, err = env.AuthClient.ChangePassword(reauth.WithToken(ctx, ra.GetReauthToken()),
+					&authpb.ChangePasswordRequest{OldPassword: password, NewPassword: "new-" + password})
+				if err != nil {
+					t.Fatalf("ChangePassword: %v", err)
+				}
+			},
+			wantCurrent: codes.OK,
+		},
+		{
+			name: "ResetPassword ends every session",
+			change: func(t *testing.T, env *fakes.Env, _ *authpb.JWTPair) {
+				_, err := env.AuthClient.RequestPasswordReset(context.Background(), &authpb.RequestPasswordResetRequest{Email: email})
+				if err != nil {
+					t.Fatalf("RequestPasswordReset: %v", err)
+				}
+				msg, ok := env.Auth.Mailer().(*mailer.MemoryMailer).Last(email, mailer.KindPasswordReset)
+				if !ok {
+					t.Fatal("no password reset email")
+				}
+				_, err = env.AuthClient.ResetPassword(context.Background(),
+					&authpb.ResetPasswordRequest{Token: msg.Token, NewPassword: "new-" + password})
+				if err != nil {
+					t.Fatalf("ResetPassword: %v", err)
+				}
+			},
+			wantCurrent: codes.Unauthenticated,
+		},
+		{
+			name: "UpdateUser ends every session",
+			change: func(t *testing.T, env *fakes.Env, current *authpb.JWTPair) {
+				// Resolve the user before its sessions end.
+				v, err := env.AuthClient.ValidateToken(context.Background(),
+					&authpb.ValidateTokenRequest{Token: current.GetAccessToken()})
+				if err != nil {
+					t.Fatalf("ValidateToken: %v", err)
+				}
+				admin := fakes.WithToken(context.Background(), login(t, env, adminEmail, adminPassword).GetAccessToken())
+				_, err = env.AuthClient.UpdateUser(admin,
+					&authpb.UpdateUserRequest{UserId: v.GetUserId().GetValue(), Password: "new-" + password})
+				if err != nil {
+					t.Fatalf("UpdateUser: %v", err)
+				}
+			},
+			wantCurrent: codes.Unauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := fakes.MustStart(t, fakes.Options{})
+			principals(t, env)
+			current := login(t, env, email, password)
+			other := login(t, env, email, password)
+
+			tt.change(t, env, current)
+
+			if got := status.Code(refresh(env, other)); got != codes.Unauthenticated {
+				t.Errorf("refresh of the other session = %v, want Unauthenticated", got)
+			}
+			if got := status.Code(refresh(env, current)); got != tt.wantCurrent {
+				t.Errorf("refresh of the current session = %v, want %v", got, tt.wantCurrent)
+			}
+			if _, err := env.AuthClient.Login(context.Background(), &authpb.LoginRequest{Email: email, Password: "new-" + password}); err != nil {
+				t.Errorf("Login with the new password: %v", err)
+			}
+		})
+	}
+}
+
+// TestRefreshTokenRacesPurge refreshes sessions while their user is deleted
+// and purged. Every refresh must either succeed or fail cleanly.
+func TestRefreshTokenRacesPurge(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{DeletionGracePeriod: time.Nanosecond})
+	p := principals(t, env)
+	admin := fakes.WithToken(context.Background(), p.Admin.AccessToken)
+	service := fakes.WithToken(context.Background(), serviceToken(t, env, p.Admin))
+
+	var pairs []*authpb.JWTPair
+	for range 20 {
+		pairs = append(pairs, login(t, env, "user@example.com", "user-password"))
+	}
+
+	var wg sync.WaitGroup
+	for _, pair := range pairs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := refresh(env, pair); err != nil && status.Code(err) != codes.Unauthenticated {
+				t.Errorf("refresh = %v, want success or Unauthenticated", err)
+			}
+		}()
+	}
+	if _, err := env.AuthClient.DeleteUser(admin, &authpb.DeleteRequest{UserId: p.User.UserID}); err != nil {
+		t.Fatalf("DeleteUser: %v", err)
+	}
+	if _, err := env.AuthClient.PurgeDeletedAccounts(service, &authpb.PurgeDeletedAccountsRequest{}); err != nil {
+		t.Fatalf("PurgeDeletedAccounts: %v", err)
+	}
+	wg.Wait()
+
+	for _, pair := range pairs {
+		if got := status.Code(refresh(env, pair)); got != codes.Unauthenticated {
+			t.Errorf("refresh after purge = %v, want Unauthenticated", got)
+		}
+	}
+}
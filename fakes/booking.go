@@ -0,0 +1,285 @@
+package fakes
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	"github.com/JunBSer/services_proto/booking/events"
+	bookpb "github.com/JunBSer/services_proto/booking/gen/go"
+	"github.com/JunBSer/services_proto/hotel/pricing"
+)
+
+// BookingServer is an in-memory bookpb.BookingServiceServer. Bookings of the
+// same room may not overlap unless one of them is cancelled. Lifecycle events
+// are published on Events.
+type BookingServer struct {
+	bookpb.UnimplementedBookingServiceServer
+
+	Events *events.ChannelBus
+
+	now    Clock
+	hotels *HotelServer
+
+	mu       sync.RWMutex
+	bookings map[string]*bookpb.BookingDetails
+	order    []string
+}
+
+const eventSource = "/fakes/booking"
+
+func NewBookingServer(now Clock, hotels *HotelServer) *BookingServer {
+	if now == nil {
+		now = time.Now
+	}
+	return &BookingServer{
+		Events:   events.NewChannelBus(64),
+		now:      now,
+		hotels:   hotels,
+		bookings: make(map[string]*bookpb.BookingDetails),
+	}
+}
+
+func stayRange(start, end *timestamppb.Timestamp) (time.Time, time.Time, error) {
+	if start == nil || end == nil {
+		return time.Time{}, time.Time{}, apierrors.New(codes.InvalidArgument, apierrors.ReasonInvalidDateRange,
+			"end_date must be after start_date")
+	}
+	if err := pricing.ValidateStay(start.AsTime(), end.AsTime()); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start.AsTime(), end.AsTime(), nil
+}
+
+func active(b *bookpb.BookingDetails) bool {
+	return b.GetStatus() == bookpb.Status_CONFIRMED || b.GetStatus() == bookpb.Status_MODIFIED
+}
+
+func (s *BookingServer) overlaps(hotelID, roomID string, start, end time.Time) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.overlapsLocked(hotelID, roomID, start, end)
+}
+
+func (s *BookingServer) overlapsLocked(hotelID, roomID string, start, end time.Time) bool {
+	for _, b := range s.bookings {
+		if !active(b) || b.GetHotelId() != hotelID || b.GetRoomId() != roomID {
+			continue
+		}
+		if start.Before(b.GetEndDate().AsTime()) && b.GetStartDate().AsTime().Before(end) {
+			return true
+		}
+	}
+	return false
+}
+
+// owner resolves the user a request acts for. Admins may act for anyone;
+// other callers only for themselves.
+func owner(ctx context.Context, requested string) (string, error) {
+	caller, err := callerID(ctx)
+	if err != nil {
+		return "", err
+	}
+	if requested == "" || requested == caller || isAdmin(ctx) {
+		if requested == "" {
+			return caller, nil
+		}
+		return requested, nil
+	}
+	return "", apierrors.New(codes.PermissionDenied, apierrors.ReasonPermissionDenied, "cannot act for another user")
+}
+
+func (s *BookingServer) CreateBooking(ctx context.Context, req *bookpb.CreateBookingRequest) (*bookpb.BookingResponse, error) {
+	userID, err := owner(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := stayRange(req.GetStartDate(), req.GetEndDate())
+	if err != nil {
+		return nil, err
+	}
+	var price float64
+	if s.hotels != nil {
+		q, err := s.hotels.quote(req.GetHotelId(), req.GetRoomId(), req.GetRatePlanId(), start, end, s.now())
+		if err != nil {
+			return nil, err
+		}
+		price = q.Total
+	}
+
+	s.mu.Lock()
+	if s.overlapsLocked(req.GetHotelId(), req.GetRoomId(), start, end) {
+		s.mu.Unlock()
+		return nil, apierrors.New(codes.AlreadyExists, apierrors.ReasonBookingConflict,
+			"room is already booked for the requested dates")
+	}
+
+	now := timestamppb.New(s.now())
+	b := &bookpb.BookingDetails{
+		BookingId:  newUUID(),
+		RoomId:     req.GetRoomId(),
+		HotelId:    req.GetHotelId(),
+		UserId:     userID,
+		Status:     bookpb.Status_CONFIRMED,
+		StartDate:  req.GetStartDate(),
+		EndDate:    req.GetEndDate(),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		RatePlanId: req.GetRatePlanId(),
+		TotalPrice: price,
+	}
+	s.bookings[b.GetBookingId()] = b
+	s.order = append(s.order, b.GetBookingId())
+	created := &bookpb.BookingCreated{Booking: proto.Clone(b).(*bookpb.BookingDetails)}
+	s.mu.Unlock()
+
+	s.publish(ctx, created)
+	return &bookpb.BookingResponse{BookingId: b.GetBookingId(), Status: bookpb.Status_CONFIRMED, CreatedAt: now}, nil
+}
+
+// publish delivers lifecycle events. Failures are ignored: the fake has no
+// outbox, and a closed bus only means nobody is listening any more.
+func (s *BookingServer) publish(ctx context.Context, payloads ...proto.Message) {
+	for _, p := range payloads {
+		e, err := events.New(eventSource, p, s.now())
+		if err != nil {
+			panic(err)
+		}
+		_ = s.Events.Publish(ctx, e)
+	}
+}
+
+func (s *BookingServer) lookup(ctx context.Context, userID, bookingID string) (*bookpb.BookingDetails, error) {
+	uid, err := owner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	b, ok := s.bookings[bookingID]
+	if !ok || (b.GetUserId() != uid && !isAdmin(ctx)) {
+		return nil, apierrors.New(codes.NotFound, apierrors.ReasonBookingNotFound, "booking not found")
+	}
+	return b, nil
+}
+
+func (s *BookingServer) GetBooking(ctx context.Context, req *bookpb.GetBookingRequest) (*bookpb.BookingDetails, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	b, err := s.lookup(ctx, req.GetUserId(), req.GetBookingId())
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(b).(*bookpb.BookingDetails), nil
+}
+
+func (s *BookingServer) CancelBooking(ctx context.Context, req *bookpb.CancelBookingRequest) (*bookpb.CancelBookingResponse, error) {
+	s.mu.Lock()
+	b, err := s.lookup(ctx, req.GetUserId(), req.GetBookingId())
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	if b.GetStatus() == bookpb.Status_CANCELLED {
+		s.mu.Unlock()
+		return nil, apierrors.New(codes.FailedPrecondition, apierrors.ReasonBookingAlreadyCancelled,
+			"booking is already cancelled")
+	}
+
+	now := timestamppb.New(s.now())
+	previous := b.GetStatus()
+	b.Status = bookpb.Status_CANCELLED
+	b.UpdatedAt = now
+	cancelled := &bookpb.BookingCancelled{
+		BookingId:   b.GetBookingId(),
+		HotelId:     b.GetHotelId(),
+		RoomId:      b.GetRoomId(),
+		UserId:      b.GetUserId(),
+		CancelledAt: now,
+	}
+	changed := &bookpb.BookingStatusChanged{
+		BookingId:      b.GetBookingId(),
+		PreviousStatus: previous,
+		Status:         bookpb.Status_CANCELLED,
+		HotelId:        b.GetHotelId(),
+	}
+	s.mu.Unlock()
+
+	s.publish(ctx, cancelled, changed)
+	return &bookpb.CancelBookingResponse{UserId: cancelled.GetUserId(), Success: true, CancelledAt: now}, nil
+}
+
+// maxBookingsPageSize caps the page_size of ListBookings.
+const maxBookingsPageSize = 100
+
+// ListBookings requires an admin token. Page is a 1-based page number and
+// page_size is capped at maxBookingsPageSize.
+func (s *BookingServer) ListBookings(ctx context.Context, req *bookpb.ListBookingsRequest) (*bookpb.ListBookingsResponse, error) {
+	if !isAdmin(ctx) {
+		return nil, apierrors.New(codes.PermissionDenied, apierrors.ReasonPermissionDenied, "admin access required")
+	}
+
+	size := int(req.GetPageSize())
+	if size <= 0 {
+		size = 10
+	}
+	size = min(size, maxBookingsPageSize)
+	page := 1
+	if req.GetPage() != "" {
+		p, err := strconv.Atoi(req.GetPage())
+		if err != nil || p < 1 {
+			return nil, apierrors.Validation("invalid page",
+				apierrors.FieldViolation{Field: "page", Description: "must be a positive page number"})
+		}
+		page = p
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	matched := s.userBookings(req.GetUserId())
+	resp := &bookpb.ListBookingsResponse{}
+	// Compare page counts first: (page-1)*size overflows for huge pages.
+	if page-1 >= (len(matched)+size-1)/size {
+		return resp, nil
+	}
+	start := (page - 1) * size
+	for _, b := range matched[start:min(start+size, len(matched))] {
+		resp.Bookings = append(resp.Bookings, proto.Clone(b).(*bookpb.BookingDetails))
+	}
+	return resp, nil
+}
+
+// userBookings returns the bookings of a user in creation order, or all
+// bookings if userID is empty. s.mu must be held.
+func (s *BookingServer) userBookings(userID string) []*bookpb.BookingDetails {
+	var out []*bookpb.BookingDetails
+	for _, id := range s.order {
+		if b := s.bookings[id]; userID == "" || b.GetUserId() == userID {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+// Export implements export.Exporter with the user's bookings.
+func (s *BookingServer) Export(_ context.Context, userID string) (proto.Message, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	resp := &bookpb.ListBookingsResponse{}
+	for _, b := range s.userBookings(userID) {
+		resp.Bookings = append(resp.Bookings, proto.Clone(b).(*bookpb.BookingDetails))
+	}
+	return resp, nil
+}
+
+func (s *BookingServer) StreamBookingEvents(req *bookpb.StreamBookingEventsRequest, stream bookpb.BookingService_StreamBookingEventsServer) error {
+	return events.Serve(req, stream, s.Events)
+}
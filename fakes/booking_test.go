@@ -0,0 +1,143 @@
+package fakes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/booking/events"
+	bookpb "github.com/JunBSer/services_proto/booking/gen/go"
+	"github.com/JunBSer/services_proto/conformance"
+	"github.com/JunBSer/services_proto/fakes"
+)
+
+// serviceToken creates a service account and returns a client credentials
+// token for it.
+func serviceToken(t *testing.T, env *fakes.Env, admin conformance.Principal) string {
+	t.Helper()
+
+	ctx := context.Background()
+	creds, err := env.AuthClient.CreateServiceAccount(fakes.WithToken(ctx, admin.AccessToken),
+		&authpb.CreateServiceAccountRequest{Name: "booking-consumer"})
+	if err != nil {
+		t.Fatalf("CreateServiceAccount: %v", err)
+	}
+	resp, err := env.AuthClient.IssueClientCredentialsToken(ctx, &authpb.ClientCredentialsRequest{
+		ClientId: creds.GetAccount().GetClientId(), ClientSecret: creds.GetClientSecret(),
+	})
+	if err != nil {
+		t.Fatalf("IssueClientCredentialsToken: %v", err)
+	}
+	return resp.GetAccessToken()
+}
+
+func TestStreamBookingEventsAuthLevel(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	p := principals(t, env)
+
+	tests := []struct {
+		name     string
+		token    string
+		wantCode codes.Code
+	}{
+		{"anonymous", "", codes.Unauthenticated},
+		{"user", p.User.AccessToken, codes.PermissionDenied},
+		{"admin", p.Admin.AccessToken, codes.PermissionDenied},
+		{"service", serviceToken(t, env, p.Admin), codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if tt.token != "" {
+				ctx = fakes.WithToken(ctx, tt.token)
+			}
+			stream, err := env.BookingClient.StreamBookingEvents(ctx, &bookpb.StreamBookingEventsRequest{})
+			if err != nil {
+				t.Fatalf("StreamBookingEvents: %v", err)
+			}
+
+			// The subscription starts asynchronously, so keep publishing
+			// until the stream sees an event or fails.
+			stop := make(chan struct{})
+			defer close(stop)
+			go func() {
+				tick := time.NewTicker(10 * time.Millisecond)
+				defer tick.Stop()
+				for {
+					e, _ := events.New("/test", &bookpb.BookingCancelled{BookingId: "b-1"}, time.Now())
+					_ = env.Bookings.Events.Publish(ctx, e)
+					select {
+					case <-tick.C:
+					case <-stop:
+						return
+					}
+				}
+			}()
+
+			e, err := stream.Recv()
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("Recv error = %v, want code %v", err, tt.wantCode)
+			}
+			if err == nil && e.GetType() != events.TypeCancelled {
+				t.Errorf("Recv type = %q, want %q", e.GetType(), events.TypeCancelled)
+			}
+		})
+	}
+}
+
+func TestListBookingsPages(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	p := principals(t, env)
+	admin := fakes.WithToken(context.Background(), p.Admin.AccessToken)
+	f := newRateFixture(t, env, admin)
+
+	// One night each, back to back, so none of them overlap.
+	const bookings = 105
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	user := fakes.WithToken(context.Background(), p.User.AccessToken)
+	for i := range bookings {
+		night := start.AddDate(0, 0, i)
+		if _, err := env.BookingClient.CreateBooking(user, &bookpb.CreateBookingRequest{
+			HotelId: f.hotel, RoomId: f.kingRoom,
+			StartDate: timestamppb.New(night), EndDate: timestamppb.New(night.AddDate(0, 0, 1)),
+		}); err != nil {
+			t.Fatalf("CreateBooking %d: %v", i, err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		size     int32
+		page     string
+		want     int
+		wantCode codes.Code
+	}{
+		{name: "default size", want: 10},
+		{name: "second page", size: 50, page: "2", want: 50},
+		{name: "short last page", size: 50, page: "3", want: 5},
+		{name: "past the end", size: 50, page: "4"},
+		{name: "size capped", size: 1000, want: 100},
+		{name: "capped size pages on", size: 1000, page: "2", want: 5},
+		{name: "huge page", size: 100, page: "922337203685477580"},
+		{name: "zero page", page: "0", wantCode: codes.InvalidArgument},
+		{name: "out of range", page: "9223372036854775808", wantCode: codes.InvalidArgument},
+		{name: "not a number", page: "next", wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := env.BookingClient.ListBookings(admin, &bookpb.ListBookingsRequest{PageSize: tt.size, Page: tt.page})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("ListBookings = %v, want code %v", err, tt.wantCode)
+			}
+			if got := len(resp.GetBookings()); got != tt.want {
+				t.Errorf("got %d bookings, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package fakes_test
+
+import (
+	"context"
+	"testing"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	bookpb "github.com/JunBSer/services_proto/booking/gen/go"
+	"github.com/JunBSer/services_proto/conformance"
+	"github.com/JunBSer/services_proto/fakes"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+)
+
+const (
+	adminEmail    = "admin@example.com"
+	adminPassword = "admin-password"
+)
+
+// principals signs in as the seeded admin and creates two verified users.
+func principals(t *testing.T, env *fakes.Env) conformance.Principals {
+	t.Helper()
+
+	ctx := context.Background()
+	admin, err := conformance.Login(ctx, env.AuthClient, adminEmail, adminPassword)
+	if err != nil {
+		t.Fatalf("login admin: %v", err)
+	}
+	newUser := func(email string) conformance.Principal {
+		_, err := env.AuthClient.CreateUser(fakes.WithToken(ctx, admin.AccessToken), &authpb.CreateUserRequest{
+			Name: "Conformance", Email: email, Password: "user-password",
+		})
+		if err != nil {
+			t.Fatalf("create %s: %v", email, err)
+		}
+		p, err := conformance.Login(ctx, env.AuthClient, email, "user-password")
+		if err != nil {
+			t.Fatalf("login %s: %v", email, err)
+		}
+		return p
+	}
+	return conformance.Principals{
+		Admin: admin,
+		User:  newUser("user@example.com"),
+		Other: newUser("other@example.com"),
+	}
+}
+
+func TestAuthConformance(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	conformance.RunAuthConformance(t, func() authpb.AuthClient { return env.AuthClient },
+		conformance.Credentials{Email: adminEmail, Password: adminPassword})
+}
+
+func TestHotelServiceConformance(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	conformance.RunHotelServiceConformance(t, func() hotelpb.HotelServiceClient { return env.HotelClient },
+		principals(t, env))
+}
+
+func TestBookingServiceConformance(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	conformance.RunBookingServiceConformance(t, func() bookpb.BookingServiceClient { return env.BookingClient },
+		func() hotelpb.HotelServiceClient { return env.HotelClient }, principals(t, env))
+}
@@ -0,0 +1,176 @@
+package fakes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/auth/reauth"
+	"github.com/JunBSer/services_proto/auth/retention"
+	"github.com/JunBSer/services_proto/fakes"
+)
+
+// deleteOwnAccount deletes the account of pair through DeleteAccount after
+// reauthenticating.
+func deleteOwnAccount(t *testing.T, env *fakes.Env, pair *authpb.JWTPair, password string) {
+	t.Helper()
+
+	ctx := fakes.WithToken(context.Background(), pair.GetAccessToken())
+	ra, err := env.AuthClient.Reauthenticate(ctx, &authpb.ReauthenticateRequest{Password: password})
+	if err != nil {
+		t.Fatalf("Reauthenticate: %v", err)
+	}
+	if _, err := env.AuthClient.DeleteAccount(reauth.WithToken(ctx, ra.GetReauthToken()),
+		&authpb.DeleteAccountRequest{Password: password}); err != nil {
+		t.Fatalf("DeleteAccount: %v", err)
+	}
+}
+
+func TestSoftDeletion(t *testing.T) {
+	const (
+		email, password = "user@example.com", "user-password"
+		grace           = 24 * time.Hour
+	)
+
+	tests := []struct {
+		name    string
+		byAdmin bool
+		wait    time.Duration
+		// restore restores the account before the purge runs, or after it
+		// when purgeFirst is set.
+		purgeFirst  bool
+		restore     func(ctx context.Context, env *fakes.Env, admin, userID string) error
+		wantRestore codes.Code
+		wantPurged  bool
+		wantSignIn  codes.Code
+	}{
+		{
+			name: "self-service restore",
+			wait: grace / 2,
+			restore: func(ctx context.Context, env *fakes.Env, _, _ string) error {
+				_, err := env.AuthClient.RestoreAccount(ctx, &authpb.RestoreAccountRequest{Email: email, Password: password})
+				return err
+			},
+		},
+		{
+			name: "restore with wrong password",
+			wait: grace / 2,
+			restore: func(ctx context.Context, env *fakes.Env, _, _ string) error {
+				_, err := env.AuthClient.RestoreAccount(ctx, &authpb.RestoreAccountRequest{Email: email, Password: "wrong"})
+				return err
+			},
+			wantRestore: codes.Unauthenticated,
+			wantSignIn:  codes.FailedPrecondition,
+		},
+		{
+			name:    "admin deletion needs an admin restore",
+			byAdmin: true,
+			restore: func(ctx context.Context, env *fakes.Env, _, _ string) error {
+				_, err := env.AuthClient.RestoreAccount(ctx, &authpb.RestoreAccountRequest{Email: email, Password: password})
+				return err
+			},
+			wantRestore: codes.PermissionDenied,
+			wantSignIn:  codes.FailedPrecondition,
+		},
+		{
+			name:    "admin restore",
+			byAdmin: true,
+			restore: func(ctx context.Context, env *fakes.Env, admin, userID string) error {
+				_, err := env.AuthClient.RestoreUser(fakes.WithToken(ctx, admin), &authpb.RestoreUserRequest{UserId: userID})
+				return err
+			},
+		},
+		{name: "grace period not over", wait: grace - time.Second, wantSignIn: codes.FailedPrecondition},
+		{name: "purged after grace period", wait: grace, wantPurged: true, wantSignIn: codes.Unauthenticated},
+		{
+			name:       "restore after purge",
+			wait:       grace,
+			purgeFirst: true,
+			restore: func(ctx context.Context, env *fakes.Env, _, _ string) error {
+				_, err := env.AuthClient.RestoreAccount(ctx, &authpb.RestoreAccountRequest{Email: email, Password: password})
+				return err
+			},
+			wantRestore: codes.Unauthenticated,
+			wantPurged:  true,
+			wantSignIn:  codes.Unauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := newTestClock()
+			env := fakes.MustStart(t, fakes.Options{Clock: clock.Now, DeletionGracePeriod: grace, AccessTTL: 2 * grace})
+			p := principals(t, env)
+			ctx := context.Background()
+			purger := retention.NewPurger(env.AuthClient)
+			service := fakes.WithToken(ctx, serviceToken(t, env, p.Admin))
+
+			session := login(t, env, email, password)
+			if tt.byAdmin {
+				if _, err := env.AuthClient.DeleteUser(fakes.WithToken(ctx, p.Admin.AccessToken),
+					&authpb.DeleteRequest{UserId: p.User.UserID}); err != nil {
+					t.Fatalf("DeleteUser: %v", err)
+				}
+			} else {
+				deleteOwnAccount(t, env, session, password)
+			}
+			if err := refresh(env, session); status.Code(err) != codes.Unauthenticated {
+				t.Errorf("refresh after deletion = %v, want Unauthenticated", err)
+			}
+			clock.Advance(tt.wait)
+
+			var purged []string
+			purge := func() {
+				ids, err := purger.Purge(service)
+				if err != nil {
+					t.Fatalf("Purge: %v", err)
+				}
+				purged = append(purged, ids...)
+			}
+			if tt.purgeFirst {
+				purge()
+			}
+			if tt.restore != nil {
+				if err := tt.restore(ctx, env, p.Admin.AccessToken, p.User.UserID); status.Code(err) != tt.wantRestore {
+					t.Fatalf("restore = %v, want %v", err, tt.wantRestore)
+				}
+			}
+			if !tt.purgeFirst {
+				purge()
+			}
+			if got := len(purged) == 1 && purged[0] == p.User.UserID; got != tt.wantPurged {
+				t.Errorf("purged %v, want user purged %v", purged, tt.wantPurged)
+			}
+
+			_, err := env.AuthClient.Login(ctx, &authpb.LoginRequest{Email: email, Password: password})
+			if status.Code(err) != tt.wantSignIn {
+				t.Fatalf("Login = %v, want %v", err, tt.wantSignIn)
+			}
+			if tt.wantSignIn == codes.FailedPrecondition {
+				info := apierrors.InfoOf(err)
+				if apierrors.Reason(info.GetReason()) != apierrors.ReasonAccountDeleted || info.GetMetadata()["purge_at"] == "" {
+					t.Errorf("Login error info = %v, want %s with purge_at", info, apierrors.ReasonAccountDeleted)
+				}
+			}
+		})
+	}
+}
+
+func TestRestoreActiveAccount(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	p := principals(t, env)
+	ctx := context.Background()
+
+	_, err := env.AuthClient.RestoreAccount(ctx, &authpb.RestoreAccountRequest{Email: "user@example.com", Password: "user-password"})
+	if status.Code(err) != codes.FailedPrecondition || apierrors.ReasonOf(err) != apierrors.ReasonAccountNotDeleted {
+		t.Errorf("RestoreAccount = %v, want FailedPrecondition %s", err, apierrors.ReasonAccountNotDeleted)
+	}
+	_, err = env.AuthClient.RestoreUser(fakes.WithToken(ctx, p.Admin.AccessToken), &authpb.RestoreUserRequest{UserId: p.User.UserID})
+	if apierrors.ReasonOf(err) != apierrors.ReasonAccountNotDeleted {
+		t.Errorf("RestoreUser = %v, want %s", err, apierrors.ReasonAccountNotDeleted)
+	}
+}
@@ -0,0 +1,197 @@
+package fakes_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/auth/mailer"
+	"github.com/JunBSer/services_proto/fakes"
+)
+
+// testClock is a fakes.Clock that tests move forward.
+type testClock struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+func newTestClock() *testClock { return &testClock{t: time.Now()} }
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.t
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.t = c.t.Add(d)
+}
+
+func lastMail(t *testing.T, env *fakes.Env, to string, kind mailer.Kind) mailer.Message {
+	t.Helper()
+
+	msg, ok := env.Auth.Mailer().(*mailer.MemoryMailer).Last(to, kind)
+	if !ok {
+		t.Fatalf("no %s email to %s", kind, to)
+	}
+	return msg
+}
+
+func TestVerifyEmail(t *testing.T) {
+	const email = "new@example.com"
+
+	tests := []struct {
+		name string
+		// token returns the token to verify with, after the user registered.
+		token        func(t *testing.T, env *fakes.Env, clock *testClock) string
+		wantCode     codes.Code
+		wantReason   apierrors.Reason
+		wantVerified bool
+	}{
+		{
+			name: "registration email",
+			token: func(t *testing.T, env *fakes.Env, _ *testClock) string {
+				return lastMail(t, env, email, mailer.KindVerification).Token
+			},
+			wantVerified: true,
+		},
+		{
+			name: "resent email",
+			token: func(t *testing.T, env *fakes.Env, _ *testClock) string {
+				if _, err := env.AuthClient.ResendVerification(context.Background(),
+					&authpb.ResendVerificationRequest{Email: email}); err != nil {
+					t.Fatalf("ResendVerification: %v", err)
+				}
+				return lastMail(t, env, email, mailer.KindVerification).Token
+			},
+			wantVerified: true,
+		},
+		{
+			name: "used twice",
+			token: func(t *testing.T, env *fakes.Env, _ *testClock) string {
+				tok := lastMail(t, env, email, mailer.KindVerification).Token
+				if _, err := env.AuthClient.VerifyEmail(context.Background(), &authpb.VerifyEmailRequest{Token: tok}); err != nil {
+					t.Fatalf("first VerifyEmail: %v", err)
+				}
+				return tok
+			},
+			wantCode:     codes.InvalidArgument,
+			wantReason:   apierrors.ReasonTokenInvalid,
+			wantVerified: true,
+		},
+		{
+			name: "expired",
+			token: func(t *testing.T, env *fakes.Env, clock *testClock) string {
+				tok := lastMail(t, env, email, mailer.KindVerification).Token
+				clock.Advance(2 * time.Hour)
+				return tok
+			},
+			wantCode:   codes.InvalidArgument,
+			wantReason: apierrors.ReasonTokenInvalid,
+		},
+		{
+			name: "password reset token",
+			token: func(t *testing.T, env *fakes.Env, _ *testClock) string {
+				if _, err := env.AuthClient.RequestPasswordReset(context.Background(),
+					&authpb.RequestPasswordResetRequest{Email: email}); err != nil {
+					t.Fatalf("RequestPasswordReset: %v", err)
+				}
+				return lastMail(t, env, email, mailer.KindPasswordReset).Token
+			},
+			wantCode:   codes.InvalidArgument,
+			wantReason: apierrors.ReasonTokenInvalid,
+		},
+		{
+			name:       "unknown",
+			token:      func(*testing.T, *fakes.Env, *testClock) string { return "not-a-token" },
+			wantCode:   codes.InvalidArgument,
+			wantReason: apierrors.ReasonTokenInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := newTestClock()
+			env := fakes.MustStart(t, fakes.Options{Clock: clock.Now})
+			resp, err := env.AuthClient.Register(context.Background(),
+				&authpb.RegisterRequest{Name: "New", Email: email, Password: "new-password"})
+			if err != nil {
+				t.Fatalf("Register: %v", err)
+			}
+
+			_, err = env.AuthClient.VerifyEmail(context.Background(),
+				&authpb.VerifyEmailRequest{Token: tt.token(t, env, clock)})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("VerifyEmail = %v, want %v", err, tt.wantCode)
+			}
+			if tt.wantReason != "" && apierrors.ReasonOf(err) != tt.wantReason {
+				t.Errorf("reason = %s, want %s", apierrors.ReasonOf(err), tt.wantReason)
+			}
+
+			admin := login(t, env, adminEmail, adminPassword)
+			u, err := env.AuthClient.GetUser(fakes.WithToken(context.Background(), admin.GetAccessToken()),
+				&authpb.GetUserRequest{UserId: resp.GetUserId().GetValue()})
+			if err != nil {
+				t.Fatalf("GetUser: %v", err)
+			}
+			if u.GetEmailVerified() != tt.wantVerified {
+				t.Errorf("email_verified = %v, want %v", u.GetEmailVerified(), tt.wantVerified)
+			}
+		})
+	}
+}
+
+func TestPasswordResetRequests(t *testing.T) {
+	tests := []struct {
+		name        string
+		email       string
+		newPassword string
+		wantMail    bool
+		wantCode    codes.Code
+	}{
+		{"known user", "user@example.com", "new-password", true, codes.OK},
+		{"short password", "user@example.com", "short", true, codes.InvalidArgument},
+		// Unknown addresses get the same response and no email, so the RPC
+		// does not reveal which accounts exist.
+		{"unknown user", "nobody@example.com", "new-password", false, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := fakes.MustStart(t, fakes.Options{})
+			principals(t, env)
+			mails := env.Auth.Mailer().(*mailer.MemoryMailer)
+
+			if _, err := env.AuthClient.RequestPasswordReset(context.Background(),
+				&authpb.RequestPasswordResetRequest{Email: tt.email}); err != nil {
+				t.Fatalf("RequestPasswordReset: %v", err)
+			}
+			msg, ok := mails.Last(tt.email, mailer.KindPasswordReset)
+			if ok != tt.wantMail {
+				t.Fatalf("reset email sent = %v, want %v", ok, tt.wantMail)
+			}
+			if !ok {
+				return
+			}
+
+			_, err := env.AuthClient.ResetPassword(context.Background(),
+				&authpb.ResetPasswordRequest{Token: msg.Token, NewPassword: tt.newPassword})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("ResetPassword = %v, want %v", err, tt.wantCode)
+			}
+			_, err = env.AuthClient.Login(context.Background(),
+				&authpb.LoginRequest{Email: tt.email, Password: tt.newPassword})
+			if (err == nil) != (tt.wantCode == codes.OK) {
+				t.Errorf("Login with the new password = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,319 @@
+// Package fakes provides stateful, concurrency-safe in-memory implementations
+// of the Auth, HotelService and BookingService servers for consumer tests.
+// Start runs all three over an in-process bufconn listener.
+package fakes
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/auth/mailer"
+	bookpb "github.com/JunBSer/services_proto/booking/gen/go"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+	"github.com/JunBSer/services_proto/hotel/media"
+	options "github.com/JunBSer/services_proto/options/auth_options/gen/go"
+	"github.com/JunBSer/services_proto/options/methodopts"
+	"github.com/JunBSer/services_proto/ratelimit"
+)
+
+// Clock returns the current time. Tests may replace it to control token
+// expiry and booking timestamps.
+type Clock func() time.Time
+
+type Options struct {
+	// AdminEmail and AdminPassword seed an admin account. Defaults to
+	// admin@example.com / admin-password.
+	AdminEmail    string
+	AdminPassword string
+
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+	Clock      Clock
+	Mailer     mailer.Mailer
+	// DeletionGracePeriod is how long deleted accounts can be restored before
+	// PurgeDeletedAccounts removes them. Defaults to 30 days.
+	DeletionGracePeriod time.Duration
+	// Blobs stores hotel and room photos. Defaults to a media.MemoryStore.
+	Blobs media.Store
+
+	// Limiter and Lockout, when set, run before the auth interceptors to
+	// enforce rate_limit options and lock accounts after failed logins.
+	Limiter *ratelimit.Limiter
+	Lockout *ratelimit.Lockout
+
+	// ServerOptions are passed to grpc.NewServer after the audit, auth and
+	// reauth interceptors.
+	ServerOptions []grpc.ServerOption
+}
+
+func (o *Options) defaults() {
+	if o.AdminEmail == "" {
+		o.AdminEmail = "admin@example.com"
+	}
+	if o.AdminPassword == "" {
+		o.AdminPassword = "admin-password"
+	}
+	if o.AccessTTL <= 0 {
+		o.AccessTTL = 15 * time.Minute
+	}
+	if o.RefreshTTL <= 0 {
+		o.RefreshTTL = 24 * time.Hour
+	}
+	if o.DeletionGracePeriod <= 0 {
+		o.DeletionGracePeriod = 30 * 24 * time.Hour
+	}
+	if o.Clock == nil {
+		o.Clock = time.Now
+	}
+	if o.Mailer == nil {
+		o.Mailer = mailer.NewMemoryMailer()
+	}
+}
+
+// Env is a running set of fake servers with connected clients.
+type Env struct {
+	Auth     *AuthServer
+	Hotels   *HotelServer
+	Bookings *BookingServer
+
+	Conn          *grpc.ClientConn
+	AuthClient    authpb.AuthClient
+	HotelClient   hotelpb.HotelServiceClient
+	BookingClient bookpb.BookingServiceClient
+
+	server   *grpc.Server
+	listener *bufconn.Listener
+	once     sync.Once
+}
+
+// NewServers creates the three fakes wired together: bookings check rooms
+// against the hotel fake and availability accounts for bookings.
+func NewServers(opts Options) (*AuthServer, *HotelServer, *BookingServer) {
+	opts.defaults()
+	auth := NewAuthServer(opts)
+	hotels := NewHotelServer(opts.Clock)
+	if opts.Blobs != nil {
+		hotels.Blobs = opts.Blobs
+	}
+	bookings := NewBookingServer(opts.Clock, hotels)
+	hotels.bookings = bookings
+	auth.Export.Register("bookings", bookings)
+	return auth, hotels, bookings
+}
+
+// Start serves the fakes over bufconn and dials them.
+func Start(opts Options) (*Env, error) {
+	opts.defaults()
+	auth, hotels, bookings := NewServers(opts)
+
+	var unary []grpc.UnaryServerInterceptor
+	if opts.Limiter != nil {
+		unary = append(unary, opts.Limiter.UnaryInterceptor())
+	}
+	if opts.Lockout != nil {
+		unary = append(unary, opts.Lockout.UnaryInterceptor())
+	}
+	// The recorder runs ahead of the auth interceptors so that denied admin
+	// calls are audited too.
+	recorder := auth.AuditRecorder()
+	unary = append(unary, recorder.UnaryInterceptor(), auth.UnaryInterceptor(), auth.ReauthGuard().UnaryInterceptor())
+	serverOpts := append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(recorder.StreamInterceptor(), auth.StreamInterceptor()),
+	}, opts.ServerOptions...)
+	srv := grpc.NewServer(serverOpts...)
+	authpb.RegisterAuthServer(srv, auth)
+	hotelpb.RegisterHotelServiceServer(srv, hotels)
+	bookpb.RegisterBookingServiceServer(srv, bookings)
+
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = srv.Serve(lis) }()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		srv.Stop()
+		return nil, err
+	}
+
+	return &Env{
+		Auth:          auth,
+		Hotels:        hotels,
+		Bookings:      bookings,
+		Conn:          conn,
+		AuthClient:    authpb.NewAuthClient(conn),
+		HotelClient:   hotelpb.NewHotelServiceClient(conn),
+		BookingClient: bookpb.NewBookingServiceClient(conn),
+		server:        srv,
+		listener:      lis,
+	}, nil
+}
+
+// MustStart is Start for tests; the environment is closed on cleanup.
+func MustStart(tb testing.TB, opts Options) *Env {
+	tb.Helper()
+
+	env, err := Start(opts)
+	if err != nil {
+		tb.Fatalf("fakes: start: %v", err)
+	}
+	tb.Cleanup(env.Close)
+	return env
+}
+
+// Dial opens another client connection to the fakes, e.g. with extra
+// interceptors.
+func (e *Env) Dial(opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	return grpc.NewClient("passthrough:///bufnet", append([]grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return e.listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}, opts...)...)
+}
+
+func (e *Env) Close() {
+	e.once.Do(func() {
+		_ = e.Conn.Close()
+		e.server.Stop()
+		_ = e.Auth.Events.Close()
+		_ = e.Bookings.Events.Close()
+	})
+}
+
+// Login returns a context carrying an access token for the given user.
+func (e *Env) Login(ctx context.Context, email, password string) (context.Context, error) {
+	resp, err := e.AuthClient.Login(ctx, &authpb.LoginRequest{Email: email, Password: password})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetTokens() == nil {
+		return nil, errors.New("fakes: login requires MFA")
+	}
+	return WithToken(ctx, resp.GetTokens().GetAccessToken()), nil
+}
+
+// WithToken attaches a bearer token to outgoing gRPC metadata.
+func WithToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+type claimsKey struct{}
+
+// ClaimsFromContext returns the verified token claims of the caller.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	c, ok := ctx.Value(claimsKey{}).(*Claims)
+	return c, ok
+}
+
+// authorize enforces the auth_level of the called method. Methods without the
+// option require a user token.
+func (s *AuthServer) authorize(ctx context.Context, method string) (context.Context, error) {
+	level, ok := methodopts.AuthLevel(method)
+	if !ok {
+		level = options.AuthLevel_USER
+	}
+
+	claims, err := s.bearerClaims(ctx)
+	if err == nil {
+		ctx = context.WithValue(ctx, claimsKey{}, claims)
+	}
+
+	switch level {
+	case options.AuthLevel_NONE:
+		return ctx, nil
+	case options.AuthLevel_SERVICE:
+		if err != nil {
+			return nil, err
+		}
+		if !claims.Service {
+			return nil, apierrors.New(codes.PermissionDenied, apierrors.ReasonPermissionDenied, "service account required")
+		}
+	case options.AuthLevel_ADMIN:
+		if err != nil {
+			return nil, err
+		}
+		if !claims.Admin {
+			return nil, apierrors.New(codes.PermissionDenied, apierrors.ReasonPermissionDenied, "admin access required")
+		}
+	default:
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ctx, nil
+}
+
+func (s *AuthServer) bearerClaims(ctx context.Context) (*Claims, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		token, ok := strings.CutPrefix(v, "Bearer ")
+		if !ok {
+			continue
+		}
+		claims, err := s.verify(token, tokenAccess)
+		if err != nil {
+			return nil, err
+		}
+		return claims, nil
+	}
+	return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonTokenInvalid, "missing bearer token")
+}
+
+func (s *AuthServer) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := s.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (s *AuthServer) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := s.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context { return s.ctx }
+
+func callerID(ctx context.Context) (string, error) {
+	c, ok := ClaimsFromContext(ctx)
+	if !ok || c.Service {
+		return "", status.Error(codes.Unauthenticated, "user token required")
+	}
+	return c.Subject, nil
+}
+
+func isAdmin(ctx context.Context) bool {
+	c, ok := ClaimsFromContext(ctx)
+	return ok && c.Admin
+}
@@ -0,0 +1,481 @@
+package fakes
+
+import (
+	"context"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	"github.com/JunBSer/services_proto/hotel/catalog"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+	"github.com/JunBSer/services_proto/hotel/media"
+	"github.com/JunBSer/services_proto/hotel/pricing"
+	"github.com/JunBSer/services_proto/hotel/rates"
+)
+
+// uploadTTL is how long an upload session may take until it is attached.
+const uploadTTL = time.Hour
+
+// HotelServer is an in-memory hotelpb.HotelServiceServer. Every catalog
+// change is recorded in Catalog.
+type HotelServer struct {
+	hotelpb.UnimplementedHotelServiceServer
+
+	Catalog *catalog.Log
+	// Blobs stores uploaded photos. Defaults to a media.MemoryStore.
+	Blobs media.Store
+
+	now      Clock
+	bookings *BookingServer
+
+	mu           sync.RWMutex
+	hotels       map[string]*hotelpb.Hotel
+	order        []string
+	uploads      map[string]*upload
+	roomTypes    map[string]*hotelpb.RoomType
+	ratePlans    map[string]*hotelpb.RatePlan
+	pricingRules map[string]*hotelpb.PricingRule
+}
+
+type upload struct {
+	hotelID, roomID string
+	expect          media.Expect
+	expires         time.Time
+	receiving       bool
+	// result is set once UploadPhoto stored the image.
+	result *media.Result
+}
+
+func NewHotelServer(now Clock) *HotelServer {
+	if now == nil {
+		now = time.Now
+	}
+	return &HotelServer{
+		Catalog:      catalog.NewLog(1000, 10000, now),
+		Blobs:        media.NewMemoryStore("/media"),
+		now:          now,
+		hotels:       make(map[string]*hotelpb.Hotel),
+		uploads:      make(map[string]*upload),
+		roomTypes:    make(map[string]*hotelpb.RoomType),
+		ratePlans:    make(map[string]*hotelpb.RatePlan),
+		pricingRules: make(map[string]*hotelpb.PricingRule),
+	}
+}
+
+func hotelNotFound() error {
+	return apierrors.New(codes.NotFound, apierrors.ReasonHotelNotFound, "hotel not found")
+}
+
+func roomNotFound() error {
+	return apierrors.New(codes.NotFound, apierrors.ReasonRoomNotFound, "room not found")
+}
+
+func findRoom(h *hotelpb.Hotel, id string) (int, *hotelpb.Room) {
+	for i, r := range h.GetRooms() {
+		if r.GetId() == id {
+			return i, r
+		}
+	}
+	return -1, nil
+}
+
+// snapshot returns a copy of the hotel with room availability at the
+// current time filled in.
+func (s *HotelServer) snapshot(h *hotelpb.Hotel) *hotelpb.Hotel {
+	out := proto.Clone(h).(*hotelpb.Hotel)
+	if s.bookings == nil {
+		return out
+	}
+	now := s.now()
+	for _, r := range out.GetRooms() {
+		r.IsAvailable = !s.bookings.overlaps(out.GetId(), r.GetId(), now, now.Add(time.Nanosecond))
+	}
+	return out
+}
+
+func (s *HotelServer) CreateHotel(_ context.Context, req *hotelpb.CreateHotelRequest) (*hotelpb.Hotel, error) {
+	if strings.TrimSpace(req.GetName()) == "" {
+		return nil, apierrors.Validation("invalid hotel",
+			apierrors.FieldViolation{Field: "name", Description: "is required"})
+	}
+
+	h := &hotelpb.Hotel{
+		Id:        newUUID(),
+		Name:      req.GetName(),
+		Address:   req.GetAddress(),
+		Amenities: slices.Clone(req.GetAmenities()),
+	}
+
+	s.mu.Lock()
+	s.hotels[h.GetId()] = h
+	s.order = append(s.order, h.GetId())
+	s.Catalog.HotelChanged(hotelpb.ChangeType_CHANGE_TYPE_CREATED, nil, h)
+	s.mu.Unlock()
+
+	return proto.Clone(h).(*hotelpb.Hotel), nil
+}
+
+func (s *HotelServer) UpdateHotel(_ context.Context, req *hotelpb.UpdateHotelRequest) (*hotelpb.Hotel, error) {
+	s.mu.Lock()
+	h, ok := s.hotels[req.GetId()]
+	if !ok {
+		s.mu.Unlock()
+		return nil, hotelNotFound()
+	}
+	before := proto.Clone(h).(*hotelpb.Hotel)
+	if req.GetName() != "" {
+		h.Name = req.GetName()
+	}
+	if req.GetAddress() != "" {
+		h.Address = req.GetAddress()
+	}
+	if req.GetAmenities() != nil {
+		h.Amenities = slices.Clone(req.GetAmenities())
+	}
+	s.Catalog.HotelChanged(hotelpb.ChangeType_CHANGE_TYPE_UPDATED, before, h)
+	out := proto.Clone(h).(*hotelpb.Hotel)
+	s.mu.Unlock()
+
+	return out, nil
+}
+
+// DeleteHotel removes the hotel together with all of its rooms, room types,
+// rate plans and pricing rules, recording a deletion for each room before the hotel's own.
+func (s *HotelServer) DeleteHotel(ctx context.Context, req *hotelpb.DeleteHotelRequest) (*hotelpb.DeleteResponse, error) {
+	var orphaned []string
+	defer func() { s.deleteBlobs(ctx, orphaned) }()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h, ok := s.hotels[req.GetId()]
+	if !ok {
+		return nil, hotelNotFound()
+	}
+	orphaned = photoKeys(h.GetId(), "", h.GetPhotos())
+	for _, r := range h.GetRooms() {
+		orphaned = append(orphaned, photoKeys(h.GetId(), r.GetId(), r.GetPhotos())...)
+		s.Catalog.RoomChanged(h.GetId(), hotelpb.ChangeType_CHANGE_TYPE_DELETED, r, nil)
+	}
+	s.Catalog.HotelChanged(hotelpb.ChangeType_CHANGE_TYPE_DELETED, h, nil)
+	maps.DeleteFunc(s.roomTypes, func(_ string, t *hotelpb.RoomType) bool { return t.GetHotelId() == req.GetId() })
+	maps.DeleteFunc(s.ratePlans, func(_ string, p *hotelpb.RatePlan) bool { return p.GetHotelId() == req.GetId() })
+	maps.DeleteFunc(s.pricingRules, func(_ string, r *hotelpb.PricingRule) bool { return r.GetHotelId() == req.GetId() })
+	delete(s.hotels, req.GetId())
+	s.order = slices.DeleteFunc(s.order, func(id string) bool { return id == req.GetId() })
+	return &hotelpb.DeleteResponse{Success: true}, nil
+}
+
+func (s *HotelServer) GetHotel(_ context.Context, req *hotelpb.GetHotelRequest) (*hotelpb.Hotel, error) {
+	s.mu.RLock()
+	h, ok := s.hotels[req.GetId()]
+	if !ok {
+		s.mu.RUnlock()
+		return nil, hotelNotFound()
+	}
+	h = proto.Clone(h).(*hotelpb.Hotel)
+	s.mu.RUnlock()
+
+	return s.snapshot(h), nil
+}
+
+func (s *HotelServer) list(match func(*hotelpb.Hotel) bool) *hotelpb.HotelList {
+	s.mu.RLock()
+	var hotels []*hotelpb.Hotel
+	for _, id := range s.order {
+		if h := s.hotels[id]; match(h) {
+			hotels = append(hotels, proto.Clone(h).(*hotelpb.Hotel))
+		}
+	}
+	s.mu.RUnlock()
+
+	out := &hotelpb.HotelList{}
+	for _, h := range hotels {
+		out.Hotels = append(out.Hotels, s.snapshot(h))
+	}
+	return out
+}
+
+func (s *HotelServer) ListHotels(context.Context, *emptypb.Empty) (*hotelpb.HotelList, error) {
+	return s.list(func(*hotelpb.Hotel) bool { return true }), nil
+}
+
+// SearchHotels matches location case-insensitively against name and address
+// and requires every requested amenity.
+func (s *HotelServer) SearchHotels(_ context.Context, req *hotelpb.SearchRequest) (*hotelpb.HotelList, error) {
+	loc := strings.ToLower(req.GetLocation())
+	return s.list(func(h *hotelpb.Hotel) bool {
+		if loc != "" && !strings.Contains(strings.ToLower(h.GetAddress()), loc) &&
+			!strings.Contains(strings.ToLower(h.GetName()), loc) {
+			return false
+		}
+		for _, a := range req.GetRequiredAmenities() {
+			if !slices.Contains(h.GetAmenities(), a) {
+				return false
+			}
+		}
+		return true
+	}), nil
+}
+
+func (s *HotelServer) ListRooms(ctx context.Context, req *hotelpb.ListRoomsRequest) (*hotelpb.RoomList, error) {
+	h, err := s.GetHotel(ctx, &hotelpb.GetHotelRequest{Id: req.GetHotelId()})
+	if err != nil {
+		return nil, err
+	}
+	return &hotelpb.RoomList{Rooms: h.GetRooms()}, nil
+}
+
+func (s *HotelServer) GetRoom(ctx context.Context, req *hotelpb.GetRoomRequest) (*hotelpb.Room, error) {
+	h, err := s.GetHotel(ctx, &hotelpb.GetHotelRequest{Id: req.GetHotelId()})
+	if err != nil {
+		return nil, err
+	}
+	_, r := findRoom(h, req.GetId())
+	if r == nil {
+		return nil, roomNotFound()
+	}
+	return r, nil
+}
+
+func validateRoom(price float64) error {
+	if price <= 0 || math.IsNaN(price) || math.IsInf(price, 0) {
+		return apierrors.Validation("invalid room",
+			apierrors.FieldViolation{Field: "price_per_night", Description: "must be positive"})
+	}
+	return nil
+}
+
+func (s *HotelServer) AddRoom(_ context.Context, req *hotelpb.AddRoomRequest) (*hotelpb.Room, error) {
+	if err := validateRoom(req.GetPricePerNight()); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h, ok := s.hotels[req.GetHotelId()]
+	if !ok {
+		return nil, hotelNotFound()
+	}
+	r := &hotelpb.Room{
+		Id:            newUUID(),
+		Type:          req.GetType(),
+		Amenities:     slices.Clone(req.GetAmenities()),
+		IsAvailable:   true,
+		PricePerNight: req.GetPricePerNight(),
+		RoomTypeId:    req.GetRoomTypeId(),
+	}
+	if r.GetRoomTypeId() != "" {
+		t, err := s.roomType(h.GetId(), r.GetRoomTypeId())
+		if err != nil {
+			return nil, err
+		}
+		if r.GetType() == "" {
+			r.Type = t.GetName()
+		}
+	}
+	h.Rooms = append(h.Rooms, r)
+	s.Catalog.RoomChanged(h.GetId(), hotelpb.ChangeType_CHANGE_TYPE_CREATED, nil, r)
+	return proto.Clone(r).(*hotelpb.Room), nil
+}
+
+func (s *HotelServer) UpdateRoom(_ context.Context, req *hotelpb.UpdateRoomRequest) (*hotelpb.Room, error) {
+	if req.GetPricePerNight() != 0 {
+		if err := validateRoom(req.GetPricePerNight()); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h, ok := s.hotels[req.GetHotelId()]
+	if !ok {
+		return nil, hotelNotFound()
+	}
+	_, r := findRoom(h, req.GetId())
+	if r == nil {
+		return nil, roomNotFound()
+	}
+	var t *hotelpb.RoomType
+	if req.GetRoomTypeId() != "" {
+		var err error
+		if t, err = s.roomType(h.GetId(), req.GetRoomTypeId()); err != nil {
+			return nil, err
+		}
+	}
+	before := proto.Clone(r).(*hotelpb.Room)
+	if t != nil {
+		r.RoomTypeId = t.GetId()
+		r.Type = t.GetName()
+	}
+	if req.GetType() != "" {
+		r.Type = req.GetType()
+	}
+	if req.GetAmenities() != nil {
+		r.Amenities = slices.Clone(req.GetAmenities())
+	}
+	if req.GetPricePerNight() != 0 {
+		r.PricePerNight = req.GetPricePerNight()
+	}
+	s.Catalog.RoomChanged(h.GetId(), hotelpb.ChangeType_CHANGE_TYPE_UPDATED, before, r)
+	return proto.Clone(r).(*hotelpb.Room), nil
+}
+
+func (s *HotelServer) DeleteRoom(ctx context.Context, req *hotelpb.DeleteRoomRequest) (*hotelpb.DeleteResponse, error) {
+	var orphaned []string
+	defer func() { s.deleteBlobs(ctx, orphaned) }()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h, ok := s.hotels[req.GetHotelId()]
+	if !ok {
+		return nil, hotelNotFound()
+	}
+	i, r := findRoom(h, req.GetId())
+	if i < 0 {
+		return nil, roomNotFound()
+	}
+	orphaned = photoKeys(h.GetId(), r.GetId(), r.GetPhotos())
+	s.Catalog.RoomChanged(h.GetId(), hotelpb.ChangeType_CHANGE_TYPE_DELETED, r, nil)
+	h.Rooms = slices.Delete(h.Rooms, i, i+1)
+	return &hotelpb.DeleteResponse{Success: true}, nil
+}
+
+// CheckAvailability returns rooms without overlapping bookings, narrowed to
+// room_type_id and to rooms rate_plan_id can be booked for. Prices come from
+// the pricing engine: TotalPrice is the cheapest available room's price for
+// the whole stay, under rate_plan_id if set. Offers pair every room type with
+// each active plan sold for it.
+func (s *HotelServer) CheckAvailability(ctx context.Context, req *hotelpb.AvailabilityRequest) (*hotelpb.AvailabilityResponse, error) {
+	start, end, err := stayRange(req.GetStartDate(), req.GetEndDate())
+	if err != nil {
+		return nil, err
+	}
+	rooms, err := s.ListRooms(ctx, &hotelpb.ListRoomsRequest{HotelId: req.GetHotelId()})
+	if err != nil {
+		return nil, err
+	}
+	plans, err := s.offeredPlans(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &hotelpb.AvailabilityResponse{}
+	nights := rates.Nights(start, end)
+	engine := s.pricingEngine(req.GetHotelId())
+	stay := pricing.Stay{Start: start, End: end, QuotedAt: s.now()}
+	if req.GetRatePlanId() != "" {
+		stay.Plan = plans[0]
+	}
+	for _, r := range rooms.GetRooms() {
+		if req.GetRoomTypeId() != "" && r.GetRoomTypeId() != req.GetRoomTypeId() {
+			continue
+		}
+		if stay.Plan != nil && rates.Check(stay.Plan, r.GetRoomTypeId(), nights) != nil {
+			continue
+		}
+		if s.bookings != nil && s.bookings.overlaps(req.GetHotelId(), r.GetId(), start, end) {
+			continue
+		}
+		r.IsAvailable = true
+		resp.AvailableRooms = append(resp.AvailableRooms, r)
+		stay.RoomTypeID, stay.BasePrice = r.GetRoomTypeId(), r.GetPricePerNight()
+		q, err := engine.Quote(stay)
+		if err != nil {
+			return nil, err
+		}
+		if resp.TotalPrice == 0 || q.Total < resp.TotalPrice {
+			resp.TotalPrice = q.Total
+		}
+	}
+	resp.IsAvailable = len(resp.AvailableRooms) > 0
+	resp.Offers, err = offers(resp.GetAvailableRooms(), plans, engine, stay)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// offeredPlans returns the active rate plans an availability request may
+// offer: just rate_plan_id if set, which must then exist and be active.
+func (s *HotelServer) offeredPlans(req *hotelpb.AvailabilityRequest) ([]*hotelpb.RatePlan, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if req.GetRoomTypeId() != "" {
+		if _, err := s.roomType(req.GetHotelId(), req.GetRoomTypeId()); err != nil {
+			return nil, err
+		}
+	}
+	if req.GetRatePlanId() != "" {
+		p, err := s.ratePlan(req.GetHotelId(), req.GetRatePlanId())
+		if err != nil {
+			return nil, err
+		}
+		if !p.GetActive() {
+			return nil, apierrors.New(codes.FailedPrecondition, apierrors.ReasonRatePlanUnavailable, "rate plan is not active")
+		}
+		return []*hotelpb.RatePlan{proto.Clone(p).(*hotelpb.RatePlan)}, nil
+	}
+	var out []*hotelpb.RatePlan
+	for _, p := range s.hotelRatePlans(req.GetHotelId()) {
+		if p.GetActive() {
+			out = append(out, proto.Clone(p).(*hotelpb.RatePlan))
+		}
+	}
+	return out, nil
+}
+
+// offers groups available rooms by room type, in order of first appearance,
+// and prices each group for stay under every plan that can be booked for it.
+func offers(rooms []*hotelpb.Room, plans []*hotelpb.RatePlan, engine *pricing.Engine, stay pricing.Stay) ([]*hotelpb.RateOffer, error) {
+	nights := rates.Nights(stay.Start, stay.End)
+	var types []string
+	byType := make(map[string][]*hotelpb.Room)
+	for _, r := range rooms {
+		id := r.GetRoomTypeId()
+		if id == "" {
+			continue
+		}
+		if _, ok := byType[id]; !ok {
+			types = append(types, id)
+		}
+		byType[id] = append(byType[id], r)
+	}
+
+	var out []*hotelpb.RateOffer
+	for _, p := range plans {
+		for _, id := range types {
+			if rates.Check(p, id, nights) != nil {
+				continue
+			}
+			o := &hotelpb.RateOffer{RoomTypeId: id, RatePlanId: p.GetId()}
+			for _, r := range byType[id] {
+				o.RoomIds = append(o.RoomIds, r.GetId())
+				stay.RoomTypeID, stay.BasePrice, stay.Plan = id, r.GetPricePerNight(), p
+				q, err := engine.Quote(stay)
+				if err != nil {
+					return nil, err
+				}
+				if o.TotalPrice == 0 || q.Total < o.TotalPrice {
+					o.TotalPrice = q.Total
+				}
+			}
+			out = append(out, o)
+		}
+	}
+	return out, nil
+}
+
+func (s *HotelServer) WatchCatalog(req *hotelpb.WatchCatalogRequest, stream hotelpb.HotelService_WatchCatalogServer) error {
+	return s.Catalog.Serve(req, stream)
+}
@@ -0,0 +1,153 @@
+package fakes_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/fakes"
+	options "github.com/JunBSer/services_proto/options/auth_options/gen/go"
+	"github.com/JunBSer/services_proto/options/methodopts"
+)
+
+func TestIntrospectToken(t *testing.T) {
+	tests := []struct {
+		name string
+		// token returns the token to introspect.
+		token       func(t *testing.T, env *fakes.Env) string
+		wait        time.Duration
+		wantActive  bool
+		wantSubject authpb.SubjectType
+		wantType    authpb.TokenType
+		wantSession bool
+		wantScopes  []string
+	}{
+		{
+			name: "user access token",
+			token: func(t *testing.T, env *fakes.Env) string {
+				return login(t, env, adminEmail, adminPassword).GetAccessToken()
+			},
+			wantActive:  true,
+			wantSubject: authpb.SubjectType_SUBJECT_TYPE_USER,
+			wantType:    authpb.TokenType_TOKEN_TYPE_ACCESS,
+			wantSession: true,
+		},
+		{
+			name: "user refresh token",
+			token: func(t *testing.T, env *fakes.Env) string {
+				return login(t, env, adminEmail, adminPassword).GetRefreshToken()
+			},
+			wantActive:  true,
+			wantSubject: authpb.SubjectType_SUBJECT_TYPE_USER,
+			wantType:    authpb.TokenType_TOKEN_TYPE_REFRESH,
+			wantSession: true,
+		},
+		{
+			name: "service token",
+			token: func(t *testing.T, env *fakes.Env) string {
+				admin := login(t, env, adminEmail, adminPassword)
+				return issueServiceToken(t, env, createServiceAccount(t, env, admin.GetAccessToken(), "booking", "hotels.read"))
+			},
+			wantActive:  true,
+			wantSubject: authpb.SubjectType_SUBJECT_TYPE_SERVICE,
+			wantType:    authpb.TokenType_TOKEN_TYPE_ACCESS,
+			wantScopes:  []string{"hotels.read"},
+		},
+		{
+			name: "logged out",
+			token: func(t *testing.T, env *fakes.Env) string {
+				pair := login(t, env, adminEmail, adminPassword)
+				if _, err := env.AuthClient.Logout(fakes.WithToken(context.Background(), pair.GetAccessToken()),
+					&authpb.LogoutRequest{RefreshToken: pair.GetRefreshToken()}); err != nil {
+					t.Fatal(err)
+				}
+				return pair.GetRefreshToken()
+			},
+		},
+		{
+			name: "expired",
+			token: func(t *testing.T, env *fakes.Env) string {
+				return login(t, env, adminEmail, adminPassword).GetAccessToken()
+			},
+			wait: time.Hour,
+		},
+		{
+			name:  "garbage",
+			token: func(*testing.T, *fakes.Env) string { return "garbage" },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := newTestClock()
+			env := fakes.MustStart(t, fakes.Options{Clock: clock.Now})
+			admin := login(t, env, adminEmail, adminPassword)
+			introspector := createServiceAccount(t, env, admin.GetAccessToken(), "gateway")
+			token := tt.token(t, env)
+			clock.Advance(tt.wait)
+			caller := issueServiceToken(t, env, introspector)
+
+			resp, err := env.AuthClient.IntrospectToken(fakes.WithToken(context.Background(), caller),
+				&authpb.IntrospectTokenRequest{Token: token})
+			if err != nil {
+				t.Fatalf("IntrospectToken: %v", err)
+			}
+			if resp.GetActive() != tt.wantActive {
+				t.Fatalf("Active = %v, want %v", resp.GetActive(), tt.wantActive)
+			}
+			if !tt.wantActive {
+				if resp.GetSubject() != "" || resp.GetTokenType() != authpb.TokenType_TOKEN_TYPE_UNSPECIFIED {
+					t.Errorf("inactive response leaks claims: %v", resp)
+				}
+				return
+			}
+			if resp.GetSubject() == "" || resp.GetSubjectType() != tt.wantSubject || resp.GetTokenType() != tt.wantType {
+				t.Errorf("subject %q %v type %v, want %v %v", resp.GetSubject(), resp.GetSubjectType(), resp.GetTokenType(),
+					tt.wantSubject, tt.wantType)
+			}
+			if (resp.GetSessionId() != "") != tt.wantSession {
+				t.Errorf("SessionId = %q, want set %v", resp.GetSessionId(), tt.wantSession)
+			}
+			if !slices.Equal(resp.GetScopes(), tt.wantScopes) {
+				t.Errorf("Scopes = %v, want %v", resp.GetScopes(), tt.wantScopes)
+			}
+			if !resp.GetIssuedAt().AsTime().Before(resp.GetExpiresAt().AsTime()) || resp.GetTokenId() == "" {
+				t.Errorf("IssuedAt %v, ExpiresAt %v, TokenId %q", resp.GetIssuedAt(), resp.GetExpiresAt(), resp.GetTokenId())
+			}
+		})
+	}
+}
+
+func TestIntrospectTokenCallers(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	p := principals(t, env)
+
+	tests := []struct {
+		name     string
+		token    string
+		wantCode codes.Code
+	}{
+		{"service", serviceToken(t, env, p.Admin), codes.OK},
+		{"admin", p.Admin.AccessToken, codes.PermissionDenied},
+		{"user", p.User.AccessToken, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		_, err := env.AuthClient.IntrospectToken(fakes.WithToken(context.Background(), tt.token),
+			&authpb.IntrospectTokenRequest{Token: p.User.AccessToken})
+		if status.Code(err) != tt.wantCode {
+			t.Errorf("%s: IntrospectToken = %v, want %v", tt.name, err, tt.wantCode)
+		}
+	}
+
+	const method = authpb.Auth_IntrospectToken_FullMethodName
+	if level, _ := methodopts.AuthLevel(method); level != options.AuthLevel_SERVICE {
+		t.Errorf("auth_level = %v, want SERVICE", level)
+	}
+	if rule, ok := methodopts.HTTPRule(method); !ok || rule.GetPost() != "/v1/auth/introspect" {
+		t.Errorf("http rule = %v, want POST /v1/auth/introspect", rule)
+	}
+}
@@ -0,0 +1,95 @@
+package fakes
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+var errInvalidToken = errors.New("invalid token")
+
+const (
+	tokenAccess  = "access"
+	tokenRefresh = "refresh"
+	tokenMFA     = "mfa"
+	tokenReauth  = "reauth"
+)
+
+// Claims is the payload of the HS256 JWTs issued by the fake Auth server.
+type Claims struct {
+	Subject   string   `json:"sub"`
+	Type      string   `json:"typ"`
+	Admin     bool     `json:"adm,omitempty"`
+	Service   bool     `json:"svc,omitempty"`
+	ClientID  string   `json:"cid,omitempty"`
+	Scopes    []string `json:"scp,omitempty"`
+	SessionID string   `json:"sid,omitempty"`
+	ID        string   `json:"jti"`
+	Issuer    string   `json:"iss"`
+	IssuedAt  int64    `json:"iat"`
+	ExpiresAt int64    `json:"exp"`
+}
+
+type signer struct {
+	key    []byte
+	issuer string
+}
+
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+func (s signer) sign(c Claims) string {
+	c.Issuer = s.issuer
+	payload, _ := json.Marshal(c)
+	unsigned := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + s.mac(unsigned)
+}
+
+func (s signer) parse(token string, now time.Time) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != jwtHeader {
+		return nil, errInvalidToken
+	}
+	if !hmac.Equal([]byte(parts[2]), []byte(s.mac(parts[0]+"."+parts[1]))) {
+		return nil, errInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errInvalidToken
+	}
+	var c Claims
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return nil, errInvalidToken
+	}
+	if c.Issuer != s.issuer || now.Unix() >= c.ExpiresAt {
+		return nil, errInvalidToken
+	}
+	return &c, nil
+}
+
+func (s signer) mac(unsigned string) string {
+	m := hmac.New(sha256.New, s.key)
+	m.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(m.Sum(nil))
+}
+
+func randomID() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+// newUUID returns a random UUID v4 string.
+func newUUID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	h := hex.EncodeToString(b[:])
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:32]
+}
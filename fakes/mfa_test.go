@@ -0,0 +1,123 @@
+package fakes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/auth/totp"
+	"github.com/JunBSer/services_proto/fakes"
+	"github.com/JunBSer/services_proto/ratelimit"
+)
+
+// enrollTOTP enables TOTP for the user with the given access token and
+// returns the secret.
+func enrollTOTP(t *testing.T, env *fakes.Env, clock *testClock, accessToken string) string {
+	t.Helper()
+
+	ctx := fakes.WithToken(context.Background(), accessToken)
+	enroll, err := env.AuthClient.EnrollTOTP(ctx, &authpb.EnrollTOTPRequest{})
+	if err != nil {
+		t.Fatalf("EnrollTOTP: %v", err)
+	}
+	code, err := totp.Options{}.Code(enroll.GetSecret(), clock.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := env.AuthClient.ConfirmTOTP(ctx, &authpb.ConfirmTOTPRequest{Code: code}); err != nil {
+		t.Fatalf("ConfirmTOTP: %v", err)
+	}
+	return enroll.GetSecret()
+}
+
+func mfaToken(t *testing.T, env *fakes.Env, email, password string) string {
+	t.Helper()
+
+	resp, err := env.AuthClient.Login(context.Background(), &authpb.LoginRequest{Email: email, Password: password})
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if resp.GetMfaChallenge() == nil {
+		t.Fatal("Login did not return an MFA challenge")
+	}
+	return resp.GetMfaChallenge().GetMfaToken()
+}
+
+func verifyTOTP(env *fakes.Env, token, code string) (*authpb.VerifyMFAResponse, error) {
+	return env.AuthClient.VerifyMFA(context.Background(), &authpb.VerifyMFARequest{
+		MfaToken: token, Credential: &authpb.VerifyMFARequest_TotpCode{TotpCode: code},
+	})
+}
+
+func TestVerifyMFAAttempts(t *testing.T) {
+	tests := []struct {
+		name      string
+		wrong     int
+		wantFinal codes.Code
+	}{
+		{"first try", 0, codes.OK},
+		{"below the limit", 4, codes.OK},
+		{"challenge burned", 5, codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := newTestClock()
+			env := fakes.MustStart(t, fakes.Options{Clock: clock.Now})
+			p := principals(t, env)
+			secret := enrollTOTP(t, env, clock, p.User.AccessToken)
+			token := mfaToken(t, env, "user@example.com", "user-password")
+
+			// A well-formed code from an hour away never matches.
+			wrong, err := totp.Options{}.Code(secret, clock.Now().Add(time.Hour))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := range tt.wrong {
+				if _, err := verifyTOTP(env, token, wrong); !apierrors.Is(err, apierrors.ReasonInvalidMFACode) {
+					t.Fatalf("wrong code %d: error = %v, want INVALID_MFA_CODE", i+1, err)
+				}
+			}
+
+			// The next window's code has not been used for enrollment.
+			clock.Advance(30 * time.Second)
+			right, err := totp.Options{}.Code(secret, clock.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := verifyTOTP(env, token, right)
+			if got := status.Code(err); got != tt.wantFinal {
+				t.Fatalf("right code: error = %v, want code %v", err, tt.wantFinal)
+			}
+			if err != nil && !apierrors.Is(err, apierrors.ReasonTokenInvalid) {
+				t.Errorf("right code: error = %v, want TOKEN_INVALID", err)
+			}
+			if err == nil && resp.GetTokens().GetAccessToken() == "" {
+				t.Error("VerifyMFA returned no tokens")
+			}
+		})
+	}
+}
+
+func TestVerifyMFARateLimit(t *testing.T) {
+	clock := newTestClock()
+	env := fakes.MustStart(t, fakes.Options{
+		Clock:   clock.Now,
+		Limiter: ratelimit.NewLimiter(ratelimit.NewMemoryBackend(clock.Now)),
+	})
+
+	// The limit follows the client, whatever challenge it sends.
+	for i := range 10 {
+		if _, err := verifyTOTP(env, "garbage", "000000"); !apierrors.Is(err, apierrors.ReasonTokenInvalid) {
+			t.Fatalf("VerifyMFA %d: error = %v, want TOKEN_INVALID", i+1, err)
+		}
+	}
+	_, err := verifyTOTP(env, "garbage", "000000")
+	if status.Code(err) != codes.ResourceExhausted || !apierrors.Is(err, apierrors.ReasonRateLimited) {
+		t.Errorf("VerifyMFA 11: error = %v, want ResourceExhausted with RATE_LIMITED", err)
+	}
+}
@@ -0,0 +1,165 @@
+package fakes_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/auth/oauth"
+	"github.com/JunBSer/services_proto/fakes"
+)
+
+const redirectURI = "https://app.example.com/callback"
+
+// startOAuth starts a login with the fake provider and approves it at idp.
+func startOAuth(t *testing.T, env *fakes.Env, idp *oauth.FakeIDP) *authpb.CompleteOAuthLoginRequest {
+	t.Helper()
+
+	start, err := env.AuthClient.StartOAuthLogin(context.Background(), &authpb.StartOAuthLoginRequest{
+		Provider: "fake", RedirectUri: redirectURI,
+	})
+	if err != nil {
+		t.Fatalf("StartOAuthLogin: %v", err)
+	}
+	code, state, err := idp.Authorize(start.GetAuthorizeUrl())
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	if state != start.GetState() {
+		t.Fatalf("state = %q, want %q", state, start.GetState())
+	}
+	return &authpb.CompleteOAuthLoginRequest{
+		Provider: "fake", Code: code, State: state,
+		CodeVerifier: start.GetCodeVerifier(), RedirectUri: redirectURI,
+	}
+}
+
+func TestOAuthLogin(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		identity oauth.Identity
+		// tamper changes the completion request before it is sent.
+		tamper      func(req *authpb.CompleteOAuthLoginRequest)
+		wait        time.Duration
+		returning   bool
+		wantCode    codes.Code
+		wantReason  apierrors.Reason
+		wantCreated bool
+		wantEmail   string
+		wantLinks   int
+	}{
+		{
+			name:        "new account",
+			identity:    oauth.Identity{Subject: "s-1", Email: "new@example.com", EmailVerified: true, Name: "New"},
+			wantCreated: true,
+			wantEmail:   "new@example.com",
+			wantLinks:   1,
+		},
+		{
+			name:      "links verified email",
+			identity:  oauth.Identity{Subject: "s-2", Email: "user@example.com", EmailVerified: true},
+			wantEmail: "user@example.com",
+			wantLinks: 1,
+		},
+		{
+			name:      "returning identity",
+			identity:  oauth.Identity{Subject: "s-1", Email: "new@example.com", EmailVerified: true},
+			returning: true,
+			wantEmail: "new@example.com",
+			wantLinks: 1,
+		},
+		{
+			name:       "unverified email on existing account",
+			identity:   oauth.Identity{Subject: "s-3", Email: "user@example.com"},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: apierrors.ReasonEmailNotVerified,
+		},
+		{
+			name:       "unknown state",
+			identity:   oauth.Identity{Subject: "s-4", Email: "new@example.com", EmailVerified: true},
+			tamper:     func(req *authpb.CompleteOAuthLoginRequest) { req.State = "forged" },
+			wantCode:   codes.InvalidArgument,
+			wantReason: apierrors.ReasonTokenInvalid,
+		},
+		{
+			name:       "expired state",
+			identity:   oauth.Identity{Subject: "s-5", Email: "new@example.com", EmailVerified: true},
+			wait:       11 * time.Minute,
+			wantCode:   codes.InvalidArgument,
+			wantReason: apierrors.ReasonTokenInvalid,
+		},
+		{
+			name:       "wrong verifier",
+			identity:   oauth.Identity{Subject: "s-6", Email: "new@example.com", EmailVerified: true},
+			tamper:     func(req *authpb.CompleteOAuthLoginRequest) { req.CodeVerifier = "not-the-verifier" },
+			wantCode:   codes.Unauthenticated,
+			wantReason: apierrors.ReasonInvalidCredentials,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := newTestClock()
+			env := fakes.MustStart(t, fakes.Options{Clock: clock.Now})
+			p := principals(t, env)
+
+			idp := oauth.NewFakeIDP("client-1")
+			srv := httptest.NewServer(idp)
+			defer srv.Close()
+			idp.SetIdentity(tt.identity)
+			env.Auth.OAuth.Register(idp.Provider("fake", srv.URL, srv.Client()))
+
+			ctx := context.Background()
+			if tt.returning {
+				if _, err := env.AuthClient.CompleteOAuthLogin(ctx, startOAuth(t, env, idp)); err != nil {
+					t.Fatalf("first CompleteOAuthLogin: %v", err)
+				}
+			}
+			req := startOAuth(t, env, idp)
+			clock.Advance(tt.wait)
+			if tt.tamper != nil {
+				tt.tamper(req)
+			}
+			resp, err := env.AuthClient.CompleteOAuthLogin(ctx, req)
+			if status.Code(err) != tt.wantCode || apierrors.ReasonOf(err) != tt.wantReason {
+				t.Fatalf("CompleteOAuthLogin = %v, want %v %s", err, tt.wantCode, tt.wantReason)
+			}
+			if err != nil {
+				return
+			}
+			if resp.GetCreated() != tt.wantCreated {
+				t.Errorf("Created = %v, want %v", resp.GetCreated(), tt.wantCreated)
+			}
+
+			v, err := env.AuthClient.ValidateToken(ctx, &authpb.ValidateTokenRequest{Token: resp.GetTokens().GetAccessToken()})
+			if err != nil || !v.GetIsValid() {
+				t.Fatalf("ValidateToken = %v, %v", v, err)
+			}
+			u, err := env.AuthClient.GetUser(fakes.WithToken(ctx, p.Admin.AccessToken),
+				&authpb.GetUserRequest{UserId: v.GetUserId().GetValue()})
+			if err != nil {
+				t.Fatalf("GetUser: %v", err)
+			}
+			if u.GetEmail() != tt.wantEmail || len(u.GetLinkedIdentities()) != tt.wantLinks {
+				t.Errorf("user = %s with %d identities, want %s with %d",
+					u.GetEmail(), len(u.GetLinkedIdentities()), tt.wantEmail, tt.wantLinks)
+			}
+		})
+	}
+}
+
+func TestStartOAuthLoginUnknownProvider(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	_, err := env.AuthClient.StartOAuthLogin(context.Background(), &authpb.StartOAuthLoginRequest{
+		Provider: "facebook", RedirectUri: "https://app.example.com/callback",
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("StartOAuthLogin = %v, want NotFound", err)
+	}
+}
@@ -0,0 +1,275 @@
+package fakes
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+	"github.com/JunBSer/services_proto/hotel/media"
+)
+
+func photoNotFound() error {
+	return apierrors.New(codes.NotFound, apierrors.ReasonPhotoNotFound, "photo not found")
+}
+
+func uploadNotFound() error {
+	return apierrors.New(codes.NotFound, apierrors.ReasonUploadNotFound, "upload not found or expired")
+}
+
+// photoOwner returns the hotel and, when roomID is set, the room whose photos
+// a request targets. s.mu must be held.
+func (s *HotelServer) photoOwner(hotelID, roomID string) (*hotelpb.Hotel, *hotelpb.Room, error) {
+	h, ok := s.hotels[hotelID]
+	if !ok {
+		return nil, nil, hotelNotFound()
+	}
+	if roomID == "" {
+		return h, nil, nil
+	}
+	_, r := findRoom(h, roomID)
+	if r == nil {
+		return nil, nil, roomNotFound()
+	}
+	return h, r, nil
+}
+
+// updatePhotos applies fn to the photos of h or r, renumbers their positions
+// and records the change in the catalog. s.mu must be held.
+func (s *HotelServer) updatePhotos(h *hotelpb.Hotel, r *hotelpb.Room, fn func([]*hotelpb.Photo) []*hotelpb.Photo) []*hotelpb.Photo {
+	if r != nil {
+		before := proto.Clone(r).(*hotelpb.Room)
+		r.Photos = renumber(fn(r.Photos))
+		s.Catalog.RoomChanged(h.GetId(), hotelpb.ChangeType_CHANGE_TYPE_UPDATED, before, r)
+		return r.Photos
+	}
+	before := proto.Clone(h).(*hotelpb.Hotel)
+	h.Photos = renumber(fn(h.Photos))
+	s.Catalog.HotelChanged(hotelpb.ChangeType_CHANGE_TYPE_UPDATED, before, h)
+	return h.Photos
+}
+
+func renumber(photos []*hotelpb.Photo) []*hotelpb.Photo {
+	for i, p := range photos {
+		p.Position = int32(i)
+	}
+	return photos
+}
+
+// photoKeys returns the blob keys of photos. Photo IDs are the IDs of the
+// uploads they were attached from.
+func photoKeys(hotelID, roomID string, photos []*hotelpb.Photo) []string {
+	keys := make([]string, len(photos))
+	for i, p := range photos {
+		keys[i] = media.Key(hotelID, roomID, p.GetId(), p.GetContentType())
+	}
+	return keys
+}
+
+// deleteBlobs removes the images of deleted photos. Failures only leave
+// unreferenced blobs behind.
+func (s *HotelServer) deleteBlobs(ctx context.Context, keys []string) {
+	for _, k := range keys {
+		_ = s.Blobs.Delete(context.WithoutCancel(ctx), k)
+	}
+}
+
+func (s *HotelServer) CreateUploadSession(_ context.Context, req *hotelpb.CreateUploadSessionRequest) (*hotelpb.UploadSession, error) {
+	if err := media.CheckUpload(req.GetContentType(), req.GetSizeBytes(), req.GetSha256()); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, _, err := s.photoOwner(req.GetHotelId(), req.GetRoomId()); err != nil {
+		return nil, err
+	}
+	now := s.now()
+	for id, u := range s.uploads {
+		if now.After(u.expires) {
+			delete(s.uploads, id)
+		}
+	}
+
+	id := newUUID()
+	u := &upload{
+		hotelID: req.GetHotelId(),
+		roomID:  req.GetRoomId(),
+		expect:  media.Expect{ContentType: req.GetContentType(), Size: req.GetSizeBytes(), SHA256: req.GetSha256()},
+		expires: now.Add(uploadTTL),
+	}
+	s.uploads[id] = u
+	return &hotelpb.UploadSession{
+		UploadId:      id,
+		ExpiresAt:     timestamppb.New(u.expires),
+		MaxChunkBytes: media.MaxChunkBytes,
+	}, nil
+}
+
+// claimUpload marks an upload as being received so concurrent streams for it
+// are rejected.
+func (s *HotelServer) claimUpload(id string) (*upload, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.uploads[id]
+	if !ok || s.now().After(u.expires) {
+		return nil, uploadNotFound()
+	}
+	if u.receiving || u.result != nil {
+		return nil, apierrors.New(codes.FailedPrecondition, apierrors.ReasonUploadNotFound, "upload already received")
+	}
+	u.receiving = true
+	return u, nil
+}
+
+func (s *HotelServer) UploadPhoto(stream hotelpb.HotelService_UploadPhotoServer) error {
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) || (err == nil && first.GetUploadId() == "") {
+		return apierrors.Validation("invalid upload",
+			apierrors.FieldViolation{Field: "upload_id", Description: "is required in the first message"})
+	}
+	if err != nil {
+		return err
+	}
+	id := first.GetUploadId()
+	u, err := s.claimUpload(id)
+	if err != nil {
+		return err
+	}
+
+	pending := first
+	next := func() (media.Chunk, error) {
+		m := pending
+		if m != nil {
+			pending = nil
+		} else {
+			var err error
+			if m, err = stream.Recv(); err != nil {
+				return media.Chunk{}, err
+			}
+		}
+		if m.GetUploadId() != "" && m.GetUploadId() != id {
+			return media.Chunk{}, apierrors.Validation("invalid upload",
+				apierrors.FieldViolation{Field: "upload_id", Description: "must not change within a stream"})
+		}
+		return media.Chunk{Offset: m.GetOffset(), Data: m.GetData()}, nil
+	}
+	key := media.Key(u.hotelID, u.roomID, id, u.expect.ContentType)
+	res, err := media.Receive(stream.Context(), s.Blobs, key, u.expect, next)
+
+	s.mu.Lock()
+	u.receiving = false
+	if err == nil {
+		u.result = &res
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(&hotelpb.UploadPhotoResponse{
+		UploadId: id, SizeBytes: res.Size, Width: int32(res.Width), Height: int32(res.Height),
+	})
+}
+
+func (s *HotelServer) AttachPhoto(_ context.Context, req *hotelpb.AttachPhotoRequest) (*hotelpb.Photo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h, r, err := s.photoOwner(req.GetHotelId(), req.GetRoomId())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := s.uploads[req.GetUploadId()]
+	if !ok || u.hotelID != req.GetHotelId() || u.roomID != req.GetRoomId() || s.now().After(u.expires) {
+		return nil, uploadNotFound()
+	}
+	if u.result == nil {
+		return nil, apierrors.New(codes.FailedPrecondition, apierrors.ReasonUploadNotFound, "upload has not been received yet")
+	}
+	delete(s.uploads, req.GetUploadId())
+
+	p := &hotelpb.Photo{
+		Id:          req.GetUploadId(),
+		Url:         s.Blobs.URL(media.Key(u.hotelID, u.roomID, req.GetUploadId(), u.expect.ContentType)),
+		Caption:     req.GetCaption(),
+		Width:       int32(u.result.Width),
+		Height:      int32(u.result.Height),
+		ContentType: u.expect.ContentType,
+		SizeBytes:   u.result.Size,
+	}
+	s.updatePhotos(h, r, func(photos []*hotelpb.Photo) []*hotelpb.Photo { return append(photos, p) })
+	return proto.Clone(p).(*hotelpb.Photo), nil
+}
+
+func (s *HotelServer) ReorderPhotos(_ context.Context, req *hotelpb.ReorderPhotosRequest) (*hotelpb.PhotoList, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h, r, err := s.photoOwner(req.GetHotelId(), req.GetRoomId())
+	if err != nil {
+		return nil, err
+	}
+	current := h.GetPhotos()
+	if r != nil {
+		current = r.GetPhotos()
+	}
+
+	byID := make(map[string]*hotelpb.Photo, len(current))
+	for _, p := range current {
+		byID[p.GetId()] = p
+	}
+	ordered := make([]*hotelpb.Photo, 0, len(current))
+	for _, id := range req.GetPhotoIds() {
+		p, ok := byID[id]
+		if !ok {
+			return nil, apierrors.Validation("invalid order",
+				apierrors.FieldViolation{Field: "photo_ids", Description: "unknown or repeated photo " + id})
+		}
+		delete(byID, id)
+		ordered = append(ordered, p)
+	}
+	if len(byID) > 0 {
+		return nil, apierrors.Validation("invalid order",
+			apierrors.FieldViolation{Field: "photo_ids", Description: "must list every photo"})
+	}
+
+	photos := s.updatePhotos(h, r, func([]*hotelpb.Photo) []*hotelpb.Photo { return ordered })
+	out := &hotelpb.PhotoList{}
+	for _, p := range photos {
+		out.Photos = append(out.Photos, proto.Clone(p).(*hotelpb.Photo))
+	}
+	return out, nil
+}
+
+func (s *HotelServer) DeletePhoto(ctx context.Context, req *hotelpb.DeletePhotoRequest) (*hotelpb.DeleteResponse, error) {
+	var orphaned []string
+	defer func() { s.deleteBlobs(ctx, orphaned) }()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h, r, err := s.photoOwner(req.GetHotelId(), req.GetRoomId())
+	if err != nil {
+		return nil, err
+	}
+	current := h.GetPhotos()
+	if r != nil {
+		current = r.GetPhotos()
+	}
+	i := slices.IndexFunc(current, func(p *hotelpb.Photo) bool { return p.GetId() == req.GetPhotoId() })
+	if i < 0 {
+		return nil, photoNotFound()
+	}
+
+	orphaned = photoKeys(req.GetHotelId(), req.GetRoomId(), current[i:i+1])
+	s.updatePhotos(h, r, func(photos []*hotelpb.Photo) []*hotelpb.Photo { return slices.Delete(photos, i, i+1) })
+	return &hotelpb.DeleteResponse{Success: true}, nil
+}
@@ -0,0 +1,192 @@
+package fakes
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+	"github.com/JunBSer/services_proto/hotel/pricing"
+	"github.com/JunBSer/services_proto/hotel/rates"
+)
+
+func pricingRuleNotFound() error {
+	return apierrors.New(codes.NotFound, apierrors.ReasonPricingRuleNotFound, "pricing rule not found")
+}
+
+// pricingRule returns the pricing rule id of hotelID. s.mu must be held.
+func (s *HotelServer) pricingRule(hotelID, id string) (*hotelpb.PricingRule, error) {
+	if _, ok := s.hotels[hotelID]; !ok {
+		return nil, hotelNotFound()
+	}
+	r, ok := s.pricingRules[id]
+	if !ok || r.GetHotelId() != hotelID {
+		return nil, pricingRuleNotFound()
+	}
+	return r, nil
+}
+
+// hotelPricingRules returns the pricing rules of a hotel in the order they
+// apply. s.mu must be held.
+func (s *HotelServer) hotelPricingRules(hotelID string) []*hotelpb.PricingRule {
+	var out []*hotelpb.PricingRule
+	for _, r := range s.pricingRules {
+		if r.GetHotelId() == hotelID {
+			out = append(out, r)
+		}
+	}
+	pricing.Sort(out)
+	return out
+}
+
+// pricingEngine returns an engine for the hotel's current rules.
+func (s *HotelServer) pricingEngine(hotelID string) *pricing.Engine {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rules := s.hotelPricingRules(hotelID)
+	for i, r := range rules {
+		rules[i] = proto.Clone(r).(*hotelpb.PricingRule)
+	}
+	return pricing.NewEngine(rules)
+}
+
+// quote prices a stay in a room as of now, for QuotePrice and the booking
+// fake. Without a rate plan the room's own price applies.
+func (s *HotelServer) quote(hotelID, roomID, ratePlanID string, start, end, now time.Time) (pricing.Quote, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	h, ok := s.hotels[hotelID]
+	if !ok {
+		return pricing.Quote{}, hotelNotFound()
+	}
+	_, r := findRoom(h, roomID)
+	if r == nil {
+		return pricing.Quote{}, roomNotFound()
+	}
+	stay := pricing.Stay{
+		Start: start, End: end, RoomTypeID: r.GetRoomTypeId(), BasePrice: r.GetPricePerNight(), QuotedAt: now,
+	}
+	if ratePlanID != "" {
+		p, err := s.ratePlan(hotelID, ratePlanID)
+		if err != nil {
+			return pricing.Quote{}, err
+		}
+		if err := rates.Check(p, r.GetRoomTypeId(), rates.Nights(start, end)); err != nil {
+			return pricing.Quote{}, err
+		}
+		stay.Plan = p
+	}
+	return pricing.NewEngine(s.hotelPricingRules(hotelID)).Quote(stay)
+}
+
+func (s *HotelServer) QuotePrice(_ context.Context, req *hotelpb.QuotePriceRequest) (*hotelpb.QuotePriceResponse, error) {
+	start, end, err := stayRange(req.GetStartDate(), req.GetEndDate())
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	q, err := s.quote(req.GetHotelId(), req.GetRoomId(), req.GetRatePlanId(), start, end, now)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &hotelpb.QuotePriceResponse{TotalPrice: q.Total, QuotedAt: timestamppb.New(now)}
+	for _, n := range q.Nights {
+		resp.Nights = append(resp.Nights, &hotelpb.NightlyPrice{
+			Date: timestamppb.New(n.Date), BasePrice: n.BasePrice, Price: n.Price, PricingRuleIds: n.RuleIDs,
+		})
+	}
+	return resp, nil
+}
+
+func (s *HotelServer) CreatePricingRule(_ context.Context, req *hotelpb.CreatePricingRuleRequest) (*hotelpb.PricingRule, error) {
+	r := proto.Clone(req.GetPricingRule()).(*hotelpb.PricingRule)
+	if r == nil {
+		r = &hotelpb.PricingRule{}
+	}
+	if err := pricing.ValidateRule(r); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.hotels[req.GetHotelId()]; !ok {
+		return nil, hotelNotFound()
+	}
+	if err := s.checkRoomTypes(req.GetHotelId(), r.GetRoomTypeIds(), "invalid pricing rule"); err != nil {
+		return nil, err
+	}
+	r.Id = newUUID()
+	r.HotelId = req.GetHotelId()
+	s.pricingRules[r.GetId()] = r
+	return proto.Clone(r).(*hotelpb.PricingRule), nil
+}
+
+func (s *HotelServer) GetPricingRule(_ context.Context, req *hotelpb.GetPricingRuleRequest) (*hotelpb.PricingRule, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	r, err := s.pricingRule(req.GetHotelId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(r).(*hotelpb.PricingRule), nil
+}
+
+func (s *HotelServer) ListPricingRules(_ context.Context, req *hotelpb.ListPricingRulesRequest) (*hotelpb.PricingRuleList, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.hotels[req.GetHotelId()]; !ok {
+		return nil, hotelNotFound()
+	}
+	out := &hotelpb.PricingRuleList{}
+	for _, r := range s.hotelPricingRules(req.GetHotelId()) {
+		out.PricingRules = append(out.PricingRules, proto.Clone(r).(*hotelpb.PricingRule))
+	}
+	return out, nil
+}
+
+// UpdatePricingRule replaces the whole rule. Bookings already made keep
+// their price.
+func (s *HotelServer) UpdatePricingRule(_ context.Context, req *hotelpb.UpdatePricingRuleRequest) (*hotelpb.PricingRule, error) {
+	r := proto.Clone(req.GetPricingRule()).(*hotelpb.PricingRule)
+	if r == nil {
+		r = &hotelpb.PricingRule{}
+	}
+	if err := pricing.ValidateRule(r); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.pricingRule(req.GetHotelId(), req.GetId()); err != nil {
+		return nil, err
+	}
+	if err := s.checkRoomTypes(req.GetHotelId(), r.GetRoomTypeIds(), "invalid pricing rule"); err != nil {
+		return nil, err
+	}
+	r.Id = req.GetId()
+	r.HotelId = req.GetHotelId()
+	s.pricingRules[r.GetId()] = r
+	return proto.Clone(r).(*hotelpb.PricingRule), nil
+}
+
+func (s *HotelServer) DeletePricingRule(_ context.Context, req *hotelpb.DeletePricingRuleRequest) (*hotelpb.DeleteResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.pricingRule(req.GetHotelId(), req.GetId()); err != nil {
+		return nil, err
+	}
+	delete(s.pricingRules, req.GetId())
+	return &hotelpb.DeleteResponse{Success: true}, nil
+}
@@ -0,0 +1,56 @@
+package fakes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	bookpb "github.com/JunBSer/services_proto/booking/gen/go"
+	"github.com/JunBSer/services_proto/fakes"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+	"github.com/JunBSer/services_proto/hotel/pricing"
+)
+
+func TestStayLengthLimits(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	ctx := fakes.WithToken(context.Background(), principals(t, env).User.AccessToken)
+	start := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	calls := map[string]func(start, end *timestamppb.Timestamp) error{
+		"QuotePrice": func(start, end *timestamppb.Timestamp) error {
+			_, err := env.HotelClient.QuotePrice(ctx, &hotelpb.QuotePriceRequest{HotelId: "h", RoomId: "r", StartDate: start, EndDate: end})
+			return err
+		},
+		"CheckAvailability": func(start, end *timestamppb.Timestamp) error {
+			_, err := env.HotelClient.CheckAvailability(ctx, &hotelpb.AvailabilityRequest{HotelId: "h", StartDate: start, EndDate: end})
+			return err
+		},
+		"CreateBooking": func(start, end *timestamppb.Timestamp) error {
+			_, err := env.BookingClient.CreateBooking(ctx, &bookpb.CreateBookingRequest{HotelId: "h", RoomId: "r", StartDate: start, EndDate: end})
+			return err
+		},
+	}
+	tests := []struct {
+		name string
+		end  time.Time
+	}{
+		{"end before start", start.AddDate(0, 0, -1)},
+		{"zero nights", start},
+		{"over the limit", start.AddDate(0, 0, pricing.MaxNights+1)},
+	}
+	for rpc, call := range calls {
+		for _, tt := range tests {
+			t.Run(rpc+"/"+tt.name, func(t *testing.T) {
+				err := call(timestamppb.New(start), timestamppb.New(tt.end))
+				if status.Code(err) != codes.InvalidArgument || !apierrors.Is(err, apierrors.ReasonInvalidDateRange) {
+					t.Errorf("error = %v, want InvalidArgument with INVALID_DATE_RANGE", err)
+				}
+			})
+		}
+	}
+}
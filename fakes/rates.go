@@ -0,0 +1,285 @@
+package fakes
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+	"github.com/JunBSer/services_proto/hotel/rates"
+)
+
+func roomTypeNotFound() error {
+	return apierrors.New(codes.NotFound, apierrors.ReasonRoomTypeNotFound, "room type not found")
+}
+
+func ratePlanNotFound() error {
+	return apierrors.New(codes.NotFound, apierrors.ReasonRatePlanNotFound, "rate plan not found")
+}
+
+// roomType returns the room type id of hotelID. s.mu must be held.
+func (s *HotelServer) roomType(hotelID, id string) (*hotelpb.RoomType, error) {
+	if _, ok := s.hotels[hotelID]; !ok {
+		return nil, hotelNotFound()
+	}
+	t, ok := s.roomTypes[id]
+	if !ok || t.GetHotelId() != hotelID {
+		return nil, roomTypeNotFound()
+	}
+	return t, nil
+}
+
+// ratePlan returns the rate plan id of hotelID. s.mu must be held.
+func (s *HotelServer) ratePlan(hotelID, id string) (*hotelpb.RatePlan, error) {
+	if _, ok := s.hotels[hotelID]; !ok {
+		return nil, hotelNotFound()
+	}
+	p, ok := s.ratePlans[id]
+	if !ok || p.GetHotelId() != hotelID {
+		return nil, ratePlanNotFound()
+	}
+	return p, nil
+}
+
+// hotelRatePlans returns the rate plans of a hotel ordered by name. s.mu must
+// be held.
+func (s *HotelServer) hotelRatePlans(hotelID string) []*hotelpb.RatePlan {
+	var out []*hotelpb.RatePlan
+	for _, p := range s.ratePlans {
+		if p.GetHotelId() == hotelID {
+			out = append(out, p)
+		}
+	}
+	slices.SortFunc(out, func(a, b *hotelpb.RatePlan) int {
+		return cmp.Or(cmp.Compare(a.GetName(), b.GetName()), cmp.Compare(a.GetId(), b.GetId()))
+	})
+	return out
+}
+
+// checkRoomTypes reports room type IDs of a rate plan or pricing rule that
+// the hotel does not have. s.mu must be held.
+func (s *HotelServer) checkRoomTypes(hotelID string, ids []string, msg string) error {
+	for _, id := range ids {
+		if _, err := s.roomType(hotelID, id); err != nil {
+			return apierrors.Validation(msg,
+				apierrors.FieldViolation{Field: "room_type_ids", Description: "unknown room type " + id})
+		}
+	}
+	return nil
+}
+
+func (s *HotelServer) CreateRoomType(_ context.Context, req *hotelpb.CreateRoomTypeRequest) (*hotelpb.RoomType, error) {
+	t := proto.Clone(req.GetRoomType()).(*hotelpb.RoomType)
+	if t == nil {
+		t = &hotelpb.RoomType{}
+	}
+	if err := rates.ValidateRoomType(t); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.hotels[req.GetHotelId()]; !ok {
+		return nil, hotelNotFound()
+	}
+	t.Id = newUUID()
+	t.HotelId = req.GetHotelId()
+	s.roomTypes[t.GetId()] = t
+	return proto.Clone(t).(*hotelpb.RoomType), nil
+}
+
+func (s *HotelServer) GetRoomType(_ context.Context, req *hotelpb.GetRoomTypeRequest) (*hotelpb.RoomType, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, err := s.roomType(req.GetHotelId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(t).(*hotelpb.RoomType), nil
+}
+
+func (s *HotelServer) ListRoomTypes(_ context.Context, req *hotelpb.ListRoomTypesRequest) (*hotelpb.RoomTypeList, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.hotels[req.GetHotelId()]; !ok {
+		return nil, hotelNotFound()
+	}
+	out := &hotelpb.RoomTypeList{}
+	for _, t := range s.roomTypes {
+		if t.GetHotelId() == req.GetHotelId() {
+			out.RoomTypes = append(out.RoomTypes, proto.Clone(t).(*hotelpb.RoomType))
+		}
+	}
+	slices.SortFunc(out.RoomTypes, func(a, b *hotelpb.RoomType) int {
+		return cmp.Or(cmp.Compare(a.GetName(), b.GetName()), cmp.Compare(a.GetId(), b.GetId()))
+	})
+	return out, nil
+}
+
+// UpdateRoomType overwrites the non-empty fields. Rooms of a renamed type
+// take the new name as their type.
+func (s *HotelServer) UpdateRoomType(_ context.Context, req *hotelpb.UpdateRoomTypeRequest) (*hotelpb.RoomType, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.roomType(req.GetHotelId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	in := req.GetRoomType()
+	next := proto.Clone(t).(*hotelpb.RoomType)
+	if in.GetName() != "" {
+		next.Name = in.GetName()
+	}
+	if in.GetDescription() != "" {
+		next.Description = in.GetDescription()
+	}
+	if in.GetMaxOccupancy() != 0 {
+		next.MaxOccupancy = in.GetMaxOccupancy()
+	}
+	if in.GetBeds() != nil {
+		next.Beds = in.GetBeds()
+	}
+	if in.GetSizeSqm() != 0 {
+		next.SizeSqm = in.GetSizeSqm()
+	}
+	if in.GetAmenities() != nil {
+		next.Amenities = slices.Clone(in.GetAmenities())
+	}
+	next = proto.Clone(next).(*hotelpb.RoomType)
+	if err := rates.ValidateRoomType(next); err != nil {
+		return nil, err
+	}
+	s.roomTypes[next.GetId()] = next
+
+	if next.GetName() != t.GetName() {
+		h := s.hotels[req.GetHotelId()]
+		for _, r := range h.GetRooms() {
+			if r.GetRoomTypeId() == next.GetId() {
+				before := proto.Clone(r).(*hotelpb.Room)
+				r.Type = next.GetName()
+				s.Catalog.RoomChanged(h.GetId(), hotelpb.ChangeType_CHANGE_TYPE_UPDATED, before, r)
+			}
+		}
+	}
+	return proto.Clone(next).(*hotelpb.RoomType), nil
+}
+
+// DeleteRoomType refuses to delete a type that rooms, rate plans or pricing
+// rules still reference.
+func (s *HotelServer) DeleteRoomType(_ context.Context, req *hotelpb.DeleteRoomTypeRequest) (*hotelpb.DeleteResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.roomType(req.GetHotelId(), req.GetId()); err != nil {
+		return nil, err
+	}
+	inUse := slices.ContainsFunc(s.hotels[req.GetHotelId()].GetRooms(), func(r *hotelpb.Room) bool {
+		return r.GetRoomTypeId() == req.GetId()
+	})
+	for _, p := range s.hotelRatePlans(req.GetHotelId()) {
+		inUse = inUse || slices.Contains(p.GetRoomTypeIds(), req.GetId())
+	}
+	for _, r := range s.hotelPricingRules(req.GetHotelId()) {
+		inUse = inUse || slices.Contains(r.GetRoomTypeIds(), req.GetId())
+	}
+	if inUse {
+		return nil, apierrors.New(codes.FailedPrecondition, apierrors.ReasonRoomTypeInUse,
+			"room type is still used by rooms, rate plans or pricing rules")
+	}
+	delete(s.roomTypes, req.GetId())
+	return &hotelpb.DeleteResponse{Success: true}, nil
+}
+
+func (s *HotelServer) CreateRatePlan(_ context.Context, req *hotelpb.CreateRatePlanRequest) (*hotelpb.RatePlan, error) {
+	p := proto.Clone(req.GetRatePlan()).(*hotelpb.RatePlan)
+	if p == nil {
+		p = &hotelpb.RatePlan{}
+	}
+	if err := rates.ValidateRatePlan(p); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.hotels[req.GetHotelId()]; !ok {
+		return nil, hotelNotFound()
+	}
+	if err := s.checkRoomTypes(req.GetHotelId(), p.GetRoomTypeIds(), "invalid rate plan"); err != nil {
+		return nil, err
+	}
+	p.Id = newUUID()
+	p.HotelId = req.GetHotelId()
+	s.ratePlans[p.GetId()] = p
+	return proto.Clone(p).(*hotelpb.RatePlan), nil
+}
+
+func (s *HotelServer) GetRatePlan(_ context.Context, req *hotelpb.GetRatePlanRequest) (*hotelpb.RatePlan, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	p, err := s.ratePlan(req.GetHotelId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(p).(*hotelpb.RatePlan), nil
+}
+
+func (s *HotelServer) ListRatePlans(_ context.Context, req *hotelpb.ListRatePlansRequest) (*hotelpb.RatePlanList, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.hotels[req.GetHotelId()]; !ok {
+		return nil, hotelNotFound()
+	}
+	out := &hotelpb.RatePlanList{}
+	for _, p := range s.hotelRatePlans(req.GetHotelId()) {
+		out.RatePlans = append(out.RatePlans, proto.Clone(p).(*hotelpb.RatePlan))
+	}
+	return out, nil
+}
+
+// UpdateRatePlan replaces the whole plan. Bookings made under it keep the
+// price they were created with.
+func (s *HotelServer) UpdateRatePlan(_ context.Context, req *hotelpb.UpdateRatePlanRequest) (*hotelpb.RatePlan, error) {
+	p := proto.Clone(req.GetRatePlan()).(*hotelpb.RatePlan)
+	if p == nil {
+		p = &hotelpb.RatePlan{}
+	}
+	if err := rates.ValidateRatePlan(p); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.ratePlan(req.GetHotelId(), req.GetId()); err != nil {
+		return nil, err
+	}
+	if err := s.checkRoomTypes(req.GetHotelId(), p.GetRoomTypeIds(), "invalid rate plan"); err != nil {
+		return nil, err
+	}
+	p.Id = req.GetId()
+	p.HotelId = req.GetHotelId()
+	s.ratePlans[p.GetId()] = p
+	return proto.Clone(p).(*hotelpb.RatePlan), nil
+}
+
+func (s *HotelServer) DeleteRatePlan(_ context.Context, req *hotelpb.DeleteRatePlanRequest) (*hotelpb.DeleteResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.ratePlan(req.GetHotelId(), req.GetId()); err != nil {
+		return nil, err
+	}
+	delete(s.ratePlans, req.GetId())
+	return &hotelpb.DeleteResponse{Success: true}, nil
+}
@@ -0,0 +1,288 @@
+package fakes_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	"github.com/JunBSer/services_proto/fakes"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+)
+
+// rateFixture is a hotel with King and Twin room types, a room of each plus
+// one without a type, and three rate plans.
+type rateFixture struct {
+	hotel                        string
+	king, twin                   string
+	kingRoom, twinRoom, oldRoom  string
+	flexible, breakfast, retired string
+}
+
+func newRateFixture(t *testing.T, env *fakes.Env, admin context.Context) rateFixture {
+	t.Helper()
+
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	var f rateFixture
+	h, err := env.HotelClient.CreateHotel(admin, &hotelpb.CreateHotelRequest{Name: "Rates", Address: "1 Main St"})
+	must(err)
+	f.hotel = h.GetId()
+
+	roomType := func(name string) string {
+		rt, err := env.HotelClient.CreateRoomType(admin, &hotelpb.CreateRoomTypeRequest{
+			HotelId: f.hotel, RoomType: &hotelpb.RoomType{Name: name, MaxOccupancy: 2},
+		})
+		must(err)
+		return rt.GetId()
+	}
+	f.king, f.twin = roomType("King"), roomType("Twin")
+
+	room := func(typeID, typ string, price float64) string {
+		r, err := env.HotelClient.AddRoom(admin, &hotelpb.AddRoomRequest{
+			HotelId: f.hotel, RoomTypeId: typeID, Type: typ, PricePerNight: price,
+		})
+		must(err)
+		return r.GetId()
+	}
+	f.kingRoom, f.twinRoom, f.oldRoom = room(f.king, "", 100), room(f.twin, "", 80), room("", "Legacy", 60)
+
+	plan := func(p *hotelpb.RatePlan) string {
+		created, err := env.HotelClient.CreateRatePlan(admin, &hotelpb.CreateRatePlanRequest{HotelId: f.hotel, RatePlan: p})
+		must(err)
+		return created.GetId()
+	}
+	f.flexible = plan(&hotelpb.RatePlan{Name: "Flexible", Active: true})
+	f.breakfast = plan(&hotelpb.RatePlan{
+		Name: "Breakfast", Active: true, RoomTypeIds: []string{f.king}, MealPlan: hotelpb.MealPlan_MEAL_PLAN_BREAKFAST,
+		PriceMultiplier: 1.1, NightlySupplement: 10, MinNights: 2,
+	})
+	f.retired = plan(&hotelpb.RatePlan{Name: "Retired"})
+	return f
+}
+
+func TestAvailabilityRateOffers(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	p := principals(t, env)
+	f := newRateFixture(t, env, fakes.WithToken(context.Background(), p.Admin.AccessToken))
+	user := fakes.WithToken(context.Background(), p.User.AccessToken)
+	start := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	// Offers are compared as plan/type=price.
+	name := map[string]string{f.king: "King", f.twin: "Twin", f.flexible: "Flexible", f.breakfast: "Breakfast"}
+	tests := []struct {
+		name       string
+		nights     int
+		ratePlan   string
+		roomType   string
+		wantRooms  int
+		wantTotal  float64
+		wantOffers []string
+		wantCode   codes.Code
+		wantReason apierrors.Reason
+	}{
+		{
+			name: "all plans", nights: 2, wantRooms: 3, wantTotal: 120,
+			wantOffers: []string{"Breakfast/King=240", "Flexible/King=200", "Flexible/Twin=160"},
+		},
+		{
+			name: "shorter than minimum stay", nights: 1, wantRooms: 3, wantTotal: 60,
+			wantOffers: []string{"Flexible/King=100", "Flexible/Twin=80"},
+		},
+		{
+			name: "one plan", nights: 2, ratePlan: f.breakfast, wantRooms: 1, wantTotal: 240,
+			wantOffers: []string{"Breakfast/King=240"},
+		},
+		{name: "one plan, too short", nights: 1, ratePlan: f.breakfast},
+		{
+			name: "one room type", nights: 2, roomType: f.twin, wantRooms: 1, wantTotal: 160,
+			wantOffers: []string{"Flexible/Twin=160"},
+		},
+		{
+			name: "inactive plan", nights: 2, ratePlan: f.retired,
+			wantCode: codes.FailedPrecondition, wantReason: apierrors.ReasonRatePlanUnavailable,
+		},
+		{
+			name: "unknown plan", nights: 2, ratePlan: "missing",
+			wantCode: codes.NotFound, wantReason: apierrors.ReasonRatePlanNotFound,
+		},
+		{
+			name: "unknown room type", nights: 2, roomType: "missing",
+			wantCode: codes.NotFound, wantReason: apierrors.ReasonRoomTypeNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := env.HotelClient.CheckAvailability(user, &hotelpb.AvailabilityRequest{
+				HotelId: f.hotel, StartDate: timestamppb.New(start), EndDate: timestamppb.New(start.AddDate(0, 0, tt.nights)),
+				RatePlanId: tt.ratePlan, RoomTypeId: tt.roomType,
+			})
+			if status.Code(err) != tt.wantCode || apierrors.ReasonOf(err) != tt.wantReason {
+				t.Fatalf("CheckAvailability = %v, want %v %s", err, tt.wantCode, tt.wantReason)
+			}
+			if err != nil {
+				return
+			}
+			if len(resp.GetAvailableRooms()) != tt.wantRooms || resp.GetIsAvailable() != (tt.wantRooms > 0) {
+				t.Errorf("%d rooms available (%v), want %d", len(resp.GetAvailableRooms()), resp.GetIsAvailable(), tt.wantRooms)
+			}
+			if resp.GetTotalPrice() != tt.wantTotal {
+				t.Errorf("TotalPrice = %v, want %v", resp.GetTotalPrice(), tt.wantTotal)
+			}
+			var offers []string
+			for _, o := range resp.GetOffers() {
+				offers = append(offers, fmt.Sprintf("%s/%s=%v", name[o.GetRatePlanId()], name[o.GetRoomTypeId()], o.GetTotalPrice()))
+			}
+			if !slices.Equal(offers, tt.wantOffers) {
+				t.Errorf("offers = %v, want %v", offers, tt.wantOffers)
+			}
+		})
+	}
+}
+
+func TestQuoteUnderRatePlan(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	p := principals(t, env)
+	f := newRateFixture(t, env, fakes.WithToken(context.Background(), p.Admin.AccessToken))
+	user := fakes.WithToken(context.Background(), p.User.AccessToken)
+	start := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		room      string
+		ratePlan  string
+		wantTotal float64
+		wantCode  codes.Code
+	}{
+		{"base price", f.kingRoom, "", 200, codes.OK},
+		{"plan rules", f.kingRoom, f.breakfast, 240, codes.OK},
+		{"plan for any type", f.twinRoom, f.flexible, 160, codes.OK},
+		{"plan not sold for type", f.twinRoom, f.breakfast, 0, codes.FailedPrecondition},
+		{"room without type", f.oldRoom, f.flexible, 0, codes.FailedPrecondition},
+		{"inactive plan", f.kingRoom, f.retired, 0, codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := env.HotelClient.QuotePrice(user, &hotelpb.QuotePriceRequest{
+				HotelId: f.hotel, RoomId: tt.room, RatePlanId: tt.ratePlan,
+				StartDate: timestamppb.New(start), EndDate: timestamppb.New(start.AddDate(0, 0, 2)),
+			})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("QuotePrice = %v, want %v", err, tt.wantCode)
+			}
+			if err == nil && (resp.GetTotalPrice() != tt.wantTotal || len(resp.GetNights()) != 2) {
+				t.Errorf("quote = %v over %d nights, want %v over 2", resp.GetTotalPrice(), len(resp.GetNights()), tt.wantTotal)
+			}
+			if tt.wantCode == codes.FailedPrecondition && apierrors.ReasonOf(err) != apierrors.ReasonRatePlanUnavailable {
+				t.Errorf("reason = %s, want %s", apierrors.ReasonOf(err), apierrors.ReasonRatePlanUnavailable)
+			}
+		})
+	}
+}
+
+func TestRoomTypeReferences(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	p := principals(t, env)
+	admin := fakes.WithToken(context.Background(), p.Admin.AccessToken)
+	f := newRateFixture(t, env, admin)
+	other, err := env.HotelClient.CreateHotel(admin, &hotelpb.CreateHotelRequest{Name: "Other", Address: "2 Main St"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		call       func() error
+		wantCode   codes.Code
+		wantReason apierrors.Reason
+	}{
+		{
+			name: "delete type used by a room",
+			call: func() error {
+				_, err := env.HotelClient.DeleteRoomType(admin, &hotelpb.DeleteRoomTypeRequest{HotelId: f.hotel, Id: f.twin})
+				return err
+			},
+			wantCode: codes.FailedPrecondition, wantReason: apierrors.ReasonRoomTypeInUse,
+		},
+		{
+			name: "plan with unknown room type",
+			call: func() error {
+				_, err := env.HotelClient.CreateRatePlan(admin, &hotelpb.CreateRatePlanRequest{
+					HotelId: f.hotel, RatePlan: &hotelpb.RatePlan{Name: "Bad", RoomTypeIds: []string{"missing"}},
+				})
+				return err
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "plan with another hotel's room type",
+			call: func() error {
+				_, err := env.HotelClient.CreateRatePlan(admin, &hotelpb.CreateRatePlanRequest{
+					HotelId: other.GetId(), RatePlan: &hotelpb.RatePlan{Name: "Bad", RoomTypeIds: []string{f.king}},
+				})
+				return err
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "room with another hotel's room type",
+			call: func() error {
+				_, err := env.HotelClient.AddRoom(admin, &hotelpb.AddRoomRequest{HotelId: other.GetId(), RoomTypeId: f.king, PricePerNight: 50})
+				return err
+			},
+			wantCode: codes.NotFound, wantReason: apierrors.ReasonRoomTypeNotFound,
+		},
+		{
+			name: "invalid room type",
+			call: func() error {
+				_, err := env.HotelClient.CreateRoomType(admin, &hotelpb.CreateRoomTypeRequest{HotelId: f.hotel, RoomType: &hotelpb.RoomType{Name: "Empty"}})
+				return err
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "user cannot create plans",
+			call: func() error {
+				_, err := env.HotelClient.CreateRatePlan(fakes.WithToken(context.Background(), p.User.AccessToken),
+					&hotelpb.CreateRatePlanRequest{HotelId: f.hotel, RatePlan: &hotelpb.RatePlan{Name: "Mine"}})
+				return err
+			},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if status.Code(err) != tt.wantCode || (tt.wantReason != "" && apierrors.ReasonOf(err) != tt.wantReason) {
+				t.Errorf("got %v, want %v %s", err, tt.wantCode, tt.wantReason)
+			}
+		})
+	}
+
+	// Renaming a type renames its rooms.
+	if _, err := env.HotelClient.UpdateRoomType(admin, &hotelpb.UpdateRoomTypeRequest{
+		HotelId: f.hotel, Id: f.king, RoomType: &hotelpb.RoomType{Name: "Deluxe King"},
+	}); err != nil {
+		t.Fatalf("UpdateRoomType: %v", err)
+	}
+	rooms, err := env.HotelClient.ListRooms(admin, &hotelpb.ListRoomsRequest{HotelId: f.hotel})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range rooms.GetRooms() {
+		want := map[string]string{f.kingRoom: "Deluxe King", f.twinRoom: "Twin", f.oldRoom: "Legacy"}[r.GetId()]
+		if r.GetType() != want {
+			t.Errorf("room %s type = %q, want %q", r.GetId(), r.GetType(), want)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package fakes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/auth/reauth"
+	"github.com/JunBSer/services_proto/fakes"
+)
+
+func TestReauthentication(t *testing.T) {
+	const email, password = "user@example.com", "user-password"
+
+	tests := []struct {
+		name string
+		// stepUp returns the step-up token sent with ChangePassword by the
+		// session current, or "" for none.
+		stepUp     func(t *testing.T, env *fakes.Env, clock *testClock, current *authpb.JWTPair) string
+		wantCode   codes.Code
+		wantReason apierrors.Reason
+	}{
+		{
+			name: "fresh token",
+			stepUp: func(t *testing.T, env *fakes.Env, _ *testClock, current *authpb.JWTPair) string {
+				return stepUp(t, env, current, password)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:       "no token",
+			stepUp:     func(*testing.T, *fakes.Env, *testClock, *authpb.JWTPair) string { return "" },
+			wantCode:   codes.Unauthenticated,
+			wantReason: apierrors.ReasonReauthRequired,
+		},
+		{
+			name: "expired token",
+			stepUp: func(t *testing.T, env *fakes.Env, clock *testClock, current *authpb.JWTPair) string {
+				tok := stepUp(t, env, current, password)
+				clock.Advance(6 * time.Minute)
+				return tok
+			},
+			wantCode:   codes.Unauthenticated,
+			wantReason: apierrors.ReasonReauthRequired,
+		},
+		{
+			name: "another user's token",
+			stepUp: func(t *testing.T, env *fakes.Env, _ *testClock, _ *authpb.JWTPair) string {
+				return stepUp(t, env, login(t, env, "other@example.com", password), password)
+			},
+			wantCode:   codes.Unauthenticated,
+			wantReason: apierrors.ReasonReauthRequired,
+		},
+		{
+			name: "token of an ended session",
+			stepUp: func(t *testing.T, env *fakes.Env, _ *testClock, _ *authpb.JWTPair) string {
+				old := login(t, env, email, password)
+				tok := stepUp(t, env, old, password)
+				if _, err := env.AuthClient.Logout(fakes.WithToken(context.Background(), old.GetAccessToken()),
+					&authpb.LogoutRequest{RefreshToken: old.GetRefreshToken()}); err != nil {
+					t.Fatalf("Logout: %v", err)
+				}
+				return tok
+			},
+			wantCode:   codes.Unauthenticated,
+			wantReason: apierrors.ReasonReauthRequired,
+		},
+		{
+			name: "access token instead of step-up token",
+			stepUp: func(_ *testing.T, _ *fakes.Env, _ *testClock, current *authpb.JWTPair) string {
+				return current.GetAccessToken()
+			},
+			wantCode:   codes.Unauthenticated,
+			wantReason: apierrors.ReasonReauthRequired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := newTestClock()
+			env := fakes.MustStart(t, fakes.Options{Clock: clock.Now})
+			principals(t, env)
+			current := login(t, env, email, password)
+
+			ctx := fakes.WithToken(context.Background(), current.GetAccessToken())
+			if tok := tt.stepUp(t, env, clock, current); tok != "" {
+				ctx = reauth.WithToken(ctx, tok)
+			}
+			_, err := env.AuthClient.ChangePassword(ctx,
+				&authpb.ChangePasswordRequest{OldPassword: password, NewPassword: "new-" + password})
+			if status.Code(err) != tt.wantCode || apierrors.ReasonOf(err) != tt.wantReason {
+				t.Errorf("ChangePassword = %v, want %v %s", err, tt.wantCode, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestReauthenticate(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	p := principals(t, env)
+	ctx := context.Background()
+	service := serviceToken(t, env, p.Admin)
+
+	tests := []struct {
+		name       string
+		token      string
+		password   string
+		wantCode   codes.Code
+		wantReason apierrors.Reason
+	}{
+		{"user", p.User.AccessToken, "user-password", codes.OK, ""},
+		{"wrong password", p.User.AccessToken, "wrong", codes.Unauthenticated, apierrors.ReasonInvalidCredentials},
+		{"service account", service, "user-password", codes.Unauthenticated, ""},
+	}
+	for _, tt := range tests {
+		resp, err := env.AuthClient.Reauthenticate(fakes.WithToken(ctx, tt.token), &authpb.ReauthenticateRequest{Password: tt.password})
+		if status.Code(err) != tt.wantCode || apierrors.ReasonOf(err) != tt.wantReason {
+			t.Errorf("%s: Reauthenticate = %v, want %v %s", tt.name, err, tt.wantCode, tt.wantReason)
+		}
+		if err == nil && (resp.GetReauthToken() == "" || resp.GetExpiresAt() == nil) {
+			t.Errorf("%s: response = %v", tt.name, resp)
+		}
+	}
+}
+
+// stepUp reauthenticates the session pair and returns the step-up token.
+func stepUp(t *testing.T, env *fakes.Env, pair *authpb.JWTPair, password string) string {
+	t.Helper()
+
+	resp, err := env.AuthClient.Reauthenticate(fakes.WithToken(context.Background(), pair.GetAccessToken()),
+		&authpb.ReauthenticateRequest{Password: password})
+	if err != nil {
+		t.Fatalf("Reauthenticate: %v", err)
+	}
+	return resp.GetReauthToken()
+}
+
+func TestUpdateProfileStepUp(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        *authpb.UpdateProfileRequest
+		wantReason apierrors.Reason
+	}{
+		{"name only", &authpb.UpdateProfileRequest{Name: "Renamed"}, ""},
+		{"current email", &authpb.UpdateProfileRequest{Name: "Renamed", Email: "user@example.com"}, ""},
+		{"current email in another case", &authpb.UpdateProfileRequest{Email: "User@Example.com"}, ""},
+		{"new email", &authpb.UpdateProfileRequest{Email: "new@example.com"}, apierrors.ReasonReauthRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := fakes.MustStart(t, fakes.Options{})
+			p := principals(t, env)
+
+			_, err := env.AuthClient.UpdateProfile(fakes.WithToken(context.Background(), p.User.AccessToken), tt.req)
+			if apierrors.ReasonOf(err) != tt.wantReason || (tt.wantReason == "") != (err == nil) {
+				t.Errorf("UpdateProfile = %v, want reason %q", err, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package fakes_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/fakes"
+)
+
+func createServiceAccount(t *testing.T, env *fakes.Env, adminToken, name string, scopes ...string) *authpb.ServiceAccountCredentials {
+	t.Helper()
+
+	creds, err := env.AuthClient.CreateServiceAccount(fakes.WithToken(context.Background(), adminToken),
+		&authpb.CreateServiceAccountRequest{Name: name, Scopes: scopes})
+	if err != nil {
+		t.Fatalf("CreateServiceAccount: %v", err)
+	}
+	return creds
+}
+
+// issueServiceToken returns a client credentials token for creds.
+func issueServiceToken(t *testing.T, env *fakes.Env, creds *authpb.ServiceAccountCredentials) string {
+	t.Helper()
+
+	resp, err := env.AuthClient.IssueClientCredentialsToken(context.Background(), &authpb.ClientCredentialsRequest{
+		ClientId: creds.GetAccount().GetClientId(), ClientSecret: creds.GetClientSecret(),
+	})
+	if err != nil {
+		t.Fatalf("IssueClientCredentialsToken: %v", err)
+	}
+	return resp.GetAccessToken()
+}
+
+func TestCreateServiceAccount(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	p := principals(t, env)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		token    string
+		account  string
+		wantCode codes.Code
+	}{
+		{"admin", p.Admin.AccessToken, "booking", codes.OK},
+		{"duplicate name", p.Admin.AccessToken, "booking", codes.AlreadyExists},
+		{"missing name", p.Admin.AccessToken, "", codes.InvalidArgument},
+		{"user", p.User.AccessToken, "hotel", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creds, err := env.AuthClient.CreateServiceAccount(fakes.WithToken(ctx, tt.token),
+				&authpb.CreateServiceAccountRequest{Name: tt.account})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("CreateServiceAccount = %v, want %v", err, tt.wantCode)
+			}
+			if err == nil && (creds.GetClientSecret() == "" || creds.GetAccount().GetClientId() == "") {
+				t.Errorf("credentials = %v, want a client ID and secret", creds)
+			}
+		})
+	}
+}
+
+func TestIssueClientCredentialsToken(t *testing.T) {
+	tests := []struct {
+		name string
+		// tamper changes the request before it is sent.
+		tamper     func(req *authpb.ClientCredentialsRequest)
+		revoke     bool
+		wantCode   codes.Code
+		wantReason apierrors.Reason
+		wantScopes []string
+	}{
+		{name: "granted scopes by default", wantScopes: []string{"hotels.read", "hotels.watch"}},
+		{
+			name:       "subset of scopes",
+			tamper:     func(req *authpb.ClientCredentialsRequest) { req.Scopes = []string{"hotels.watch"} },
+			wantScopes: []string{"hotels.watch"},
+		},
+		{
+			name:       "scope not granted",
+			tamper:     func(req *authpb.ClientCredentialsRequest) { req.Scopes = []string{"hotels.write"} },
+			wantCode:   codes.PermissionDenied,
+			wantReason: apierrors.ReasonPermissionDenied,
+		},
+		{
+			name:       "wrong secret",
+			tamper:     func(req *authpb.ClientCredentialsRequest) { req.ClientSecret = "wrong" },
+			wantCode:   codes.Unauthenticated,
+			wantReason: apierrors.ReasonInvalidCredentials,
+		},
+		{
+			name:       "unknown client",
+			tamper:     func(req *authpb.ClientCredentialsRequest) { req.ClientId = "unknown" },
+			wantCode:   codes.Unauthenticated,
+			wantReason: apierrors.ReasonInvalidCredentials,
+		},
+		{
+			name:       "revoked",
+			revoke:     true,
+			wantCode:   codes.Unauthenticated,
+			wantReason: apierrors.ReasonInvalidCredentials,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := fakes.MustStart(t, fakes.Options{})
+			p := principals(t, env)
+			ctx := context.Background()
+			creds := createServiceAccount(t, env, p.Admin.AccessToken, "booking", "hotels.read", "hotels.watch")
+			clientID := creds.GetAccount().GetClientId()
+
+			if tt.revoke {
+				if _, err := env.AuthClient.RevokeServiceAccount(fakes.WithToken(ctx, p.Admin.AccessToken),
+					&authpb.RevokeServiceAccountRequest{ClientId: clientID}); err != nil {
+					t.Fatalf("RevokeServiceAccount: %v", err)
+				}
+			}
+			req := &authpb.ClientCredentialsRequest{ClientId: clientID, ClientSecret: creds.GetClientSecret()}
+			if tt.tamper != nil {
+				tt.tamper(req)
+			}
+			resp, err := env.AuthClient.IssueClientCredentialsToken(ctx, req)
+			if status.Code(err) != tt.wantCode || apierrors.ReasonOf(err) != tt.wantReason {
+				t.Fatalf("IssueClientCredentialsToken = %v, want %v %s", err, tt.wantCode, tt.wantReason)
+			}
+			if err != nil {
+				return
+			}
+			if !slices.Equal(resp.GetScopes(), tt.wantScopes) {
+				t.Errorf("scopes = %v, want %v", resp.GetScopes(), tt.wantScopes)
+			}
+
+			v, err := env.AuthClient.ValidateToken(ctx, &authpb.ValidateTokenRequest{Token: resp.GetAccessToken()})
+			if err != nil {
+				t.Fatalf("ValidateToken: %v", err)
+			}
+			if !v.GetIsValid() || v.GetSubjectType() != authpb.SubjectType_SUBJECT_TYPE_SERVICE ||
+				v.GetClientId() != clientID || v.GetUserId() != nil || !slices.Equal(v.GetScopes(), tt.wantScopes) {
+				t.Errorf("ValidateToken = %v", v)
+			}
+		})
+	}
+}
+
+func TestRotateServiceAccountKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		grace     time.Duration
+		wait      time.Duration
+		oldSecret codes.Code
+	}{
+		{"no grace period", 0, 0, codes.Unauthenticated},
+		{"within grace period", time.Hour, 30 * time.Minute, codes.OK},
+		{"after grace period", time.Hour, 2 * time.Hour, codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := newTestClock()
+			env := fakes.MustStart(t, fakes.Options{Clock: clock.Now})
+			admin := login(t, env, adminEmail, adminPassword)
+			ctx := context.Background()
+			creds := createServiceAccount(t, env, admin.GetAccessToken(), "booking")
+			clientID := creds.GetAccount().GetClientId()
+
+			rotated, err := env.AuthClient.RotateServiceAccountKey(fakes.WithToken(ctx, admin.GetAccessToken()),
+				&authpb.RotateServiceAccountKeyRequest{ClientId: clientID, GracePeriodSeconds: int32(tt.grace.Seconds())})
+			if err != nil {
+				t.Fatalf("RotateServiceAccountKey: %v", err)
+			}
+			if rotated.GetClientSecret() == creds.GetClientSecret() || rotated.GetAccount().GetKeyRotatedAt() == nil {
+				t.Errorf("rotation = %v, want a new secret and KeyRotatedAt", rotated)
+			}
+			clock.Advance(tt.wait)
+
+			for _, c := range []struct {
+				secret string
+				want   codes.Code
+			}{{creds.GetClientSecret(), tt.oldSecret}, {rotated.GetClientSecret(), codes.OK}} {
+				_, err := env.AuthClient.IssueClientCredentialsToken(ctx,
+					&authpb.ClientCredentialsRequest{ClientId: clientID, ClientSecret: c.secret})
+				if status.Code(err) != c.want {
+					t.Errorf("IssueClientCredentialsToken = %v, want %v", err, c.want)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceAuthLevel(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	p := principals(t, env)
+	ctx := context.Background()
+	service := serviceToken(t, env, p.Admin)
+
+	revoked := createServiceAccount(t, env, p.Admin.AccessToken, "retired")
+	revokedToken := issueServiceToken(t, env, revoked)
+	if _, err := env.AuthClient.RevokeServiceAccount(fakes.WithToken(ctx, p.Admin.AccessToken),
+		&authpb.RevokeServiceAccountRequest{ClientId: revoked.GetAccount().GetClientId()}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		token      string
+		wantCode   codes.Code
+		wantReason apierrors.Reason
+	}{
+		{"service", service, codes.OK, ""},
+		{"revoked service", revokedToken, codes.Unauthenticated, apierrors.ReasonServiceAccountRevoked},
+		{"user", p.User.AccessToken, codes.PermissionDenied, apierrors.ReasonPermissionDenied},
+		{"admin", p.Admin.AccessToken, codes.PermissionDenied, apierrors.ReasonPermissionDenied},
+		{"anonymous", "", codes.Unauthenticated, apierrors.ReasonTokenInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			call := ctx
+			if tt.token != "" {
+				call = fakes.WithToken(ctx, tt.token)
+			}
+			_, err := env.AuthClient.PurgeDeletedAccounts(call, &authpb.PurgeDeletedAccountsRequest{})
+			if status.Code(err) != tt.wantCode || apierrors.ReasonOf(err) != tt.wantReason {
+				t.Errorf("PurgeDeletedAccounts = %v, want %v %s", err, tt.wantCode, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,386 @@
+package fakes_test
+
+import (
+	"context"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	userevents "github.com/JunBSer/services_proto/auth/events"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	"github.com/JunBSer/services_proto/fakes"
+)
+
+func TestListUsersFilters(t *testing.T) {
+	clock := newTestClock()
+	// The admin token must outlive the hours the users are spread over.
+	env := fakes.MustStart(t, fakes.Options{Clock: clock.Now, AccessTTL: 24 * time.Hour})
+	ctx := context.Background()
+	start := clock.Now()
+	admin := fakes.WithToken(ctx, login(t, env, adminEmail, adminPassword).GetAccessToken())
+
+	// One user an hour after the seeded admin: alice, bob (suspended),
+	// carol (admin), dave (deleted) and erin (unverified).
+	ids := map[string]string{}
+	for _, u := range []struct {
+		name, email string
+		admin       bool
+	}{
+		{"Alice", "alice@example.com", false},
+		{"Bob", "Bob@Example.com", false},
+		{"carol", "carol@staff.example.com", true},
+		{"Dave", "dave@example.com", false},
+	} {
+		clock.Advance(time.Hour)
+		resp, err := env.AuthClient.CreateUser(admin, &authpb.CreateUserRequest{
+			Name: u.name, Email: u.email, Password: "user-password", IsAdmin: u.admin,
+		})
+		if err != nil {
+			t.Fatalf("CreateUser %s: %v", u.email, err)
+		}
+		ids[u.name] = resp.GetUserId().GetValue()
+	}
+	clock.Advance(time.Hour)
+	if _, err := env.AuthClient.Register(ctx, &authpb.RegisterRequest{
+		Name: "Erin", Email: "erin@example.com", Password: "user-password",
+	}); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	if _, err := env.AuthClient.SuspendUser(admin, &authpb.SuspendUserRequest{UserId: ids["Bob"]}); err != nil {
+		t.Fatalf("SuspendUser: %v", err)
+	}
+	if _, err := env.AuthClient.DeleteUser(admin, &authpb.DeleteRequest{UserId: ids["Dave"]}); err != nil {
+		t.Fatalf("DeleteUser: %v", err)
+	}
+
+	at := func(h int) *timestamppb.Timestamp { return timestamppb.New(start.Add(time.Duration(h) * time.Hour)) }
+	tests := []struct {
+		name      string
+		req       *authpb.ListUsersRequest
+		wantNames []string
+		wantTotal int32
+		wantCode  codes.Code
+	}{
+		{name: "all", req: &authpb.ListUsersRequest{}, wantNames: []string{"Admin", "Alice", "Bob", "carol", "Dave", "Erin"}},
+		{name: "email prefix ignores case", req: &authpb.ListUsersRequest{EmailPrefix: "BOB@"}, wantNames: []string{"Bob"}},
+		{name: "name prefix ignores case", req: &authpb.ListUsersRequest{NamePrefix: "c"}, wantNames: []string{"carol"}},
+		{
+			name:      "role user",
+			req:       &authpb.ListUsersRequest{Role: authpb.UserRole_USER_ROLE_USER},
+			wantNames: []string{"Alice", "Bob", "Dave", "Erin"},
+		},
+		{
+			name:      "role admin",
+			req:       &authpb.ListUsersRequest{Role: authpb.UserRole_USER_ROLE_ADMIN},
+			wantNames: []string{"Admin", "carol"},
+		},
+		{
+			name:      "created range is half open",
+			req:       &authpb.ListUsersRequest{CreatedAfter: at(1), CreatedBefore: at(3)},
+			wantNames: []string{"Alice", "Bob"},
+		},
+		{
+			name:      "status active",
+			req:       &authpb.ListUsersRequest{Status: authpb.UserStatus_USER_STATUS_ACTIVE},
+			wantNames: []string{"Admin", "Alice", "carol"},
+		},
+		{
+			name:      "status suspended",
+			req:       &authpb.ListUsersRequest{Status: authpb.UserStatus_USER_STATUS_SUSPENDED},
+			wantNames: []string{"Bob"},
+		},
+		{
+			name:      "status deleted",
+			req:       &authpb.ListUsersRequest{Status: authpb.UserStatus_USER_STATUS_DELETED},
+			wantNames: []string{"Dave"},
+		},
+		{
+			name:      "status pending verification",
+			req:       &authpb.ListUsersRequest{Status: authpb.UserStatus_USER_STATUS_PENDING_VERIFICATION},
+			wantNames: []string{"Erin"},
+		},
+		{
+			name:      "filters and pages combine",
+			req:       &authpb.ListUsersRequest{Role: authpb.UserRole_USER_ROLE_USER, Page: 2, Limit: 3},
+			wantNames: []string{"Erin"},
+			wantTotal: 4,
+		},
+		{name: "no match", req: &authpb.ListUsersRequest{EmailPrefix: "zed"}},
+		{name: "page past the end", req: &authpb.ListUsersRequest{Page: 3, Limit: 3}, wantTotal: 6},
+		{name: "largest page", req: &authpb.ListUsersRequest{Page: math.MaxInt32, Limit: 100}, wantTotal: 6},
+		{name: "negative page", req: &authpb.ListUsersRequest{Page: -1}, wantCode: codes.InvalidArgument},
+		{
+			name:     "empty created range",
+			req:      &authpb.ListUsersRequest{CreatedAfter: at(3), CreatedBefore: at(3)},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := env.AuthClient.ListUsers(admin, tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("ListUsers = %v, want %v", err, tt.wantCode)
+			}
+			var names []string
+			for _, u := range resp.GetUsers() {
+				names = append(names, u.GetName())
+			}
+			if !slices.Equal(names, tt.wantNames) {
+				t.Errorf("users = %v, want %v", names, tt.wantNames)
+			}
+			wantTotal := tt.wantTotal
+			if wantTotal == 0 {
+				wantTotal = int32(len(tt.wantNames))
+			}
+			if err == nil && resp.GetTotal() != wantTotal {
+				t.Errorf("Total = %d, want %d", resp.GetTotal(), wantTotal)
+			}
+		})
+	}
+}
+
+func TestSuspendUser(t *testing.T) {
+	const email, password = "user@example.com", "user-password"
+
+	tests := []struct {
+		name string
+		// act runs as the admin after the user has signed in.
+		act         func(ctx context.Context, env *fakes.Env, admin, userID string) (*authpb.UserResponse, error)
+		wantCode    codes.Code
+		wantStatus  authpb.UserStatus
+		wantReason  string
+		wantSignIn  codes.Code
+		wantSession bool
+	}{
+		{
+			name: "suspend",
+			act: func(ctx context.Context, env *fakes.Env, _, userID string) (*authpb.UserResponse, error) {
+				return env.AuthClient.SuspendUser(ctx, &authpb.SuspendUserRequest{UserId: userID, Reason: "chargebacks"})
+			},
+			wantStatus: authpb.UserStatus_USER_STATUS_SUSPENDED,
+			wantReason: "chargebacks",
+			wantSignIn: codes.PermissionDenied,
+		},
+		{
+			name: "suspend twice",
+			act: func(ctx context.Context, env *fakes.Env, _, userID string) (*authpb.UserResponse, error) {
+				if _, err := env.AuthClient.SuspendUser(ctx, &authpb.SuspendUserRequest{UserId: userID, Reason: "first"}); err != nil {
+					return nil, err
+				}
+				return env.AuthClient.SuspendUser(ctx, &authpb.SuspendUserRequest{UserId: userID, Reason: "second"})
+			},
+			wantStatus: authpb.UserStatus_USER_STATUS_SUSPENDED,
+			wantReason: "first",
+			wantSignIn: codes.PermissionDenied,
+		},
+		{
+			name: "reactivate",
+			act: func(ctx context.Context, env *fakes.Env, _, userID string) (*authpb.UserResponse, error) {
+				if _, err := env.AuthClient.SuspendUser(ctx, &authpb.SuspendUserRequest{UserId: userID, Reason: "oops"}); err != nil {
+					return nil, err
+				}
+				return env.AuthClient.ReactivateUser(ctx, &authpb.ReactivateUserRequest{UserId: userID})
+			},
+			wantStatus: authpb.UserStatus_USER_STATUS_ACTIVE,
+		},
+		{
+			name: "reactivate active user",
+			act: func(ctx context.Context, env *fakes.Env, _, userID string) (*authpb.UserResponse, error) {
+				return env.AuthClient.ReactivateUser(ctx, &authpb.ReactivateUserRequest{UserId: userID})
+			},
+			wantStatus:  authpb.UserStatus_USER_STATUS_ACTIVE,
+			wantSession: true,
+		},
+		{
+			name: "suspend self",
+			act: func(ctx context.Context, env *fakes.Env, admin, _ string) (*authpb.UserResponse, error) {
+				return env.AuthClient.SuspendUser(ctx, &authpb.SuspendUserRequest{UserId: admin})
+			},
+			wantCode:    codes.InvalidArgument,
+			wantSession: true,
+		},
+		{
+			name: "unknown user",
+			act: func(ctx context.Context, env *fakes.Env, _, _ string) (*authpb.UserResponse, error) {
+				return env.AuthClient.SuspendUser(ctx, &authpb.SuspendUserRequest{UserId: "missing"})
+			},
+			wantCode:    codes.NotFound,
+			wantSession: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := fakes.MustStart(t, fakes.Options{})
+			p := principals(t, env)
+			session := login(t, env, email, password)
+
+			resp, err := tt.act(fakes.WithToken(context.Background(), p.Admin.AccessToken), env, p.Admin.UserID, p.User.UserID)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("got %v, want %v", err, tt.wantCode)
+			}
+			if err == nil && (resp.GetStatus() != tt.wantStatus || resp.GetSuspensionReason() != tt.wantReason) {
+				t.Errorf("user status %v %q, want %v %q", resp.GetStatus(), resp.GetSuspensionReason(), tt.wantStatus, tt.wantReason)
+			}
+
+			if err := refresh(env, session); (err == nil) != tt.wantSession {
+				t.Errorf("refresh existing session = %v, want it kept %v", err, tt.wantSession)
+			}
+			_, err = env.AuthClient.Login(context.Background(), &authpb.LoginRequest{Email: email, Password: password})
+			if status.Code(err) != tt.wantSignIn {
+				t.Errorf("Login = %v, want %v", err, tt.wantSignIn)
+			}
+			if tt.wantSignIn == codes.PermissionDenied && apierrors.ReasonOf(err) != apierrors.ReasonAccountSuspended {
+				t.Errorf("Login reason = %s, want %s", apierrors.ReasonOf(err), apierrors.ReasonAccountSuspended)
+			}
+		})
+	}
+}
+
+type userIDs struct{ user, other string }
+
+func TestForceLogout(t *testing.T) {
+	const email, password = "user@example.com", "user-password"
+
+	tests := []struct {
+		name         string
+		sessions     int
+		target       func(p userIDs) string
+		wantCode     codes.Code
+		wantRevoked  int32
+		wantSessions bool
+	}{
+		// principals already signed the user in once.
+		{name: "all sessions", sessions: 2, target: func(p userIDs) string { return p.user }, wantRevoked: 3},
+		{name: "other user", sessions: 2, target: func(p userIDs) string { return p.other }, wantRevoked: 1, wantSessions: true},
+		{name: "unknown user", sessions: 1, target: func(userIDs) string { return "missing" }, wantCode: codes.NotFound, wantSessions: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := fakes.MustStart(t, fakes.Options{})
+			p := principals(t, env)
+			ctx := context.Background()
+			var sessions []*authpb.JWTPair
+			for range tt.sessions {
+				sessions = append(sessions, login(t, env, email, password))
+			}
+
+			resp, err := env.AuthClient.ForceLogout(fakes.WithToken(ctx, p.Admin.AccessToken),
+				&authpb.ForceLogoutRequest{UserId: tt.target(userIDs{user: p.User.UserID, other: p.Other.UserID})})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("ForceLogout = %v, want %v", err, tt.wantCode)
+			}
+			if resp.GetSessionsRevoked() != tt.wantRevoked {
+				t.Errorf("SessionsRevoked = %d, want %d", resp.GetSessionsRevoked(), tt.wantRevoked)
+			}
+
+			for i, s := range sessions {
+				v, err := env.AuthClient.ValidateToken(ctx, &authpb.ValidateTokenRequest{Token: s.GetAccessToken()})
+				if err != nil || v.GetIsValid() != tt.wantSessions {
+					t.Errorf("access token %d valid = %v, %v; want %v", i, v.GetIsValid(), err, tt.wantSessions)
+				}
+				if err := refresh(env, s); (err == nil) != tt.wantSessions {
+					t.Errorf("refresh session %d = %v, want it kept %v", i, err, tt.wantSessions)
+				}
+			}
+			// Force logout is not a suspension: the user can sign in again.
+			login(t, env, email, password)
+		})
+	}
+}
+
+func TestDemotedAdminLosesAccess(t *testing.T) {
+	env := fakes.MustStart(t, fakes.Options{})
+	p := principals(t, env)
+	ctx := context.Background()
+	root := fakes.WithToken(ctx, p.Admin.AccessToken)
+
+	created, err := env.AuthClient.CreateUser(root, &authpb.CreateUserRequest{
+		Name: "Carol", Email: "carol@example.com", Password: "user-password", IsAdmin: true,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	carol := login(t, env, "carol@example.com", "user-password")
+	if _, err := env.AuthClient.ListUsers(fakes.WithToken(ctx, carol.GetAccessToken()), &authpb.ListUsersRequest{}); err != nil {
+		t.Fatalf("ListUsers as admin: %v", err)
+	}
+
+	if _, err := env.AuthClient.UpdateUser(root, &authpb.UpdateUserRequest{
+		UserId: created.GetUserId().GetValue(), IsAdmin: proto.Bool(false),
+	}); err != nil {
+		t.Fatalf("UpdateUser: %v", err)
+	}
+
+	_, err = env.AuthClient.ListUsers(fakes.WithToken(ctx, carol.GetAccessToken()), &authpb.ListUsersRequest{})
+	if status.Code(err) != codes.Unauthenticated || !apierrors.Is(err, apierrors.ReasonTokenInvalid) {
+		t.Errorf("ListUsers with the admin token after demotion = %v, want Unauthenticated with TOKEN_INVALID", err)
+	}
+	v, err := env.AuthClient.ValidateToken(ctx, &authpb.ValidateTokenRequest{Token: carol.GetAccessToken()})
+	if err != nil || v.GetIsValid() {
+		t.Errorf("ValidateToken after demotion = %v, %v; want invalid", v.GetIsValid(), err)
+	}
+
+	// A fresh sign-in carries the new role.
+	again := fakes.WithToken(ctx, login(t, env, "carol@example.com", "user-password").GetAccessToken())
+	if _, err := env.AuthClient.ListUsers(again, &authpb.ListUsersRequest{}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ListUsers after signing in again = %v, want PermissionDenied", err)
+	}
+}
+
+func TestUpdateUserRole(t *testing.T) {
+	tests := []struct {
+		name      string
+		isAdmin   *bool
+		wantAdmin bool
+		wantEvent bool
+	}{
+		{name: "omitted keeps the role", wantAdmin: true},
+		{name: "unchanged", isAdmin: proto.Bool(true), wantAdmin: true},
+		{name: "demote", isAdmin: proto.Bool(false), wantEvent: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := fakes.MustStart(t, fakes.Options{})
+			root := fakes.WithToken(context.Background(), login(t, env, adminEmail, adminPassword).GetAccessToken())
+			created, err := env.AuthClient.CreateUser(root, &authpb.CreateUserRequest{
+				Name: "Carol", Email: "carol@example.com", Password: "user-password", IsAdmin: true,
+			})
+			if err != nil {
+				t.Fatalf("CreateUser: %v", err)
+			}
+			sub, err := env.Auth.Events.Subscribe(context.Background(), userevents.Filter{Types: []string{userevents.TypeRoleChanged}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			u, err := env.AuthClient.UpdateUser(root, &authpb.UpdateUserRequest{
+				UserId: created.GetUserId().GetValue(), Name: "Caroline", IsAdmin: tt.isAdmin,
+			})
+			if err != nil {
+				t.Fatalf("UpdateUser: %v", err)
+			}
+			if u.GetName() != "Caroline" || u.GetIsAdmin() != tt.wantAdmin {
+				t.Errorf("UpdateUser = %s admin %v, want Caroline admin %v", u.GetName(), u.GetIsAdmin(), tt.wantAdmin)
+			}
+
+			// Events are published before UpdateUser returns.
+			select {
+			case e := <-sub.C:
+				if !tt.wantEvent {
+					t.Errorf("unexpected %s event", e.GetType())
+				}
+			default:
+				if tt.wantEvent {
+					t.Errorf("no %s event", userevents.TypeRoleChanged)
+				}
+			}
+		})
+	}
+}
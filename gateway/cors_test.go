@@ -0,0 +1,134 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewRejectsWildcardWithCredentials(t *testing.T) {
+	tests := []struct {
+		name    string
+		cors    CORSConfig
+		wantErr bool
+	}{
+		{"wildcard", CORSConfig{AllowedOrigins: []string{"*"}}, false},
+		{"credentials with exact origins", CORSConfig{AllowedOrigins: []string{"https://app.example.com"}, AllowCredentials: true}, false},
+		{"wildcard with credentials", CORSConfig{AllowedOrigins: []string{"https://app.example.com", "*"}, AllowCredentials: true}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(context.Background(), Config{CORS: tt.cors})
+			gotCORSErr := err != nil && strings.Contains(err.Error(), "AllowCredentials")
+			if gotCORSErr != tt.wantErr {
+				t.Errorf("New error = %v, want CORS error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCORS(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusTeapot) })
+
+	tests := []struct {
+		name        string
+		cfg         CORSConfig
+		method      string
+		origin      string
+		preflight   bool
+		wantStatus  int
+		wantHeaders map[string]string
+	}{
+		{
+			name:        "disabled",
+			method:      http.MethodGet,
+			origin:      "https://app.example.com",
+			wantStatus:  http.StatusTeapot,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": ""},
+		},
+		{
+			name:        "same origin request",
+			cfg:         CORSConfig{AllowedOrigins: []string{"*"}},
+			method:      http.MethodGet,
+			wantStatus:  http.StatusTeapot,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": "", "Vary": ""},
+		},
+		{
+			name:       "wildcard",
+			cfg:        CORSConfig{AllowedOrigins: []string{"*"}},
+			method:     http.MethodGet,
+			origin:     "https://app.example.com",
+			wantStatus: http.StatusTeapot,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "*",
+				"Access-Control-Allow-Credentials": "",
+				"Access-Control-Expose-Headers":    RequestIDHeader,
+				"Vary":                             "Origin",
+			},
+		},
+		{
+			name:       "exact origin with credentials",
+			cfg:        CORSConfig{AllowedOrigins: []string{"https://APP.example.com"}, AllowCredentials: true},
+			method:     http.MethodGet,
+			origin:     "https://app.example.com",
+			wantStatus: http.StatusTeapot,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "https://app.example.com",
+				"Access-Control-Allow-Credentials": "true",
+			},
+		},
+		{
+			name:        "unknown origin",
+			cfg:         CORSConfig{AllowedOrigins: []string{"https://app.example.com"}},
+			method:      http.MethodGet,
+			origin:      "https://evil.example.com",
+			wantStatus:  http.StatusTeapot,
+			wantHeaders: map[string]string{"Access-Control-Allow-Origin": "", "Vary": "Origin"},
+		},
+		{
+			name:       "preflight",
+			cfg:        CORSConfig{AllowedOrigins: []string{"https://app.example.com"}, MaxAge: 600},
+			method:     http.MethodOptions,
+			origin:     "https://app.example.com",
+			preflight:  true,
+			wantStatus: http.StatusNoContent,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":  "https://app.example.com",
+				"Access-Control-Allow-Methods": "GET, POST, PUT, PATCH, DELETE",
+				"Access-Control-Allow-Headers": "Authorization, Content-Type, " + RequestIDHeader,
+				"Access-Control-Max-Age":       "600",
+			},
+		},
+		{
+			name:       "plain OPTIONS passes through",
+			cfg:        CORSConfig{AllowedOrigins: []string{"*"}},
+			method:     http.MethodOptions,
+			origin:     "https://app.example.com",
+			wantStatus: http.StatusTeapot,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/v1/hotels", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			if tt.preflight {
+				req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			}
+			rec := httptest.NewRecorder()
+			cors(tt.cfg, next).ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			for k, want := range tt.wantHeaders {
+				if got := rec.Header().Get(k); got != want {
+					t.Errorf("%s = %q, want %q", k, got, want)
+				}
+			}
+		})
+	}
+}
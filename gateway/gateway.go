@@ -0,0 +1,220 @@
+// Package gateway serves the Auth, HotelService and BookingService HTTP APIs
+// from a single grpc-gateway runtime.ServeMux.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	bookpb "github.com/JunBSer/services_proto/booking/gen/go"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+	"github.com/JunBSer/services_proto/observability"
+	"github.com/JunBSer/services_proto/openapi"
+)
+
+// Config describes which backends the gateway proxies to and how it serves
+// HTTP. An empty endpoint disables that service.
+type Config struct {
+	Addr string
+
+	AuthEndpoint    string
+	HotelEndpoint   string
+	BookingEndpoint string
+
+	// DialOptions are used for every backend connection. Defaults to
+	// insecure transport credentials.
+	DialOptions []grpc.DialOption
+
+	// CORS is applied to every response. CORS with no allowed origins
+	// disables cross-origin requests.
+	CORS CORSConfig
+
+	// ErrorHandler replaces the default problem+json handler built from
+	// Problems.
+	ErrorHandler runtime.ErrorHandlerFunc
+	Problems     ProblemOptions
+
+	// Telemetry, when set, traces and measures every request and forwards
+	// trace context to the backends.
+	Telemetry *observability.Instrumentation
+
+	// MuxOptions are appended after the gateway's own options, so they can
+	// override them.
+	MuxOptions []runtime.ServeMuxOption
+
+	HealthPath string
+	// DocsPath mounts the merged OpenAPI specification and documentation UI,
+	// e.g. "/docs". Empty disables it.
+	DocsPath        string
+	ShutdownTimeout time.Duration
+}
+
+func (c Config) healthPath() string {
+	if c.HealthPath == "" {
+		return "/healthz"
+	}
+	return c.HealthPath
+}
+
+func (c Config) shutdownTimeout() time.Duration {
+	if c.ShutdownTimeout <= 0 {
+		return 10 * time.Second
+	}
+	return c.ShutdownTimeout
+}
+
+type backend struct {
+	name     string
+	endpoint string
+	register func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error
+}
+
+// Gateway owns the backend connections and the combined HTTP handler.
+type Gateway struct {
+	cfg     Config
+	mux     *runtime.ServeMux
+	handler http.Handler
+	conns   map[string]*grpc.ClientConn
+}
+
+func New(ctx context.Context, cfg Config) (*Gateway, error) {
+	if err := cfg.CORS.validate(); err != nil {
+		return nil, err
+	}
+	backends := []backend{
+		{name: "auth", endpoint: cfg.AuthEndpoint, register: authpb.RegisterAuthHandler},
+		{name: "hotel", endpoint: cfg.HotelEndpoint, register: hotelpb.RegisterHotelServiceHandler},
+		{name: "booking", endpoint: cfg.BookingEndpoint, register: bookpb.RegisterBookingServiceHandler},
+	}
+
+	dialOpts := cfg.DialOptions
+	if len(dialOpts) == 0 {
+		dialOpts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+	if cfg.Telemetry != nil {
+		dialOpts = slices.Concat(dialOpts, cfg.Telemetry.DialOptions())
+	}
+
+	g := &Gateway{
+		cfg:   cfg,
+		mux:   runtime.NewServeMux(muxOptions(cfg)...),
+		conns: make(map[string]*grpc.ClientConn),
+	}
+
+	for _, b := range backends {
+		if b.endpoint == "" {
+			continue
+		}
+		conn, err := grpc.NewClient(b.endpoint, dialOpts...)
+		if err != nil {
+			g.Close()
+			return nil, fmt.Errorf("gateway: dial %s at %s: %w", b.name, b.endpoint, err)
+		}
+		g.conns[b.name] = conn
+		if err := b.register(ctx, g.mux, conn); err != nil {
+			g.Close()
+			return nil, fmt.Errorf("gateway: register %s handlers: %w", b.name, err)
+		}
+	}
+	if len(g.conns) == 0 {
+		return nil, errors.New("gateway: no backend endpoints configured")
+	}
+
+	root := http.NewServeMux()
+	root.Handle(cfg.healthPath(), g.healthHandler())
+	if docs := strings.TrimRight(cfg.DocsPath, "/"); docs != "" {
+		root.Handle(docs, http.RedirectHandler(docs+"/", http.StatusMovedPermanently))
+		root.Handle(docs+"/", http.StripPrefix(docs, openapi.Handler()))
+	}
+	root.Handle("/", g.mux)
+	g.handler = requestID(cors(cfg.CORS, root))
+	if cfg.Telemetry != nil {
+		g.handler = cfg.Telemetry.Middleware(g.handler)
+	}
+
+	return g, nil
+}
+
+func muxOptions(cfg Config) []runtime.ServeMuxOption {
+	opts := []runtime.ServeMuxOption{
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	}
+	errorHandler := cfg.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = ProblemErrorHandler(cfg.Problems)
+	}
+	opts = append(opts, runtime.WithErrorHandler(errorHandler))
+	return append(opts, cfg.MuxOptions...)
+}
+
+// Mux exposes the underlying runtime.ServeMux, e.g. to add custom routes with
+// HandlePath.
+func (g *Gateway) Mux() *runtime.ServeMux { return g.mux }
+
+// Handler returns the mux wrapped with request ID, CORS and health handling.
+func (g *Gateway) Handler() http.Handler { return g.handler }
+
+// ListenAndServe serves until ctx is cancelled, then shuts down gracefully
+// within Config.ShutdownTimeout and closes backend connections.
+func (g *Gateway) ListenAndServe(ctx context.Context) error {
+	lis, err := net.Listen("tcp", g.cfg.Addr)
+	if err != nil {
+		return fmt.Errorf("gateway: listen: %w", err)
+	}
+	return g.Serve(ctx, lis)
+}
+
+func (g *Gateway) Serve(ctx context.Context, lis net.Listener) error {
+	srv := &http.Server{
+		Handler:           g.handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(lis)
+	}()
+
+	select {
+	case err := <-errCh:
+		g.Close()
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), g.cfg.shutdownTimeout())
+	defer cancel()
+
+	err := srv.Shutdown(shutdownCtx)
+	if serveErr := <-errCh; !errors.Is(serveErr, http.ErrServerClosed) && err == nil {
+		err = serveErr
+	}
+	if closeErr := g.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// Close closes all backend connections.
+func (g *Gateway) Close() error {
+	var errs []error
+	for name, conn := range g.conns {
+		if err := conn.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("close %s: %w", name, err))
+		}
+		delete(g.conns, name)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,71 @@
+package gateway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+
+	"github.com/JunBSer/services_proto/auth/reauth"
+)
+
+const RequestIDHeader = "X-Request-Id"
+
+// RequestIDMetadataKey is the gRPC metadata key the request ID is forwarded as.
+const RequestIDMetadataKey = "x-request-id"
+
+type requestIDKey struct{}
+
+// RequestIDFromContext returns the request ID assigned by the gateway.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// requestID makes sure every request carries an X-Request-Id header, reusing
+// the client's value when present, and echoes it in the response.
+func requestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if id == "" || len(id) > 128 {
+			id = newRequestID()
+			r.Header.Set(RequestIDHeader, id)
+		}
+		w.Header().Set(RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDKey{}, id)))
+	})
+}
+
+func newRequestID() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+// incomingHeaderMatcher forwards Authorization, the step-up token, the
+// request ID and W3C trace context under their plain names so backend
+// interceptors don't need to know about the gateway's "grpcgateway-" prefix.
+func incomingHeaderMatcher(key string) (string, bool) {
+	switch k := strings.ToLower(key); k {
+	case "authorization", reauth.MetadataKey, "traceparent", "tracestate", "baggage":
+		return k, true
+	case RequestIDMetadataKey:
+		return RequestIDMetadataKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher passes Content-Disposition through unprefixed so
+// HttpBody downloads such as ExportMyData keep their file name.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case RequestIDMetadataKey:
+		return "", false
+	case "content-disposition":
+		return "Content-Disposition", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
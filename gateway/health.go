@@ -0,0 +1,38 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/grpc/connectivity"
+)
+
+type healthResponse struct {
+	Status   string            `json:"status"`
+	Backends map[string]string `json:"backends"`
+}
+
+// healthHandler reports the connection state of every backend. It answers 503
+// if any backend connection has failed.
+func (g *Gateway) healthHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := healthResponse{Status: "ok", Backends: make(map[string]string, len(g.conns))}
+		status := http.StatusOK
+
+		for name, conn := range g.conns {
+			state := conn.GetState()
+			if state == connectivity.Idle {
+				conn.Connect()
+			}
+			resp.Backends[name] = state.String()
+			if state == connectivity.TransientFailure || state == connectivity.Shutdown {
+				resp.Status = "unavailable"
+				status = http.StatusServiceUnavailable
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+}
@@ -0,0 +1,195 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/JunBSer/services_proto/apierrors"
+)
+
+const ProblemContentType = "application/problem+json"
+
+// Problem is an RFC 7807 problem details document with extension members
+// for the gRPC code, domain reason and request ID.
+type Problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	Code          string         `json:"code"`
+	Reason        string         `json:"reason,omitempty"`
+	RequestID     string         `json:"request_id,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid_params,omitempty"`
+}
+
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// ProblemType is the HTTP representation of a domain error reason.
+type ProblemType struct {
+	Status int
+	Title  string
+	// Slug is appended to ProblemOptions.TypeBaseURI to form the type URI.
+	Slug string
+}
+
+// ProblemTypes maps domain reasons to stable HTTP statuses regardless of the
+// gRPC code chosen by the server.
+var ProblemTypes = map[apierrors.Reason]ProblemType{
+	apierrors.ReasonInvalidCredentials:    {http.StatusUnauthorized, "Invalid credentials", "invalid-credentials"},
+	apierrors.ReasonTokenExpired:          {http.StatusUnauthorized, "Token expired", "token-expired"},
+	apierrors.ReasonTokenInvalid:          {http.StatusUnauthorized, "Invalid token", "token-invalid"},
+	apierrors.ReasonPermissionDenied:      {http.StatusForbidden, "Permission denied", "permission-denied"},
+	apierrors.ReasonUserNotFound:          {http.StatusNotFound, "User not found", "user-not-found"},
+	apierrors.ReasonUserAlreadyExists:     {http.StatusConflict, "User already exists", "user-already-exists"},
+	apierrors.ReasonEmailNotVerified:      {http.StatusForbidden, "Email not verified", "email-not-verified"},
+	apierrors.ReasonMFARequired:           {http.StatusUnauthorized, "Multi-factor authentication required", "mfa-required"},
+	apierrors.ReasonInvalidMFACode:        {http.StatusUnauthorized, "Invalid MFA code", "invalid-mfa-code"},
+	apierrors.ReasonServiceAccountRevoked: {http.StatusUnauthorized, "Service account revoked", "service-account-revoked"},
+	apierrors.ReasonAccountLocked:         {http.StatusTooManyRequests, "Account temporarily locked", "account-locked"},
+	apierrors.ReasonAccountSuspended:      {http.StatusForbidden, "Account suspended", "account-suspended"},
+	apierrors.ReasonAccountDeleted:        {http.StatusForbidden, "Account scheduled for deletion", "account-deleted"},
+	apierrors.ReasonAccountNotDeleted:     {http.StatusConflict, "Account not pending deletion", "account-not-deleted"},
+	apierrors.ReasonReauthRequired:        {http.StatusUnauthorized, "Reauthentication required", "reauthentication-required"},
+
+	apierrors.ReasonHotelNotFound:       {http.StatusNotFound, "Hotel not found", "hotel-not-found"},
+	apierrors.ReasonRoomNotFound:        {http.StatusNotFound, "Room not found", "room-not-found"},
+	apierrors.ReasonResumeTokenExpired:  {http.StatusGone, "Resume token expired", "resume-token-expired"},
+	apierrors.ReasonPhotoNotFound:       {http.StatusNotFound, "Photo not found", "photo-not-found"},
+	apierrors.ReasonUploadNotFound:      {http.StatusNotFound, "Upload not found or expired", "upload-not-found"},
+	apierrors.ReasonChecksumMismatch:    {http.StatusUnprocessableEntity, "Checksum mismatch", "checksum-mismatch"},
+	apierrors.ReasonRoomTypeNotFound:    {http.StatusNotFound, "Room type not found", "room-type-not-found"},
+	apierrors.ReasonRoomTypeInUse:       {http.StatusConflict, "Room type in use", "room-type-in-use"},
+	apierrors.ReasonRatePlanNotFound:    {http.StatusNotFound, "Rate plan not found", "rate-plan-not-found"},
+	apierrors.ReasonRatePlanUnavailable: {http.StatusUnprocessableEntity, "Rate plan not bookable", "rate-plan-unavailable"},
+	apierrors.ReasonPricingRuleNotFound: {http.StatusNotFound, "Pricing rule not found", "pricing-rule-not-found"},
+
+	apierrors.ReasonBookingNotFound:         {http.StatusNotFound, "Booking not found", "booking-not-found"},
+	apierrors.ReasonBookingConflict:         {http.StatusConflict, "Booking conflict", "booking-conflict"},
+	apierrors.ReasonBookingAlreadyCancelled: {http.StatusConflict, "Booking already cancelled", "booking-already-cancelled"},
+	apierrors.ReasonRoomUnavailable:         {http.StatusConflict, "Room unavailable", "room-unavailable"},
+	apierrors.ReasonInvalidDateRange:        {http.StatusBadRequest, "Invalid date range", "invalid-date-range"},
+
+	apierrors.ReasonValidationFailed: {http.StatusBadRequest, "Validation failed", "validation-failed"},
+	apierrors.ReasonRateLimited:      {http.StatusTooManyRequests, "Too many requests", "rate-limited"},
+}
+
+type ProblemOptions struct {
+	// TypeBaseURI prefixes the slug of known reasons and defaults to
+	// "/problems". Errors without a known reason use "about:blank" as
+	// RFC 7807 recommends.
+	TypeBaseURI string
+	// Types overrides or extends ProblemTypes.
+	Types map[apierrors.Reason]ProblemType
+}
+
+func (o ProblemOptions) typeURI(slug string) string {
+	base := strings.TrimRight(o.TypeBaseURI, "/")
+	if base == "" {
+		base = "/problems"
+	}
+	return base + "/" + slug
+}
+
+func (o ProblemOptions) lookup(reason apierrors.Reason) (ProblemType, bool) {
+	if pt, ok := o.Types[reason]; ok {
+		return pt, true
+	}
+	pt, ok := ProblemTypes[reason]
+	return pt, ok
+}
+
+// NewProblem converts a gRPC error into a Problem.
+func (o ProblemOptions) NewProblem(err error) Problem {
+	st := status.Convert(err)
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+
+	p := Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(httpStatus),
+		Status: httpStatus,
+		Detail: st.Message(),
+		Code:   st.Code().String(),
+	}
+
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			if p.Reason != "" {
+				continue
+			}
+			p.Reason = d.GetReason()
+			if pt, ok := o.lookup(apierrors.Reason(d.GetReason())); ok {
+				p.Status = pt.Status
+				p.Title = pt.Title
+				p.Type = o.typeURI(pt.Slug)
+			}
+		case *errdetails.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				p.InvalidParams = append(p.InvalidParams, InvalidParam{Name: v.GetField(), Reason: v.GetDescription()})
+			}
+		}
+	}
+
+	// Hide messages of unexpected internal errors from clients.
+	if p.Type == "about:blank" && (st.Code() == codes.Internal || st.Code() == codes.Unknown) {
+		p.Detail = ""
+	}
+	return p
+}
+
+// ProblemErrorHandler returns a runtime.ErrorHandlerFunc that writes errors
+// as application/problem+json.
+func ProblemErrorHandler(opts ProblemOptions) runtime.ErrorHandlerFunc {
+	return func(ctx context.Context, mux *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+		p := opts.NewProblem(err)
+		p.Instance = r.URL.Path
+		p.RequestID = RequestIDFromContext(r.Context())
+
+		if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+			for k, vs := range md.HeaderMD {
+				if h, ok := outgoingHeaderMatcher(k); ok {
+					for _, v := range vs {
+						w.Header().Add(h, v)
+					}
+				}
+			}
+		}
+		if retry := retryAfter(err); retry > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(retry))
+		}
+		if p.Status == http.StatusUnauthorized {
+			w.Header().Set("WWW-Authenticate", `Bearer`)
+		}
+
+		w.Header().Del("Trailer")
+		w.Header().Del("Transfer-Encoding")
+		w.Header().Set("Content-Type", ProblemContentType)
+		w.WriteHeader(p.Status)
+		_ = json.NewEncoder(w).Encode(p)
+	}
+}
+
+// retryAfter returns the RetryInfo delay of err rounded up to whole seconds.
+func retryAfter(err error) int {
+	delay, ok := apierrors.RetryDelay(err)
+	if !ok {
+		return 0
+	}
+	secs := int(delay.Seconds())
+	if float64(secs) < delay.Seconds() {
+		secs++
+	}
+	return secs
+}
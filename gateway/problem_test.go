@@ -0,0 +1,236 @@
+package gateway
+
+import (
+	"bytes"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/JunBSer/services_proto/apierrors"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+type problemCase struct {
+	name       string
+	err        error
+	wantStatus int
+	// wantHeaders are checked in addition to the problem content type.
+	wantHeaders map[string]string
+}
+
+// problemCases covers every reason in ProblemTypes plus the fallbacks for
+// errors without a known reason.
+func problemCases() []problemCase {
+	var cases []problemCase
+	for reason, pt := range ProblemTypes {
+		c := problemCase{
+			name:       pt.Slug,
+			err:        apierrors.New(codes.FailedPrecondition, reason, strings.ToLower(pt.Title)),
+			wantStatus: pt.Status,
+		}
+		if pt.Status == http.StatusUnauthorized {
+			c.wantHeaders = map[string]string{"WWW-Authenticate": "Bearer"}
+		}
+		cases = append(cases, c)
+	}
+	slices.SortFunc(cases, func(a, b problemCase) int { return strings.Compare(a.name, b.name) })
+
+	return append(cases,
+		problemCase{
+			name: "validation-invalid-params",
+			err: apierrors.Validation("invalid hotel",
+				apierrors.FieldViolation{Field: "name", Description: "is required"},
+				apierrors.FieldViolation{Field: "rating", Description: "must be between 0 and 5"}),
+			wantStatus: http.StatusBadRequest,
+		},
+		problemCase{
+			name:        "throttled-retry-after",
+			err:         apierrors.Throttled(apierrors.ReasonRateLimited, "slow down", 1500*time.Millisecond),
+			wantStatus:  http.StatusTooManyRequests,
+			wantHeaders: map[string]string{"Retry-After": "2"},
+		},
+		problemCase{
+			name:       "unknown-reason",
+			err:        apierrors.New(codes.Aborted, apierrors.Reason("SOMETHING_NEW"), "try again"),
+			wantStatus: http.StatusConflict,
+		},
+		problemCase{
+			name:       "plain-status",
+			err:        status.Error(codes.NotFound, "nothing here"),
+			wantStatus: http.StatusNotFound,
+		},
+		problemCase{
+			name:       "internal-hidden",
+			err:        status.Error(codes.Internal, "pq: connection refused"),
+			wantStatus: http.StatusInternalServerError,
+		},
+		problemCase{
+			name:       "unknown-hidden",
+			err:        status.Error(codes.Unknown, "panic: nil map"),
+			wantStatus: http.StatusInternalServerError,
+		},
+	)
+}
+
+// newProblemHandler builds the gateway's ServeMux with one route per case
+// that fails with the case's error, wrapped like Gateway.Handler.
+func newProblemHandler(t *testing.T, cases []problemCase) http.Handler {
+	t.Helper()
+
+	errs := make(map[string]error, len(cases))
+	for _, c := range cases {
+		errs[c.name] = c.err
+	}
+	mux := runtime.NewServeMux(muxOptions(Config{})...)
+	err := mux.HandlePath(http.MethodGet, "/v1/problems/{name}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		runtime.HTTPError(r.Context(), mux, &runtime.JSONPb{}, w, r, errs[params["name"]])
+	})
+	if err != nil {
+		t.Fatalf("HandlePath: %v", err)
+	}
+	return requestID(mux)
+}
+
+func serveProblem(h http.Handler, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set(RequestIDHeader, "req-1")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden file (run with -update to create it): %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("body mismatch for %s\n got: %s\nwant: %s", path, got, want)
+	}
+}
+
+func TestProblemErrorHandler(t *testing.T) {
+	cases := problemCases()
+	h := newProblemHandler(t, cases)
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rec := serveProblem(h, "/v1/problems/"+c.name)
+
+			if rec.Code != c.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, c.wantStatus)
+			}
+			if got := rec.Header().Get("Content-Type"); got != ProblemContentType {
+				t.Errorf("Content-Type = %q, want %q", got, ProblemContentType)
+			}
+			if got := rec.Header().Get(RequestIDHeader); got != "req-1" {
+				t.Errorf("%s = %q, want req-1", RequestIDHeader, got)
+			}
+			for k, want := range c.wantHeaders {
+				if got := rec.Header().Get(k); got != want {
+					t.Errorf("%s = %q, want %q", k, got, want)
+				}
+			}
+			checkGolden(t, c.name, rec.Body.Bytes())
+		})
+	}
+}
+
+func TestProblemErrorHandlerUnknownRoute(t *testing.T) {
+	h := newProblemHandler(t, nil)
+	rec := serveProblem(h, "/v1/nowhere")
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+	if got := rec.Header().Get("Content-Type"); got != ProblemContentType {
+		t.Errorf("Content-Type = %q, want %q", got, ProblemContentType)
+	}
+	checkGolden(t, "unknown-route", rec.Body.Bytes())
+}
+
+func TestProblemOptionsTypes(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      ProblemOptions
+		err       error
+		wantType  string
+		wantTitle string
+		wantCode  int
+	}{
+		{
+			name:      "default base",
+			err:       apierrors.New(codes.NotFound, apierrors.ReasonHotelNotFound, "hotel not found"),
+			wantType:  "/problems/hotel-not-found",
+			wantTitle: "Hotel not found",
+			wantCode:  http.StatusNotFound,
+		},
+		{
+			name:      "custom base",
+			opts:      ProblemOptions{TypeBaseURI: "https://errors.example.com/"},
+			err:       apierrors.New(codes.NotFound, apierrors.ReasonHotelNotFound, "hotel not found"),
+			wantType:  "https://errors.example.com/hotel-not-found",
+			wantTitle: "Hotel not found",
+			wantCode:  http.StatusNotFound,
+		},
+		{
+			name: "override",
+			opts: ProblemOptions{Types: map[apierrors.Reason]ProblemType{
+				apierrors.ReasonHotelNotFound: {http.StatusGone, "Hotel closed", "hotel-closed"},
+			}},
+			err:       apierrors.New(codes.NotFound, apierrors.ReasonHotelNotFound, "hotel not found"),
+			wantType:  "/problems/hotel-closed",
+			wantTitle: "Hotel closed",
+			wantCode:  http.StatusGone,
+		},
+		{
+			name: "extension",
+			opts: ProblemOptions{Types: map[apierrors.Reason]ProblemType{
+				"LOYALTY_EXPIRED": {http.StatusPaymentRequired, "Loyalty expired", "loyalty-expired"},
+			}},
+			err:       apierrors.New(codes.FailedPrecondition, "LOYALTY_EXPIRED", "renew"),
+			wantType:  "/problems/loyalty-expired",
+			wantTitle: "Loyalty expired",
+			wantCode:  http.StatusPaymentRequired,
+		},
+		{
+			name:      "gRPC code without reason",
+			err:       status.Error(codes.PermissionDenied, "no"),
+			wantType:  "about:blank",
+			wantTitle: "Forbidden",
+			wantCode:  http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.opts.NewProblem(tt.err)
+			if p.Type != tt.wantType || p.Title != tt.wantTitle || p.Status != tt.wantCode {
+				t.Errorf("got (%q, %q, %d), want (%q, %q, %d)",
+					p.Type, p.Title, p.Status, tt.wantType, tt.wantTitle, tt.wantCode)
+			}
+		})
+	}
+}
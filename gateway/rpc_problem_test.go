@@ -0,0 +1,250 @@
+package gateway
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	bookpb "github.com/JunBSer/services_proto/booking/gen/go"
+	"github.com/JunBSer/services_proto/fakes"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+)
+
+// rpcFixture serves the generated gateway handlers of all three services
+// against the fakes, with a signed-in user and admin and a hotel with one
+// room.
+type rpcFixture struct {
+	env     *fakes.Env
+	handler http.Handler
+
+	user, admin string // access tokens
+	hotel, room string
+	// ids maps IDs created during setup to the placeholders written to the
+	// golden files in their place.
+	ids map[string]string
+}
+
+func newRPCFixture(t *testing.T) *rpcFixture {
+	t.Helper()
+
+	ctx := context.Background()
+	env := fakes.MustStart(t, fakes.Options{})
+	mux := runtime.NewServeMux(muxOptions(Config{})...)
+	for _, register := range []func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error{
+		authpb.RegisterAuthHandler, hotelpb.RegisterHotelServiceHandler, bookpb.RegisterBookingServiceHandler,
+	} {
+		if err := register(ctx, mux, env.Conn); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f := &rpcFixture{env: env, handler: requestID(mux), ids: map[string]string{}}
+
+	signIn := func(email, password string) string {
+		resp, err := env.AuthClient.Login(ctx, &authpb.LoginRequest{Email: email, Password: password})
+		if err != nil {
+			t.Fatalf("Login %s: %v", email, err)
+		}
+		return resp.GetTokens().GetAccessToken()
+	}
+	f.admin = signIn("admin@example.com", "admin-password")
+	if _, err := env.AuthClient.Register(ctx, &authpb.RegisterRequest{
+		Name: "User", Email: "user@example.com", Password: "user-password",
+	}); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	f.user = signIn("user@example.com", "user-password")
+
+	admin := fakes.WithToken(ctx, f.admin)
+	h, err := env.HotelClient.CreateHotel(admin, &hotelpb.CreateHotelRequest{Name: "Seaside", Address: "1 Beach Rd"})
+	if err != nil {
+		t.Fatalf("CreateHotel: %v", err)
+	}
+	r, err := env.HotelClient.AddRoom(admin, &hotelpb.AddRoomRequest{HotelId: h.GetId(), Type: "Double", PricePerNight: 100})
+	if err != nil {
+		t.Fatalf("AddRoom: %v", err)
+	}
+	f.hotel, f.room = f.id(h.GetId(), "{hotel_id}"), f.id(r.GetId(), "{room_id}")
+	return f
+}
+
+// id records the placeholder for a generated ID and returns the ID.
+func (f *rpcFixture) id(id, placeholder string) string {
+	f.ids[id] = placeholder
+	return id
+}
+
+// book books the room for the given nights from 1 June 2030.
+func (f *rpcFixture) book(t *testing.T, from, nights int) string {
+	t.Helper()
+
+	start := time.Date(2030, 6, from, 0, 0, 0, 0, time.UTC)
+	resp, err := f.env.BookingClient.CreateBooking(fakes.WithToken(context.Background(), f.user), &bookpb.CreateBookingRequest{
+		HotelId: f.hotel, RoomId: f.room,
+		StartDate: timestamppb.New(start), EndDate: timestamppb.New(start.AddDate(0, 0, nights)),
+	})
+	if err != nil {
+		t.Fatalf("CreateBooking: %v", err)
+	}
+	return f.id(resp.GetBookingId(), "{booking_id}")
+}
+
+// do serves one HTTP request through the gateway handlers.
+func (f *rpcFixture) do(method, path, token, body string) *httptest.ResponseRecorder {
+	var r io.Reader
+	if body != "" {
+		r = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, path, r)
+	req.Header.Set(RequestIDHeader, "req-1")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	f.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func bookingBody(hotel, room, start, end string) string {
+	return `{"hotel_id":"` + hotel + `","room_id":"` + room + `","start_date":"` + start + `","end_date":"` + end + `"}`
+}
+
+// TestRPCProblems checks the problem+json responses of real RPC failures
+// against golden files in testdata/rpc-*.golden.
+func TestRPCProblems(t *testing.T) {
+	tests := []struct {
+		name string
+		// call sets up any state through gRPC and makes the failing HTTP
+		// call.
+		call        func(t *testing.T, f *rpcFixture) *httptest.ResponseRecorder
+		wantStatus  int
+		wantHeaders map[string]string
+	}{
+		{
+			name: "login-bad-credentials",
+			call: func(t *testing.T, f *rpcFixture) *httptest.ResponseRecorder {
+				return f.do(http.MethodPost, "/v1/auth/login", "", `{"email":"user@example.com","password":"wrong-password"}`)
+			},
+			wantStatus:  http.StatusUnauthorized,
+			wantHeaders: map[string]string{"WWW-Authenticate": "Bearer"},
+		},
+		{
+			name: "register-existing-email",
+			call: func(t *testing.T, f *rpcFixture) *httptest.ResponseRecorder {
+				return f.do(http.MethodPost, "/v1/auth/register", "",
+					`{"name":"Again","email":"user@example.com","password":"user-password"}`)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "register-invalid",
+			call: func(t *testing.T, f *rpcFixture) *httptest.ResponseRecorder {
+				return f.do(http.MethodPost, "/v1/auth/register", "", `{"name":"Bad","email":"not-an-email","password":"short"}`)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "list-users-anonymous",
+			call: func(t *testing.T, f *rpcFixture) *httptest.ResponseRecorder {
+				return f.do(http.MethodGet, "/v1/admin/users", "", "")
+			},
+			wantStatus:  http.StatusUnauthorized,
+			wantHeaders: map[string]string{"WWW-Authenticate": "Bearer"},
+		},
+		{
+			name: "list-users-as-user",
+			call: func(t *testing.T, f *rpcFixture) *httptest.ResponseRecorder {
+				return f.do(http.MethodGet, "/v1/admin/users", f.user, "")
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "get-hotel-unknown",
+			call: func(t *testing.T, f *rpcFixture) *httptest.ResponseRecorder {
+				return f.do(http.MethodGet, "/v1/hotels/missing", f.user, "")
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "get-room-unknown",
+			call: func(t *testing.T, f *rpcFixture) *httptest.ResponseRecorder {
+				return f.do(http.MethodGet, "/v1/hotels/"+f.hotel+"/rooms/missing", f.user, "")
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "create-booking-overlap",
+			call: func(t *testing.T, f *rpcFixture) *httptest.ResponseRecorder {
+				f.book(t, 1, 3)
+				return f.do(http.MethodPost, "/v1/bookings", f.user,
+					bookingBody(f.hotel, f.room, "2030-06-02T00:00:00Z", "2030-06-05T00:00:00Z"))
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "create-booking-empty-stay",
+			call: func(t *testing.T, f *rpcFixture) *httptest.ResponseRecorder {
+				return f.do(http.MethodPost, "/v1/bookings", f.user,
+					bookingBody(f.hotel, f.room, "2030-06-02T00:00:00Z", "2030-06-02T00:00:00Z"))
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "create-booking-unknown-room",
+			call: func(t *testing.T, f *rpcFixture) *httptest.ResponseRecorder {
+				return f.do(http.MethodPost, "/v1/bookings", f.user,
+					bookingBody(f.hotel, "missing", "2030-06-02T00:00:00Z", "2030-06-05T00:00:00Z"))
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "cancel-booking-twice",
+			call: func(t *testing.T, f *rpcFixture) *httptest.ResponseRecorder {
+				id := f.book(t, 1, 2)
+				if rec := f.do(http.MethodDelete, "/v1/cancel/"+id, f.user, "{}"); rec.Code != http.StatusOK {
+					t.Fatalf("first cancel = %d %s", rec.Code, rec.Body)
+				}
+				return f.do(http.MethodDelete, "/v1/cancel/"+id, f.user, "{}")
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "cancel-booking-unknown",
+			call: func(t *testing.T, f *rpcFixture) *httptest.ResponseRecorder {
+				return f.do(http.MethodDelete, "/v1/cancel/missing", f.user, "{}")
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newRPCFixture(t)
+			rec := tt.call(t, f)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Header().Get("Content-Type"); got != ProblemContentType {
+				t.Errorf("Content-Type = %q, want %q", got, ProblemContentType)
+			}
+			for k, want := range tt.wantHeaders {
+				if got := rec.Header().Get(k); got != want {
+					t.Errorf("%s = %q, want %q", k, got, want)
+				}
+			}
+			body := rec.Body.String()
+			for id, placeholder := range f.ids {
+				body = strings.ReplaceAll(body, id, placeholder)
+			}
+			checkGolden(t, "rpc-"+tt.name, []byte(body))
+		})
+	}
+}
@@ -0,0 +1,316 @@
+// Package catalog records hotel and room changes in a revisioned log, serves
+// them through the WatchCatalog RPC and follows that stream on the consumer
+// side.
+package catalog
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+)
+
+var (
+	ErrInvalidResumeToken = errors.New("catalog: invalid resume token")
+	ErrInvalidEvent       = errors.New("catalog: event has no change")
+)
+
+const tokenPrefix = "rev:"
+
+// ResumeToken encodes a revision as an opaque token.
+func ResumeToken(revision int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(tokenPrefix + strconv.FormatInt(revision, 10)))
+}
+
+// ParseResumeToken returns the revision encoded by ResumeToken.
+func ParseResumeToken(token string) (int64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidResumeToken
+	}
+	rev, ok := strings.CutPrefix(string(b), tokenPrefix)
+	if !ok {
+		return 0, ErrInvalidResumeToken
+	}
+	n, err := strconv.ParseInt(rev, 10, 64)
+	if err != nil || n < 0 {
+		return 0, ErrInvalidResumeToken
+	}
+	return n, nil
+}
+
+// HotelID returns the hotel a catalog event is about.
+func HotelID(e *hotelpb.CatalogEvent) string {
+	switch c := e.GetChange().(type) {
+	case *hotelpb.CatalogEvent_Hotel:
+		if c.Hotel.GetAfter() != nil {
+			return c.Hotel.GetAfter().GetId()
+		}
+		return c.Hotel.GetBefore().GetId()
+	case *hotelpb.CatalogEvent_Room:
+		return c.Room.GetHotelId()
+	}
+	return ""
+}
+
+// Log is an in-memory change log that keeps the most recent events. Revisions
+// start at 1 and increase by one per change.
+type Log struct {
+	retain  int
+	backlog int
+	now     func() time.Time
+
+	mu       sync.Mutex
+	events   []*hotelpb.CatalogEvent
+	revision int64
+	// appended is closed and replaced whenever an event is added.
+	appended chan struct{}
+	// watchers holds the last revision sent to each stream served by Serve.
+	watchers map[*watcher]struct{}
+}
+
+type watcher struct {
+	revision int64
+}
+
+// NewLog returns a log retaining up to retain events for resuming watchers.
+// Events a connected watcher has not been sent yet are kept beyond retain, up
+// to backlog events, so a burst of changes does not end live streams. Like a
+// slow eventbus subscriber, a watcher that falls further behind is ended with
+// RESUME_TOKEN_EXPIRED and must resync. It panics if retain is not positive
+// or backlog is less than retain.
+func NewLog(retain, backlog int, now func() time.Time) *Log {
+	if retain <= 0 {
+		panic(fmt.Sprintf("catalog: NewLog retain must be positive, got %d", retain))
+	}
+	if backlog < retain {
+		panic(fmt.Sprintf("catalog: NewLog backlog %d is less than retain %d", backlog, retain))
+	}
+	if now == nil {
+		now = time.Now
+	}
+	return &Log{
+		retain: retain, backlog: backlog, now: now,
+		appended: make(chan struct{}), watchers: make(map[*watcher]struct{}),
+	}
+}
+
+// HotelEvent returns an event for a hotel change, without a revision. Room
+// lists are stripped from the snapshots; room edits are recorded with
+// RoomEvent.
+func HotelEvent(change hotelpb.ChangeType, before, after *hotelpb.Hotel) *hotelpb.CatalogEvent {
+	return &hotelpb.CatalogEvent{Change: &hotelpb.CatalogEvent_Hotel{Hotel: &hotelpb.HotelChanged{
+		Change: change,
+		Before: withoutRooms(before),
+		After:  withoutRooms(after),
+	}}}
+}
+
+// RoomEvent returns an event for a change to one of hotelID's rooms, without
+// a revision.
+func RoomEvent(hotelID string, change hotelpb.ChangeType, before, after *hotelpb.Room) *hotelpb.CatalogEvent {
+	return &hotelpb.CatalogEvent{Change: &hotelpb.CatalogEvent_Room{Room: &hotelpb.RoomChanged{
+		HotelId: hotelID,
+		Change:  change,
+		Before:  cloneRoom(before),
+		After:   cloneRoom(after),
+	}}}
+}
+
+// HotelChanged records a hotel change.
+func (l *Log) HotelChanged(change hotelpb.ChangeType, before, after *hotelpb.Hotel) *hotelpb.CatalogEvent {
+	return l.append(HotelEvent(change, before, after))
+}
+
+// RoomChanged records a change to one of hotelID's rooms.
+func (l *Log) RoomChanged(hotelID string, change hotelpb.ChangeType, before, after *hotelpb.Room) *hotelpb.CatalogEvent {
+	return l.append(RoomEvent(hotelID, change, before, after))
+}
+
+// Append records an event built with HotelEvent or RoomEvent, e.g. one
+// delivered from the outbox. It sets the event's revision, resume token and
+// time.
+func (l *Log) Append(e *hotelpb.CatalogEvent) (*hotelpb.CatalogEvent, error) {
+	if e.GetChange() == nil {
+		return nil, ErrInvalidEvent
+	}
+	return l.append(e), nil
+}
+
+func withoutRooms(h *hotelpb.Hotel) *hotelpb.Hotel {
+	if h == nil {
+		return nil
+	}
+	h = proto.Clone(h).(*hotelpb.Hotel)
+	h.Rooms = nil
+	return h
+}
+
+func cloneRoom(r *hotelpb.Room) *hotelpb.Room {
+	if r == nil {
+		return nil
+	}
+	return proto.Clone(r).(*hotelpb.Room)
+}
+
+func (l *Log) append(e *hotelpb.CatalogEvent) *hotelpb.CatalogEvent {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.revision++
+	e.Revision = l.revision
+	e.ResumeToken = ResumeToken(l.revision)
+	e.Time = timestamppb.New(l.now())
+
+	l.events = append(l.events, e)
+	keep := l.retain
+	for w := range l.watchers {
+		keep = max(keep, int(min(l.revision-w.revision, int64(l.backlog))))
+	}
+	if over := len(l.events) - keep; over > 0 {
+		l.events = append(l.events[:0:0], l.events[over:]...)
+	}
+	close(l.appended)
+	l.appended = make(chan struct{})
+	return e
+}
+
+// Revision returns the revision of the latest change.
+func (l *Log) Revision() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.revision
+}
+
+// Since returns the retained events after revision and a channel closed when
+// the next event is appended. It fails with a RESUME_TOKEN_EXPIRED error when
+// events after revision have already been discarded.
+func (l *Log) Since(revision int64) ([]*hotelpb.CatalogEvent, <-chan struct{}, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.since(revision)
+}
+
+// since implements Since. l.mu must be held.
+func (l *Log) since(revision int64) ([]*hotelpb.CatalogEvent, <-chan struct{}, error) {
+	if revision > l.revision {
+		return nil, nil, apierrors.Validation("invalid resume token",
+			apierrors.FieldViolation{Field: "resume_token", Description: "refers to a future revision"})
+	}
+	oldest := l.revision - int64(len(l.events)) + 1
+	if revision+1 < oldest {
+		return nil, nil, apierrors.New(codes.OutOfRange, apierrors.ReasonResumeTokenExpired,
+			fmt.Sprintf("revision %d is no longer retained; resync and watch from now", revision))
+	}
+
+	events := l.events[len(l.events)-int(l.revision-revision):]
+	return events, l.appended, nil
+}
+
+// Serve implements the WatchCatalog RPC on top of the log. Without a resume
+// token the stream starts with a bookmark: an event carrying the current
+// revision and resume token but no change. A stream filtered by hotel gets a
+// bookmark after changes to other hotels, so its resume token keeps up with
+// the log. A stream that falls more than the backlog behind ends with
+// RESUME_TOKEN_EXPIRED.
+func (l *Log) Serve(req *hotelpb.WatchCatalogRequest, stream hotelpb.HotelService_WatchCatalogServer) error {
+	w, err := l.watch(req.GetResumeToken())
+	if err != nil {
+		return err
+	}
+	defer l.unwatch(w)
+
+	revision := w.revision
+	if req.GetResumeToken() == "" {
+		if err := stream.Send(l.bookmark(revision)); err != nil {
+			return err
+		}
+	}
+
+	ctx := stream.Context()
+	for {
+		events, appended, err := l.next(w, revision)
+		if err != nil {
+			return err
+		}
+		sent := revision
+		for _, e := range events {
+			revision = e.GetRevision()
+			if req.GetHotelId() != "" && HotelID(e) != req.GetHotelId() {
+				continue
+			}
+			if err := stream.Send(e); err != nil {
+				return err
+			}
+			sent = revision
+		}
+		if sent < revision {
+			if err := stream.Send(l.bookmark(revision)); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-appended:
+		}
+	}
+}
+
+// bookmark returns an event carrying revision and its resume token but no
+// change.
+func (l *Log) bookmark(revision int64) *hotelpb.CatalogEvent {
+	return &hotelpb.CatalogEvent{Revision: revision, ResumeToken: ResumeToken(revision), Time: timestamppb.New(l.now())}
+}
+
+// watch registers a stream starting after the revision of token, or at the
+// current revision if token is empty.
+func (l *Log) watch(token string) (*watcher, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	w := &watcher{revision: l.revision}
+	if token != "" {
+		rev, err := ParseResumeToken(token)
+		if err != nil {
+			return nil, apierrors.Validation("invalid resume token",
+				apierrors.FieldViolation{Field: "resume_token", Description: "is malformed"})
+		}
+		if _, _, err := l.since(rev); err != nil {
+			return nil, err
+		}
+		w.revision = rev
+	}
+	l.watchers[w] = struct{}{}
+	return w, nil
+}
+
+// next records that w has been sent everything up to revision and returns
+// the events after it.
+func (l *Log) next(w *watcher, revision int64) ([]*hotelpb.CatalogEvent, <-chan struct{}, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	w.revision = revision
+	return l.since(revision)
+}
+
+func (l *Log) unwatch(w *watcher) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.watchers, w)
+}
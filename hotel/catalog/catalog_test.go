@@ -0,0 +1,316 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+)
+
+func fixedNow() time.Time { return time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC) }
+
+// addHotels appends n hotel creations to l.
+func addHotels(l *Log, n int) {
+	for range n {
+		id := fmt.Sprintf("h-%d", l.Revision()+1)
+		l.HotelChanged(hotelpb.ChangeType_CHANGE_TYPE_CREATED, nil, &hotelpb.Hotel{Id: id})
+	}
+}
+
+func revisions(events []*hotelpb.CatalogEvent) []int64 {
+	var out []int64
+	for _, e := range events {
+		out = append(out, e.GetRevision())
+	}
+	return out
+}
+
+func TestParseResumeToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		want    int64
+		wantErr bool
+	}{
+		{"round trip", ResumeToken(42), 42, false},
+		{"zero", ResumeToken(0), 0, false},
+		{"not base64", "!!!", 0, true},
+		{"missing prefix", "NDI", 0, true},
+		{"negative", ResumeToken(-1), 0, true},
+		{"not a number", "cmV2Ong", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseResumeToken(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseResumeToken(%q) error = %v, wantErr %v", tt.token, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseResumeToken(%q) = %d, want %d", tt.token, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewLogRejects(t *testing.T) {
+	tests := []struct{ retain, backlog int }{{0, 0}, {-1, 5}, {5, 4}}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.retain, "/", tt.backlog), func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewLog(%d, %d) did not panic", tt.retain, tt.backlog)
+				}
+			}()
+			NewLog(tt.retain, tt.backlog, nil)
+		})
+	}
+}
+
+func TestLogSince(t *testing.T) {
+	l := NewLog(3, 3, fixedNow)
+	addHotels(l, 5)
+
+	tests := []struct {
+		name       string
+		revision   int64
+		want       []int64
+		wantCode   codes.Code
+		wantReason apierrors.Reason
+	}{
+		{"latest", 5, nil, codes.OK, ""},
+		{"within retention", 3, []int64{4, 5}, codes.OK, ""},
+		{"oldest retained", 2, []int64{3, 4, 5}, codes.OK, ""},
+		{"discarded", 1, nil, codes.OutOfRange, apierrors.ReasonResumeTokenExpired},
+		{"future", 6, nil, codes.InvalidArgument, apierrors.ReasonValidationFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, appended, err := l.Since(tt.revision)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("Since(%d) code = %v, want %v (%v)", tt.revision, got, tt.wantCode, err)
+			}
+			if err != nil {
+				if !apierrors.Is(err, tt.wantReason) {
+					t.Errorf("Since(%d) reason = %v, want %v", tt.revision, apierrors.ReasonOf(err), tt.wantReason)
+				}
+				return
+			}
+			if fmt.Sprint(revisions(events)) != fmt.Sprint(tt.want) {
+				t.Errorf("Since(%d) = %v, want %v", tt.revision, revisions(events), tt.want)
+			}
+			if appended == nil {
+				t.Error("Since returned no appended channel")
+			}
+		})
+	}
+}
+
+func TestLogEvents(t *testing.T) {
+	l := NewLog(10, 10, fixedNow)
+	hotel := &hotelpb.Hotel{Id: "h-1", Rooms: []*hotelpb.Room{{Id: "r-1"}}}
+	e := l.HotelChanged(hotelpb.ChangeType_CHANGE_TYPE_CREATED, nil, hotel)
+	if e.GetRevision() != 1 || e.GetResumeToken() != ResumeToken(1) || !e.GetTime().AsTime().Equal(fixedNow()) {
+		t.Errorf("HotelChanged = %v, want revision 1 at %v", e, fixedNow())
+	}
+	if len(e.GetHotel().GetAfter().GetRooms()) != 0 || len(hotel.GetRooms()) != 1 {
+		t.Error("HotelChanged must strip rooms from the snapshot without touching the input")
+	}
+	r := l.RoomChanged("h-1", hotelpb.ChangeType_CHANGE_TYPE_DELETED, &hotelpb.Room{Id: "r-1"}, nil)
+	if r.GetRevision() != 2 || HotelID(r) != "h-1" || HotelID(e) != "h-1" {
+		t.Errorf("RoomChanged = %v, want revision 2 of hotel h-1", r)
+	}
+}
+
+// fakeStream hands sent events to the test one at a time.
+type fakeStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *hotelpb.CatalogEvent
+}
+
+func (s *fakeStream) Context() context.Context { return s.ctx }
+
+func (s *fakeStream) Send(e *hotelpb.CatalogEvent) error {
+	select {
+	case s.sent <- e:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func serve(t *testing.T, l *Log, req *hotelpb.WatchCatalogRequest) (*fakeStream, <-chan error) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	stream := &fakeStream{ctx: ctx, sent: make(chan *hotelpb.CatalogEvent)}
+	done := make(chan error, 1)
+	go func() { done <- l.Serve(req, stream) }()
+	return stream, done
+}
+
+func recv(t *testing.T, s *fakeStream, done <-chan error) *hotelpb.CatalogEvent {
+	t.Helper()
+
+	select {
+	case e := <-s.sent:
+		return e
+	case err := <-done:
+		t.Fatalf("Serve ended: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for an event")
+	}
+	return nil
+}
+
+func TestServeBookmark(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  []int64
+	}{
+		{"empty token starts with a bookmark", "", []int64{3, 4}},
+		{"resume token replays without bookmark", ResumeToken(1), []int64{2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLog(10, 10, fixedNow)
+			addHotels(l, 3)
+			stream, done := serve(t, l, &hotelpb.WatchCatalogRequest{ResumeToken: tt.token})
+
+			var got []int64
+			for i := range tt.want {
+				if i == len(tt.want)-1 {
+					addHotels(l, 1)
+				}
+				e := recv(t, stream, done)
+				got = append(got, e.GetRevision())
+				if e.GetResumeToken() != ResumeToken(e.GetRevision()) {
+					t.Errorf("event %d has resume token %q", e.GetRevision(), e.GetResumeToken())
+				}
+				isBookmark := e.GetChange() == nil
+				if wantBookmark := tt.token == "" && i == 0; isBookmark != wantBookmark {
+					t.Errorf("event %d bookmark = %v, want %v", e.GetRevision(), isBookmark, wantBookmark)
+				}
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("revisions = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServeRejectsTokens(t *testing.T) {
+	l := NewLog(2, 2, fixedNow)
+	addHotels(l, 5)
+
+	tests := []struct {
+		name     string
+		token    string
+		wantCode codes.Code
+	}{
+		{"malformed", "!!!", codes.InvalidArgument},
+		{"expired", ResumeToken(1), codes.OutOfRange},
+		{"future", ResumeToken(9), codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, done := serve(t, l, &hotelpb.WatchCatalogRequest{ResumeToken: tt.token})
+			select {
+			case err := <-done:
+				if got := status.Code(err); got != tt.wantCode {
+					t.Errorf("Serve code = %v, want %v (%v)", got, tt.wantCode, err)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("Serve did not fail")
+			}
+		})
+	}
+}
+
+func TestServeSurvivesBurst(t *testing.T) {
+	const retain = 2
+	l := NewLog(retain, 20, fixedNow)
+	stream, done := serve(t, l, &hotelpb.WatchCatalogRequest{})
+	recv(t, stream, done) // bookmark
+
+	// The stream blocks in Send with revision 1 while ten more changes
+	// arrive, far more than the log retains.
+	addHotels(l, 1)
+	addHotels(l, 10)
+	for want := int64(1); want <= 11; want++ {
+		if got := recv(t, stream, done).GetRevision(); got != want {
+			t.Fatalf("revision = %d, want %d", got, want)
+		}
+	}
+
+	// Once the watcher has caught up, the log shrinks back to retain. The
+	// watcher has at least asked for the events after 11 before being sent
+	// 12, so appending 13 trims everything before 12.
+	addHotels(l, 1)
+	recv(t, stream, done)
+	addHotels(l, 1)
+	recv(t, stream, done)
+	if _, _, err := l.Since(13 - retain - 1); status.Code(err) != codes.OutOfRange {
+		t.Errorf("Since after catching up = %v, want OutOfRange", err)
+	}
+}
+
+func TestServeEndsLaggingWatcher(t *testing.T) {
+	const retain, backlog = 2, 4
+	l := NewLog(retain, backlog, fixedNow)
+	stream, done := serve(t, l, &hotelpb.WatchCatalogRequest{})
+	recv(t, stream, done) // bookmark
+
+	// The stream stalls while more changes arrive than the backlog holds.
+	addHotels(l, 11)
+	if _, _, err := l.Since(11 - backlog - 1); status.Code(err) != codes.OutOfRange {
+		t.Errorf("Since beyond the backlog = %v, want OutOfRange", err)
+	}
+	for {
+		select {
+		case <-stream.sent:
+			// The event the stream was blocked on, if any.
+		case err := <-done:
+			if !apierrors.Is(err, apierrors.ReasonResumeTokenExpired) {
+				t.Errorf("Serve = %v, want RESUME_TOKEN_EXPIRED", err)
+			}
+			return
+		case <-time.After(5 * time.Second):
+			t.Fatal("lagging watcher was not ended")
+		}
+	}
+}
+
+func TestServeFiltersByHotel(t *testing.T) {
+	l := NewLog(10, 10, fixedNow)
+	stream, done := serve(t, l, &hotelpb.WatchCatalogRequest{HotelId: "h-2"})
+	recv(t, stream, done) // bookmark
+
+	// Changes to other hotels arrive as bookmarks, so the resume token
+	// still reaches the latest revision.
+	addHotels(l, 3)
+	var changes []string
+	for e := recv(t, stream, done); ; e = recv(t, stream, done) {
+		if e.GetChange() != nil {
+			changes = append(changes, HotelID(e))
+		}
+		if e.GetRevision() == 3 {
+			if e.GetChange() != nil || e.GetResumeToken() != ResumeToken(3) {
+				t.Errorf("last event = %v, want a bookmark for revision 3", e)
+			}
+			break
+		}
+	}
+	if fmt.Sprint(changes) != "[h-2]" {
+		t.Errorf("changes of hotels %v, want [h-2]", changes)
+	}
+}
@@ -0,0 +1,60 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+	"github.com/JunBSer/services_proto/outbox"
+)
+
+// CloudEvents binary-mode header names set on outbox records.
+const (
+	HeaderType    = "ce-type"
+	HeaderSubject = "ce-subject"
+)
+
+// Record serializes e, built with HotelEvent or RoomEvent, for the outbox.
+// The hotel service adds it with outbox.Writer in the transaction that
+// changes the catalog; OutboxSink later appends it to the Log, which assigns
+// its revision.
+func Record(e *hotelpb.CatalogEvent) (outbox.Record, error) {
+	var typ string
+	switch c := e.GetChange().(type) {
+	case *hotelpb.CatalogEvent_Hotel:
+		typ = string(c.Hotel.ProtoReflect().Descriptor().FullName())
+	case *hotelpb.CatalogEvent_Room:
+		typ = string(c.Room.ProtoReflect().Descriptor().FullName())
+	default:
+		return outbox.Record{}, ErrInvalidEvent
+	}
+	return outbox.NewRecord("", e, map[string]string{
+		HeaderType:    typ,
+		HeaderSubject: HotelID(e),
+	})
+}
+
+// OutboxSink appends outbox records holding catalog events to l. A batch
+// that failed part-way is redelivered in full, so seen, when not nil, drops
+// records that were already appended instead of recording the change twice.
+func OutboxSink(l *Log, seen *outbox.Deduper) outbox.Sink {
+	return outbox.SinkFunc(func(ctx context.Context, records []outbox.Record) error {
+		for _, r := range records {
+			m, err := r.Unmarshal()
+			if err != nil {
+				return err
+			}
+			e, ok := m.(*hotelpb.CatalogEvent)
+			if !ok {
+				return fmt.Errorf("catalog: outbox record %s holds %s, not a catalog event", r.ID, r.TypeURL)
+			}
+			if seen != nil && seen.Seen(r.ID) {
+				continue
+			}
+			if _, err := l.Append(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,168 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+	"github.com/JunBSer/services_proto/outbox"
+)
+
+func TestRecord(t *testing.T) {
+	tests := []struct {
+		name        string
+		event       *hotelpb.CatalogEvent
+		wantType    string
+		wantSubject string
+		wantErr     error
+	}{
+		{
+			name: "hotel",
+			event: HotelEvent(hotelpb.ChangeType_CHANGE_TYPE_CREATED, nil,
+				&hotelpb.Hotel{Id: "h-1", Rooms: []*hotelpb.Room{{Id: "r-1"}}}),
+			wantType:    "hotel.HotelChanged",
+			wantSubject: "h-1",
+		},
+		{
+			name:        "room",
+			event:       RoomEvent("h-2", hotelpb.ChangeType_CHANGE_TYPE_DELETED, &hotelpb.Room{Id: "r-1"}, nil),
+			wantType:    "hotel.RoomChanged",
+			wantSubject: "h-2",
+		},
+		{name: "no change", event: &hotelpb.CatalogEvent{}, wantErr: ErrInvalidEvent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Record(tt.event)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Record error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if r.ID == "" || r.Headers[HeaderType] != tt.wantType || r.Headers[HeaderSubject] != tt.wantSubject {
+				t.Errorf("record %s headers = %v, want type %s subject %s", r.ID, r.Headers, tt.wantType, tt.wantSubject)
+			}
+			m, err := r.Unmarshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(m, tt.event) {
+				t.Errorf("payload = %v, want %v", m, tt.event)
+			}
+		})
+	}
+}
+
+func TestHotelEventStripsRooms(t *testing.T) {
+	h := &hotelpb.Hotel{Id: "h-1", Rooms: []*hotelpb.Room{{Id: "r-1"}}}
+	e := HotelEvent(hotelpb.ChangeType_CHANGE_TYPE_UPDATED, h, h)
+	if got := e.GetHotel(); len(got.GetBefore().GetRooms())+len(got.GetAfter().GetRooms()) != 0 {
+		t.Errorf("event keeps rooms: %v", got)
+	}
+	if len(h.GetRooms()) != 1 {
+		t.Error("HotelEvent modified the caller's hotel")
+	}
+}
+
+// TestOutboxToLog runs catalog changes through an outbox transaction and the
+// relay into the log, the path the hotel service takes.
+func TestOutboxToLog(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		// commit reports whether the transaction holding the changes commits.
+		commit bool
+		// failures fail the sink's first deliveries after appending
+		// everything, so the batch is redelivered.
+		failures   int
+		dedup      bool
+		wantHotels []string
+	}{
+		{name: "committed", commit: true, wantHotels: []string{"h-1", "h-2"}},
+		{name: "rolled back", commit: false},
+		{name: "redelivered with deduper", commit: true, failures: 1, dedup: true, wantHotels: []string{"h-1", "h-2"}},
+		{name: "redelivered without deduper", commit: true, failures: 1, wantHotels: []string{"h-1", "h-2", "h-1", "h-2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := outbox.NewMemoryStore()
+			tx := store.Begin()
+			for _, id := range []string{"h-1", "h-2"} {
+				rec, err := Record(HotelEvent(hotelpb.ChangeType_CHANGE_TYPE_CREATED, nil, &hotelpb.Hotel{Id: id}))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := store.Add(ctx, tx, rec); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.commit {
+				if err := tx.Commit(); err != nil {
+					t.Fatal(err)
+				}
+			} else {
+				tx.Rollback()
+			}
+
+			log := NewLog(10, 10, fixedNow)
+			var seen *outbox.Deduper
+			if tt.dedup {
+				seen = outbox.NewDeduper(10)
+			}
+			sink := OutboxSink(log, seen)
+			failures := tt.failures
+			relay := outbox.NewRelay(store, outbox.SinkFunc(func(ctx context.Context, records []outbox.Record) error {
+				if err := sink.Deliver(ctx, records); err != nil {
+					return err
+				}
+				if failures > 0 {
+					failures--
+					return errors.New("acknowledgement lost")
+				}
+				return nil
+			}))
+			for range tt.failures + 1 {
+				_, _ = relay.Flush(ctx)
+			}
+
+			events, _, err := log.Since(0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var hotels []string
+			for i, e := range events {
+				if e.GetRevision() != int64(i+1) {
+					t.Errorf("event %d has revision %d", i, e.GetRevision())
+				}
+				hotels = append(hotels, HotelID(e))
+			}
+			if !slices.Equal(hotels, tt.wantHotels) {
+				t.Errorf("log holds hotels %v, want %v", hotels, tt.wantHotels)
+			}
+			if store.Len() != 0 {
+				t.Errorf("%d records left pending", store.Len())
+			}
+		})
+	}
+}
+
+func TestOutboxSinkRejectsOtherPayloads(t *testing.T) {
+	rec, err := outbox.NewRecord("", wrapperspb.String("not a catalog event"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := NewLog(10, 10, fixedNow)
+	if err := OutboxSink(log, nil).Deliver(context.Background(), []outbox.Record{rec}); err == nil {
+		t.Error("Deliver accepted a record that is not a catalog event")
+	}
+	if log.Revision() != 0 {
+		t.Errorf("log revision = %d, want 0", log.Revision())
+	}
+}
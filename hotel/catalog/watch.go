@@ -0,0 +1,138 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+)
+
+// Watcher follows WatchCatalog, reconnecting with the last resume token when
+// the stream breaks, so every change is handled once and in order.
+//
+// Bookmarks, events without a change, only advance ResumeToken and are not
+// handed to the handler. When the server no longer retains the resume
+// revision, Run returns an error with reason RESUME_TOKEN_EXPIRED: the
+// consumer must reload the catalog, clear ResumeToken and run again.
+type Watcher struct {
+	Client  hotelpb.HotelServiceClient
+	HotelID string
+	// ResumeToken is where watching starts, and is advanced after every
+	// handled event and bookmark. Empty starts with the next change.
+	ResumeToken string
+
+	InitialBackoff time.Duration
+	// MaxBackoff defaults to 30s.
+	MaxBackoff time.Duration
+	// Codes are reconnected on in addition to a cleanly closed stream.
+	// Defaults to Unavailable, Internal, Unknown and DeadlineExceeded.
+	Codes []codes.Code
+}
+
+var defaultReconnectCodes = []codes.Code{codes.Unavailable, codes.Internal, codes.Unknown, codes.DeadlineExceeded}
+
+// Run streams events to handle until ctx is done, handle fails or the stream
+// fails with a code that is not reconnected on.
+func (w *Watcher) Run(ctx context.Context, handle func(context.Context, *hotelpb.CatalogEvent) error) error {
+	for retry := 0; ; {
+		received, err := w.watch(ctx, handle)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var he handlerError
+		if errors.As(err, &he) {
+			return he.err
+		}
+		if !w.reconnectable(err) {
+			return err
+		}
+
+		if received {
+			retry = 0
+		}
+		retry++
+		timer := time.NewTimer(w.backoff(retry))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+type handlerError struct{ err error }
+
+func (e handlerError) Error() string { return e.err.Error() }
+
+// watch runs a single stream and reports whether any event arrived.
+func (w *Watcher) watch(ctx context.Context, handle func(context.Context, *hotelpb.CatalogEvent) error) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := w.Client.WatchCatalog(ctx, &hotelpb.WatchCatalogRequest{
+		ResumeToken: w.ResumeToken,
+		HotelId:     w.HotelID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	received := false
+	for {
+		e, err := stream.Recv()
+		if err != nil {
+			return received, err
+		}
+		if e.GetChange() != nil {
+			received = true
+			if err := handle(ctx, e); err != nil {
+				return received, handlerError{err}
+			}
+		}
+		w.ResumeToken = e.GetResumeToken()
+	}
+}
+
+func (w *Watcher) reconnectable(err error) bool {
+	if errors.Is(err, io.EOF) {
+		return true
+	}
+	reconnectCodes := w.Codes
+	if len(reconnectCodes) == 0 {
+		reconnectCodes = defaultReconnectCodes
+	}
+	code := status.Code(err)
+	for _, c := range reconnectCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the given reconnect (1-based) with full
+// jitter.
+func (w *Watcher) backoff(retry int) time.Duration {
+	d, maxBackoff := w.InitialBackoff, w.MaxBackoff
+	if d <= 0 {
+		d = 100 * time.Millisecond
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = 30 * time.Second
+	}
+	for i := 1; i < retry; i++ {
+		d *= 2
+		if d >= maxBackoff {
+			d = maxBackoff
+			break
+		}
+	}
+	return time.Duration(rand.Int64N(int64(d)) + 1)
+}
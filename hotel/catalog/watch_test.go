@@ -0,0 +1,178 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+)
+
+// watchServer serves WatchCatalog from a Log. Each stream first runs the
+// next entry of breaks, if any, which can send through the stream and end
+// it with an error.
+type watchServer struct {
+	hotelpb.UnimplementedHotelServiceServer
+	log *Log
+
+	mu      sync.Mutex
+	breaks  []func(*hotelpb.WatchCatalogRequest, hotelpb.HotelService_WatchCatalogServer) error
+	streams []*hotelpb.WatchCatalogRequest
+}
+
+func (s *watchServer) WatchCatalog(req *hotelpb.WatchCatalogRequest, stream hotelpb.HotelService_WatchCatalogServer) error {
+	s.mu.Lock()
+	s.streams = append(s.streams, req)
+	var brk func(*hotelpb.WatchCatalogRequest, hotelpb.HotelService_WatchCatalogServer) error
+	if len(s.breaks) > 0 {
+		brk, s.breaks = s.breaks[0], s.breaks[1:]
+	}
+	s.mu.Unlock()
+
+	if brk != nil {
+		return brk(req, stream)
+	}
+	return s.log.Serve(req, stream)
+}
+
+func startWatchServer(t *testing.T, srv *watchServer) hotelpb.HotelServiceClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer()
+	hotelpb.RegisterHotelServiceServer(gs, srv)
+	go gs.Serve(lis)
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return hotelpb.NewHotelServiceClient(conn)
+}
+
+// runUntil runs w until handle has seen n events and returns their
+// revisions.
+func runUntil(t *testing.T, w *Watcher, n int) ([]int64, error) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var got []int64
+	errDone := errors.New("done")
+	err := w.Run(ctx, func(_ context.Context, e *hotelpb.CatalogEvent) error {
+		got = append(got, e.GetRevision())
+		if len(got) == n {
+			return errDone
+		}
+		return nil
+	})
+	if errors.Is(err, errDone) {
+		err = nil
+	}
+	return got, err
+}
+
+func TestWatcherKeepsChangesAcrossReconnects(t *testing.T) {
+	l := NewLog(10, 10, fixedNow)
+	addHotels(l, 2)
+
+	srv := &watchServer{log: l}
+	// The first stream only delivers the bookmark; changes then happen
+	// while the watcher is disconnected.
+	srv.breaks = append(srv.breaks, func(req *hotelpb.WatchCatalogRequest, stream hotelpb.HotelService_WatchCatalogServer) error {
+		rev := l.Revision()
+		if err := stream.Send(&hotelpb.CatalogEvent{Revision: rev, ResumeToken: ResumeToken(rev)}); err != nil {
+			return err
+		}
+		addHotels(l, 2)
+		return status.Error(codes.Unavailable, "connection reset")
+	})
+	w := &Watcher{Client: startWatchServer(t, srv), InitialBackoff: time.Millisecond}
+
+	got, err := runUntil(t, w, 2)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(got) != 2 || got[0] != 3 || got[1] != 4 {
+		t.Errorf("handled revisions %v, want [3 4]", got)
+	}
+	// The handler failed on revision 4, so the watcher resumes there.
+	if w.ResumeToken != ResumeToken(3) {
+		t.Errorf("ResumeToken = %q, want the token of revision 3", w.ResumeToken)
+	}
+	if srv.streams[1].GetResumeToken() != ResumeToken(2) {
+		t.Errorf("reconnected with token %q, want the bookmark's", srv.streams[1].GetResumeToken())
+	}
+}
+
+func TestWatcherRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		codes    []codes.Code
+		fail     codes.Code
+		wantCode codes.Code
+	}{
+		{"reconnects on Unavailable", nil, codes.Unavailable, codes.OK},
+		{"stops on PermissionDenied", nil, codes.PermissionDenied, codes.PermissionDenied},
+		{"custom codes", []codes.Code{codes.Aborted}, codes.Aborted, codes.OK},
+		{"custom codes replace the defaults", []codes.Code{codes.Aborted}, codes.Unavailable, codes.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLog(10, 10, fixedNow)
+			addHotels(l, 1)
+
+			srv := &watchServer{log: l}
+			srv.breaks = append(srv.breaks, func(*hotelpb.WatchCatalogRequest, hotelpb.HotelService_WatchCatalogServer) error {
+				return status.Error(tt.fail, "broken")
+			})
+			w := &Watcher{
+				Client: startWatchServer(t, srv), ResumeToken: ResumeToken(0),
+				InitialBackoff: time.Millisecond, Codes: tt.codes,
+			}
+
+			got, err := runUntil(t, w, 1)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("Run error = %v, want code %v", err, tt.wantCode)
+			}
+			if err == nil && (len(got) != 1 || got[0] != 1) {
+				t.Errorf("handled revisions %v, want [1]", got)
+			}
+		})
+	}
+}
+
+func TestWatcherBackoff(t *testing.T) {
+	w := &Watcher{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	tests := []struct {
+		retry int
+		max   time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second},
+		{50, time.Second},
+	}
+	for _, tt := range tests {
+		for range 20 {
+			if d := w.backoff(tt.retry); d <= 0 || d > tt.max {
+				t.Errorf("backoff(%d) = %v, want in (0, %v]", tt.retry, d, tt.max)
+			}
+		}
+	}
+}
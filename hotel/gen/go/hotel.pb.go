@@ -26,6 +26,171 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MealPlan int32
+
+const (
+	MealPlan_MEAL_PLAN_UNSPECIFIED   MealPlan = 0
+	MealPlan_MEAL_PLAN_ROOM_ONLY     MealPlan = 1
+	MealPlan_MEAL_PLAN_BREAKFAST     MealPlan = 2
+	MealPlan_MEAL_PLAN_HALF_BOARD    MealPlan = 3
+	MealPlan_MEAL_PLAN_FULL_BOARD    MealPlan = 4
+	MealPlan_MEAL_PLAN_ALL_INCLUSIVE MealPlan = 5
+)
+
+// Enum value maps for MealPlan.
+var (
+	MealPlan_name = map[int32]string{
+		0: "MEAL_PLAN_UNSPECIFIED",
+		1: "MEAL_PLAN_ROOM_ONLY",
+		2: "MEAL_PLAN_BREAKFAST",
+		3: "MEAL_PLAN_HALF_BOARD",
+		4: "MEAL_PLAN_FULL_BOARD",
+		5: "MEAL_PLAN_ALL_INCLUSIVE",
+	}
+	MealPlan_value = map[string]int32{
+		"MEAL_PLAN_UNSPECIFIED":   0,
+		"MEAL_PLAN_ROOM_ONLY":     1,
+		"MEAL_PLAN_BREAKFAST":     2,
+		"MEAL_PLAN_HALF_BOARD":    3,
+		"MEAL_PLAN_FULL_BOARD":    4,
+		"MEAL_PLAN_ALL_INCLUSIVE": 5,
+	}
+)
+
+func (x MealPlan) Enum() *MealPlan {
+	p := new(MealPlan)
+	*p = x
+	return p
+}
+
+func (x MealPlan) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MealPlan) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_hotel_proto_enumTypes[0].Descriptor()
+}
+
+func (MealPlan) Type() protoreflect.EnumType {
+	return &file_proto_hotel_proto_enumTypes[0]
+}
+
+func (x MealPlan) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MealPlan.Descriptor instead.
+func (MealPlan) EnumDescriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{0}
+}
+
+type AdjustmentType int32
+
+const (
+	AdjustmentType_ADJUSTMENT_TYPE_UNSPECIFIED AdjustmentType = 0
+	// Adds adjustment percent of the price, e.g. 20 or -15.
+	AdjustmentType_ADJUSTMENT_TYPE_PERCENT AdjustmentType = 1
+	// Adds adjustment to the price.
+	AdjustmentType_ADJUSTMENT_TYPE_AMOUNT AdjustmentType = 2
+	// Sets the price to adjustment.
+	AdjustmentType_ADJUSTMENT_TYPE_FIXED_PRICE AdjustmentType = 3
+)
+
+// Enum value maps for AdjustmentType.
+var (
+	AdjustmentType_name = map[int32]string{
+		0: "ADJUSTMENT_TYPE_UNSPECIFIED",
+		1: "ADJUSTMENT_TYPE_PERCENT",
+		2: "ADJUSTMENT_TYPE_AMOUNT",
+		3: "ADJUSTMENT_TYPE_FIXED_PRICE",
+	}
+	AdjustmentType_value = map[string]int32{
+		"ADJUSTMENT_TYPE_UNSPECIFIED": 0,
+		"ADJUSTMENT_TYPE_PERCENT":     1,
+		"ADJUSTMENT_TYPE_AMOUNT":      2,
+		"ADJUSTMENT_TYPE_FIXED_PRICE": 3,
+	}
+)
+
+func (x AdjustmentType) Enum() *AdjustmentType {
+	p := new(AdjustmentType)
+	*p = x
+	return p
+}
+
+func (x AdjustmentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdjustmentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_hotel_proto_enumTypes[1].Descriptor()
+}
+
+func (AdjustmentType) Type() protoreflect.EnumType {
+	return &file_proto_hotel_proto_enumTypes[1]
+}
+
+func (x AdjustmentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdjustmentType.Descriptor instead.
+func (AdjustmentType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{1}
+}
+
+type ChangeType int32
+
+const (
+	ChangeType_CHANGE_TYPE_UNSPECIFIED ChangeType = 0
+	ChangeType_CHANGE_TYPE_CREATED     ChangeType = 1
+	ChangeType_CHANGE_TYPE_UPDATED     ChangeType = 2
+	ChangeType_CHANGE_TYPE_DELETED     ChangeType = 3
+)
+
+// Enum value maps for ChangeType.
+var (
+	ChangeType_name = map[int32]string{
+		0: "CHANGE_TYPE_UNSPECIFIED",
+		1: "CHANGE_TYPE_CREATED",
+		2: "CHANGE_TYPE_UPDATED",
+		3: "CHANGE_TYPE_DELETED",
+	}
+	ChangeType_value = map[string]int32{
+		"CHANGE_TYPE_UNSPECIFIED": 0,
+		"CHANGE_TYPE_CREATED":     1,
+		"CHANGE_TYPE_UPDATED":     2,
+		"CHANGE_TYPE_DELETED":     3,
+	}
+)
+
+func (x ChangeType) Enum() *ChangeType {
+	p := new(ChangeType)
+	*p = x
+	return p
+}
+
+func (x ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_hotel_proto_enumTypes[2].Descriptor()
+}
+
+func (ChangeType) Type() protoreflect.EnumType {
+	return &file_proto_hotel_proto_enumTypes[2]
+}
+
+func (x ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeType.Descriptor instead.
+func (ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{2}
+}
+
 type Hotel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -33,6 +198,7 @@ type Hotel struct {
 	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 	Amenities     []string               `protobuf:"bytes,4,rep,name=amenities,proto3" json:"amenities,omitempty"`
 	Rooms         []*Room                `protobuf:"bytes,5,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	Photos        []*Photo               `protobuf:"bytes,6,rep,name=photos,proto3" json:"photos,omitempty"`
 	Metadata      map[string]string      `protobuf:"bytes,15,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -103,6 +269,13 @@ func (x *Hotel) GetRooms() []*Room {
 	return nil
 }
 
+func (x *Hotel) GetPhotos() []*Photo {
+	if x != nil {
+		return x.Photos
+	}
+	return nil
+}
+
 func (x *Hotel) GetMetadata() map[string]string {
 	if x != nil {
 		return x.Metadata
@@ -117,6 +290,8 @@ type Room struct {
 	Amenities     []string               `protobuf:"bytes,3,rep,name=amenities,proto3" json:"amenities,omitempty"`
 	IsAvailable   bool                   `protobuf:"varint,4,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
 	PricePerNight float64                `protobuf:"fixed64,5,opt,name=price_per_night,json=pricePerNight,proto3" json:"price_per_night,omitempty"`
+	Photos        []*Photo               `protobuf:"bytes,6,rep,name=photos,proto3" json:"photos,omitempty"`
+	RoomTypeId    string                 `protobuf:"bytes,7,opt,name=room_type_id,json=roomTypeId,proto3" json:"room_type_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -186,29 +361,42 @@ func (x *Room) GetPricePerNight() float64 {
 	return 0
 }
 
-type CreateHotelRequest struct {
+func (x *Room) GetPhotos() []*Photo {
+	if x != nil {
+		return x.Photos
+	}
+	return nil
+}
+
+func (x *Room) GetRoomTypeId() string {
+	if x != nil {
+		return x.RoomTypeId
+	}
+	return ""
+}
+
+type BedConfiguration struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Amenities     []string               `protobuf:"bytes,3,rep,name=amenities,proto3" json:"amenities,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateHotelRequest) Reset() {
-	*x = CreateHotelRequest{}
+func (x *BedConfiguration) Reset() {
+	*x = BedConfiguration{}
 	mi := &file_proto_hotel_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateHotelRequest) String() string {
+func (x *BedConfiguration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateHotelRequest) ProtoMessage() {}
+func (*BedConfiguration) ProtoMessage() {}
 
-func (x *CreateHotelRequest) ProtoReflect() protoreflect.Message {
+func (x *BedConfiguration) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_hotel_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -220,56 +408,53 @@ func (x *CreateHotelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateHotelRequest.ProtoReflect.Descriptor instead.
-func (*CreateHotelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BedConfiguration.ProtoReflect.Descriptor instead.
+func (*BedConfiguration) Descriptor() ([]byte, []int) {
 	return file_proto_hotel_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateHotelRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateHotelRequest) GetAddress() string {
+func (x *BedConfiguration) GetType() string {
 	if x != nil {
-		return x.Address
+		return x.Type
 	}
 	return ""
 }
 
-func (x *CreateHotelRequest) GetAmenities() []string {
+func (x *BedConfiguration) GetCount() int32 {
 	if x != nil {
-		return x.Amenities
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type UpdateHotelRequest struct {
+type RoomType struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Amenities     []string               `protobuf:"bytes,4,rep,name=amenities,proto3" json:"amenities,omitempty"`
+	HotelId       string                 `protobuf:"bytes,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	MaxOccupancy  int32                  `protobuf:"varint,5,opt,name=max_occupancy,json=maxOccupancy,proto3" json:"max_occupancy,omitempty"`
+	Beds          []*BedConfiguration    `protobuf:"bytes,6,rep,name=beds,proto3" json:"beds,omitempty"`
+	SizeSqm       float64                `protobuf:"fixed64,7,opt,name=size_sqm,json=sizeSqm,proto3" json:"size_sqm,omitempty"`
+	Amenities     []string               `protobuf:"bytes,8,rep,name=amenities,proto3" json:"amenities,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateHotelRequest) Reset() {
-	*x = UpdateHotelRequest{}
+func (x *RoomType) Reset() {
+	*x = RoomType{}
 	mi := &file_proto_hotel_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateHotelRequest) String() string {
+func (x *RoomType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateHotelRequest) ProtoMessage() {}
+func (*RoomType) ProtoMessage() {}
 
-func (x *UpdateHotelRequest) ProtoReflect() protoreflect.Message {
+func (x *RoomType) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_hotel_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -281,60 +466,101 @@ func (x *UpdateHotelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateHotelRequest.ProtoReflect.Descriptor instead.
-func (*UpdateHotelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RoomType.ProtoReflect.Descriptor instead.
+func (*RoomType) Descriptor() ([]byte, []int) {
 	return file_proto_hotel_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *UpdateHotelRequest) GetId() string {
+func (x *RoomType) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateHotelRequest) GetName() string {
+func (x *RoomType) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *RoomType) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateHotelRequest) GetAddress() string {
+func (x *RoomType) GetDescription() string {
 	if x != nil {
-		return x.Address
+		return x.Description
 	}
 	return ""
 }
 
-func (x *UpdateHotelRequest) GetAmenities() []string {
+func (x *RoomType) GetMaxOccupancy() int32 {
+	if x != nil {
+		return x.MaxOccupancy
+	}
+	return 0
+}
+
+func (x *RoomType) GetBeds() []*BedConfiguration {
+	if x != nil {
+		return x.Beds
+	}
+	return nil
+}
+
+func (x *RoomType) GetSizeSqm() float64 {
+	if x != nil {
+		return x.SizeSqm
+	}
+	return 0
+}
+
+func (x *RoomType) GetAmenities() []string {
 	if x != nil {
 		return x.Amenities
 	}
 	return nil
 }
 
-type DeleteHotelRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PricingRule struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	HotelId           string                 `protobuf:"bytes,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	RoomTypeIds       []string               `protobuf:"bytes,4,rep,name=room_type_ids,json=roomTypeIds,proto3" json:"room_type_ids,omitempty"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	WeekdayMask       uint32                 `protobuf:"varint,7,opt,name=weekday_mask,json=weekdayMask,proto3" json:"weekday_mask,omitempty"`
+	MinNights         int32                  `protobuf:"varint,8,opt,name=min_nights,json=minNights,proto3" json:"min_nights,omitempty"`
+	MaxNights         int32                  `protobuf:"varint,9,opt,name=max_nights,json=maxNights,proto3" json:"max_nights,omitempty"`
+	ArrivalWithinDays int32                  `protobuf:"varint,10,opt,name=arrival_within_days,json=arrivalWithinDays,proto3" json:"arrival_within_days,omitempty"`
+	AdjustmentType    AdjustmentType         `protobuf:"varint,11,opt,name=adjustment_type,json=adjustmentType,proto3,enum=hotel.AdjustmentType" json:"adjustment_type,omitempty"`
+	Adjustment        float64                `protobuf:"fixed64,12,opt,name=adjustment,proto3" json:"adjustment,omitempty"`
+	Priority          int32                  `protobuf:"varint,13,opt,name=priority,proto3" json:"priority,omitempty"`
+	Active            bool                   `protobuf:"varint,14,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *DeleteHotelRequest) Reset() {
-	*x = DeleteHotelRequest{}
+func (x *PricingRule) Reset() {
+	*x = PricingRule{}
 	mi := &file_proto_hotel_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteHotelRequest) String() string {
+func (x *PricingRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteHotelRequest) ProtoMessage() {}
+func (*PricingRule) ProtoMessage() {}
 
-func (x *DeleteHotelRequest) ProtoReflect() protoreflect.Message {
+func (x *PricingRule) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_hotel_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -346,84 +572,133 @@ func (x *DeleteHotelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteHotelRequest.ProtoReflect.Descriptor instead.
-func (*DeleteHotelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PricingRule.ProtoReflect.Descriptor instead.
+func (*PricingRule) Descriptor() ([]byte, []int) {
 	return file_proto_hotel_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DeleteHotelRequest) GetId() string {
+func (x *PricingRule) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PricingRule) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
 }
 
-func (x *DeleteResponse) Reset() {
-	*x = DeleteResponse{}
-	mi := &file_proto_hotel_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PricingRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *DeleteResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PricingRule) GetRoomTypeIds() []string {
+	if x != nil {
+		return x.RoomTypeIds
+	}
+	return nil
 }
 
-func (*DeleteResponse) ProtoMessage() {}
+func (x *PricingRule) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
 
-func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotel_proto_msgTypes[5]
+func (x *PricingRule) GetEndDate() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EndDate
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
-func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_hotel_proto_rawDescGZIP(), []int{5}
+func (x *PricingRule) GetWeekdayMask() uint32 {
+	if x != nil {
+		return x.WeekdayMask
+	}
+	return 0
 }
 
-func (x *DeleteResponse) GetSuccess() bool {
+func (x *PricingRule) GetMinNights() int32 {
 	if x != nil {
-		return x.Success
+		return x.MinNights
+	}
+	return 0
+}
+
+func (x *PricingRule) GetMaxNights() int32 {
+	if x != nil {
+		return x.MaxNights
+	}
+	return 0
+}
+
+func (x *PricingRule) GetArrivalWithinDays() int32 {
+	if x != nil {
+		return x.ArrivalWithinDays
+	}
+	return 0
+}
+
+func (x *PricingRule) GetAdjustmentType() AdjustmentType {
+	if x != nil {
+		return x.AdjustmentType
+	}
+	return AdjustmentType_ADJUSTMENT_TYPE_UNSPECIFIED
+}
+
+func (x *PricingRule) GetAdjustment() float64 {
+	if x != nil {
+		return x.Adjustment
+	}
+	return 0
+}
+
+func (x *PricingRule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *PricingRule) GetActive() bool {
+	if x != nil {
+		return x.Active
 	}
 	return false
 }
 
-type GetHotelRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CancellationPolicy struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Refundable            bool                   `protobuf:"varint,1,opt,name=refundable,proto3" json:"refundable,omitempty"`
+	FreeCancellationHours int32                  `protobuf:"varint,2,opt,name=free_cancellation_hours,json=freeCancellationHours,proto3" json:"free_cancellation_hours,omitempty"`
+	PenaltyPercent        float64                `protobuf:"fixed64,3,opt,name=penalty_percent,json=penaltyPercent,proto3" json:"penalty_percent,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *GetHotelRequest) Reset() {
-	*x = GetHotelRequest{}
-	mi := &file_proto_hotel_proto_msgTypes[6]
+func (x *CancellationPolicy) Reset() {
+	*x = CancellationPolicy{}
+	mi := &file_proto_hotel_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHotelRequest) String() string {
+func (x *CancellationPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHotelRequest) ProtoMessage() {}
+func (*CancellationPolicy) ProtoMessage() {}
 
-func (x *GetHotelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotel_proto_msgTypes[6]
+func (x *CancellationPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,41 +709,2207 @@ func (x *GetHotelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHotelRequest.ProtoReflect.Descriptor instead.
-func (*GetHotelRequest) Descriptor() ([]byte, []int) {
-	return file_proto_hotel_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CancellationPolicy.ProtoReflect.Descriptor instead.
+func (*CancellationPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetHotelRequest) GetId() string {
+func (x *CancellationPolicy) GetRefundable() bool {
 	if x != nil {
-		return x.Id
+		return x.Refundable
 	}
-	return ""
+	return false
+}
+
+func (x *CancellationPolicy) GetFreeCancellationHours() int32 {
+	if x != nil {
+		return x.FreeCancellationHours
+	}
+	return 0
+}
+
+func (x *CancellationPolicy) GetPenaltyPercent() float64 {
+	if x != nil {
+		return x.PenaltyPercent
+	}
+	return 0
+}
+
+type RatePlan struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	HotelId            string                 `protobuf:"bytes,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	RoomTypeIds        []string               `protobuf:"bytes,4,rep,name=room_type_ids,json=roomTypeIds,proto3" json:"room_type_ids,omitempty"`
+	MealPlan           MealPlan               `protobuf:"varint,5,opt,name=meal_plan,json=mealPlan,proto3,enum=hotel.MealPlan" json:"meal_plan,omitempty"`
+	CancellationPolicy *CancellationPolicy    `protobuf:"bytes,6,opt,name=cancellation_policy,json=cancellationPolicy,proto3" json:"cancellation_policy,omitempty"`
+	PriceMultiplier    float64                `protobuf:"fixed64,7,opt,name=price_multiplier,json=priceMultiplier,proto3" json:"price_multiplier,omitempty"`
+	NightlySupplement  float64                `protobuf:"fixed64,8,opt,name=nightly_supplement,json=nightlySupplement,proto3" json:"nightly_supplement,omitempty"`
+	MinNights          int32                  `protobuf:"varint,9,opt,name=min_nights,json=minNights,proto3" json:"min_nights,omitempty"`
+	Active             bool                   `protobuf:"varint,10,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RatePlan) Reset() {
+	*x = RatePlan{}
+	mi := &file_proto_hotel_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatePlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatePlan) ProtoMessage() {}
+
+func (x *RatePlan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatePlan.ProtoReflect.Descriptor instead.
+func (*RatePlan) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RatePlan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RatePlan) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *RatePlan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RatePlan) GetRoomTypeIds() []string {
+	if x != nil {
+		return x.RoomTypeIds
+	}
+	return nil
+}
+
+func (x *RatePlan) GetMealPlan() MealPlan {
+	if x != nil {
+		return x.MealPlan
+	}
+	return MealPlan_MEAL_PLAN_UNSPECIFIED
+}
+
+func (x *RatePlan) GetCancellationPolicy() *CancellationPolicy {
+	if x != nil {
+		return x.CancellationPolicy
+	}
+	return nil
+}
+
+func (x *RatePlan) GetPriceMultiplier() float64 {
+	if x != nil {
+		return x.PriceMultiplier
+	}
+	return 0
+}
+
+func (x *RatePlan) GetNightlySupplement() float64 {
+	if x != nil {
+		return x.NightlySupplement
+	}
+	return 0
+}
+
+func (x *RatePlan) GetMinNights() int32 {
+	if x != nil {
+		return x.MinNights
+	}
+	return 0
+}
+
+func (x *RatePlan) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type Photo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Caption       string                 `protobuf:"bytes,3,opt,name=caption,proto3" json:"caption,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Width         int32                  `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	ContentType   string                 `protobuf:"bytes,7,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,8,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Photo) Reset() {
+	*x = Photo{}
+	mi := &file_proto_hotel_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Photo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Photo) ProtoMessage() {}
+
+func (x *Photo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Photo.ProtoReflect.Descriptor instead.
+func (*Photo) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Photo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Photo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Photo) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+func (x *Photo) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Photo) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Photo) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Photo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Photo) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type CreateHotelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amenities     []string               `protobuf:"bytes,3,rep,name=amenities,proto3" json:"amenities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHotelRequest) Reset() {
+	*x = CreateHotelRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHotelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHotelRequest) ProtoMessage() {}
+
+func (x *CreateHotelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHotelRequest.ProtoReflect.Descriptor instead.
+func (*CreateHotelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateHotelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateHotelRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateHotelRequest) GetAmenities() []string {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+type UpdateHotelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Amenities     []string               `protobuf:"bytes,4,rep,name=amenities,proto3" json:"amenities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHotelRequest) Reset() {
+	*x = UpdateHotelRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHotelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHotelRequest) ProtoMessage() {}
+
+func (x *UpdateHotelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHotelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHotelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateHotelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateHotelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateHotelRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UpdateHotelRequest) GetAmenities() []string {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+type DeleteHotelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHotelRequest) Reset() {
+	*x = DeleteHotelRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHotelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHotelRequest) ProtoMessage() {}
+
+func (x *DeleteHotelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHotelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHotelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteHotelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResponse) Reset() {
+	*x = DeleteResponse{}
+	mi := &file_proto_hotel_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResponse) ProtoMessage() {}
+
+func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetHotelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHotelRequest) Reset() {
+	*x = GetHotelRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHotelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHotelRequest) ProtoMessage() {}
+
+func (x *GetHotelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHotelRequest.ProtoReflect.Descriptor instead.
+func (*GetHotelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetHotelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SearchRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Location          string                 `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	RequiredAmenities []string               `protobuf:"bytes,2,rep,name=required_amenities,json=requiredAmenities,proto3" json:"required_amenities,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetRequiredAmenities() []string {
+	if x != nil {
+		return x.RequiredAmenities
+	}
+	return nil
+}
+
+type HotelList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hotels        []*Hotel               `protobuf:"bytes,1,rep,name=hotels,proto3" json:"hotels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HotelList) Reset() {
+	*x = HotelList{}
+	mi := &file_proto_hotel_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HotelList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HotelList) ProtoMessage() {}
+
+func (x *HotelList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HotelList.ProtoReflect.Descriptor instead.
+func (*HotelList) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HotelList) GetHotels() []*Hotel {
+	if x != nil {
+		return x.Hotels
+	}
+	return nil
+}
+
+type AddRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomTypeId    string                 `protobuf:"bytes,5,opt,name=room_type_id,json=roomTypeId,proto3" json:"room_type_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Amenities     []string               `protobuf:"bytes,3,rep,name=amenities,proto3" json:"amenities,omitempty"`
+	PricePerNight float64                `protobuf:"fixed64,4,opt,name=price_per_night,json=pricePerNight,proto3" json:"price_per_night,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRoomRequest) Reset() {
+	*x = AddRoomRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRoomRequest) ProtoMessage() {}
+
+func (x *AddRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRoomRequest.ProtoReflect.Descriptor instead.
+func (*AddRoomRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddRoomRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *AddRoomRequest) GetRoomTypeId() string {
+	if x != nil {
+		return x.RoomTypeId
+	}
+	return ""
+}
+
+func (x *AddRoomRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddRoomRequest) GetAmenities() []string {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+func (x *AddRoomRequest) GetPricePerNight() float64 {
+	if x != nil {
+		return x.PricePerNight
+	}
+	return 0
+}
+
+type UpdateRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Amenities     []string               `protobuf:"bytes,4,rep,name=amenities,proto3" json:"amenities,omitempty"`
+	PricePerNight float64                `protobuf:"fixed64,5,opt,name=price_per_night,json=pricePerNight,proto3" json:"price_per_night,omitempty"`
+	RoomTypeId    string                 `protobuf:"bytes,6,opt,name=room_type_id,json=roomTypeId,proto3" json:"room_type_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoomRequest) Reset() {
+	*x = UpdateRoomRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomRequest) ProtoMessage() {}
+
+func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateRoomRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetAmenities() []string {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+func (x *UpdateRoomRequest) GetPricePerNight() float64 {
+	if x != nil {
+		return x.PricePerNight
+	}
+	return 0
+}
+
+func (x *UpdateRoomRequest) GetRoomTypeId() string {
+	if x != nil {
+		return x.RoomTypeId
+	}
+	return ""
+}
+
+type DeleteRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomRequest) Reset() {
+	*x = DeleteRoomRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomRequest) ProtoMessage() {}
+
+func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoomRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteRoomRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *DeleteRoomRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	RatePlanId    string                 `protobuf:"bytes,4,opt,name=rate_plan_id,json=ratePlanId,proto3" json:"rate_plan_id,omitempty"`
+	RoomTypeId    string                 `protobuf:"bytes,5,opt,name=room_type_id,json=roomTypeId,proto3" json:"room_type_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilityRequest) Reset() {
+	*x = AvailabilityRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityRequest) ProtoMessage() {}
+
+func (x *AvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*AvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AvailabilityRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *AvailabilityRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *AvailabilityRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *AvailabilityRequest) GetRatePlanId() string {
+	if x != nil {
+		return x.RatePlanId
+	}
+	return ""
+}
+
+func (x *AvailabilityRequest) GetRoomTypeId() string {
+	if x != nil {
+		return x.RoomTypeId
+	}
+	return ""
+}
+
+type AvailabilityResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IsAvailable    bool                   `protobuf:"varint,1,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	AvailableRooms []*Room                `protobuf:"bytes,2,rep,name=available_rooms,json=availableRooms,proto3" json:"available_rooms,omitempty"`
+	TotalPrice     float64                `protobuf:"fixed64,3,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	Offers         []*RateOffer           `protobuf:"bytes,4,rep,name=offers,proto3" json:"offers,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AvailabilityResponse) Reset() {
+	*x = AvailabilityResponse{}
+	mi := &file_proto_hotel_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityResponse) ProtoMessage() {}
+
+func (x *AvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*AvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AvailabilityResponse) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *AvailabilityResponse) GetAvailableRooms() []*Room {
+	if x != nil {
+		return x.AvailableRooms
+	}
+	return nil
+}
+
+func (x *AvailabilityResponse) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *AvailabilityResponse) GetOffers() []*RateOffer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+type RateOffer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomTypeId    string                 `protobuf:"bytes,1,opt,name=room_type_id,json=roomTypeId,proto3" json:"room_type_id,omitempty"`
+	RatePlanId    string                 `protobuf:"bytes,2,opt,name=rate_plan_id,json=ratePlanId,proto3" json:"rate_plan_id,omitempty"`
+	RoomIds       []string               `protobuf:"bytes,3,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	TotalPrice    float64                `protobuf:"fixed64,4,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateOffer) Reset() {
+	*x = RateOffer{}
+	mi := &file_proto_hotel_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateOffer) ProtoMessage() {}
+
+func (x *RateOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateOffer.ProtoReflect.Descriptor instead.
+func (*RateOffer) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RateOffer) GetRoomTypeId() string {
+	if x != nil {
+		return x.RoomTypeId
+	}
+	return ""
+}
+
+func (x *RateOffer) GetRatePlanId() string {
+	if x != nil {
+		return x.RatePlanId
+	}
+	return ""
+}
+
+func (x *RateOffer) GetRoomIds() []string {
+	if x != nil {
+		return x.RoomIds
+	}
+	return nil
+}
+
+func (x *RateOffer) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+type CreateUploadSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Sha256        string                 `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUploadSessionRequest) Reset() {
+	*x = CreateUploadSessionRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUploadSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUploadSessionRequest) ProtoMessage() {}
+
+func (x *CreateUploadSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUploadSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateUploadSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateUploadSessionRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *CreateUploadSessionRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *CreateUploadSessionRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CreateUploadSessionRequest) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CreateUploadSessionRequest) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type UploadSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxChunkBytes int32                  `protobuf:"varint,3,opt,name=max_chunk_bytes,json=maxChunkBytes,proto3" json:"max_chunk_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadSession) Reset() {
+	*x = UploadSession{}
+	mi := &file_proto_hotel_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadSession) ProtoMessage() {}
+
+func (x *UploadSession) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadSession.ProtoReflect.Descriptor instead.
+func (*UploadSession) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UploadSession) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadSession) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *UploadSession) GetMaxChunkBytes() int32 {
+	if x != nil {
+		return x.MaxChunkBytes
+	}
+	return 0
+}
+
+type UploadPhotoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadPhotoRequest) Reset() {
+	*x = UploadPhotoRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadPhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPhotoRequest) ProtoMessage() {}
+
+func (x *UploadPhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPhotoRequest.ProtoReflect.Descriptor instead.
+func (*UploadPhotoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UploadPhotoRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadPhotoRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UploadPhotoRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UploadPhotoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Width         int32                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadPhotoResponse) Reset() {
+	*x = UploadPhotoResponse{}
+	mi := &file_proto_hotel_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadPhotoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPhotoResponse) ProtoMessage() {}
+
+func (x *UploadPhotoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPhotoResponse.ProtoReflect.Descriptor instead.
+func (*UploadPhotoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UploadPhotoResponse) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadPhotoResponse) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *UploadPhotoResponse) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *UploadPhotoResponse) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type AttachPhotoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UploadId      string                 `protobuf:"bytes,3,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Caption       string                 `protobuf:"bytes,4,opt,name=caption,proto3" json:"caption,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachPhotoRequest) Reset() {
+	*x = AttachPhotoRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachPhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachPhotoRequest) ProtoMessage() {}
+
+func (x *AttachPhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachPhotoRequest.ProtoReflect.Descriptor instead.
+func (*AttachPhotoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AttachPhotoRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *AttachPhotoRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *AttachPhotoRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *AttachPhotoRequest) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+type ReorderPhotosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PhotoIds      []string               `protobuf:"bytes,3,rep,name=photo_ids,json=photoIds,proto3" json:"photo_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderPhotosRequest) Reset() {
+	*x = ReorderPhotosRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderPhotosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderPhotosRequest) ProtoMessage() {}
+
+func (x *ReorderPhotosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderPhotosRequest.ProtoReflect.Descriptor instead.
+func (*ReorderPhotosRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReorderPhotosRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *ReorderPhotosRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ReorderPhotosRequest) GetPhotoIds() []string {
+	if x != nil {
+		return x.PhotoIds
+	}
+	return nil
+}
+
+type PhotoList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Photos        []*Photo               `protobuf:"bytes,1,rep,name=photos,proto3" json:"photos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PhotoList) Reset() {
+	*x = PhotoList{}
+	mi := &file_proto_hotel_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PhotoList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhotoList) ProtoMessage() {}
+
+func (x *PhotoList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhotoList.ProtoReflect.Descriptor instead.
+func (*PhotoList) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PhotoList) GetPhotos() []*Photo {
+	if x != nil {
+		return x.Photos
+	}
+	return nil
+}
+
+type DeletePhotoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	PhotoId       string                 `protobuf:"bytes,3,opt,name=photo_id,json=photoId,proto3" json:"photo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePhotoRequest) Reset() {
+	*x = DeletePhotoRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePhotoRequest) ProtoMessage() {}
+
+func (x *DeletePhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePhotoRequest.ProtoReflect.Descriptor instead.
+func (*DeletePhotoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeletePhotoRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *DeletePhotoRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *DeletePhotoRequest) GetPhotoId() string {
+	if x != nil {
+		return x.PhotoId
+	}
+	return ""
+}
+
+type CreateRoomTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomType      *RoomType              `protobuf:"bytes,2,opt,name=room_type,json=roomType,proto3" json:"room_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomTypeRequest) Reset() {
+	*x = CreateRoomTypeRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomTypeRequest) ProtoMessage() {}
+
+func (x *CreateRoomTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomTypeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateRoomTypeRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *CreateRoomTypeRequest) GetRoomType() *RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return nil
+}
+
+type GetRoomTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomTypeRequest) Reset() {
+	*x = GetRoomTypeRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomTypeRequest) ProtoMessage() {}
+
+func (x *GetRoomTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomTypeRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomTypeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetRoomTypeRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *GetRoomTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListRoomTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomTypesRequest) Reset() {
+	*x = ListRoomTypesRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomTypesRequest) ProtoMessage() {}
+
+func (x *ListRoomTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomTypesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListRoomTypesRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+type RoomTypeList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomTypes     []*RoomType            `protobuf:"bytes,1,rep,name=room_types,json=roomTypes,proto3" json:"room_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomTypeList) Reset() {
+	*x = RoomTypeList{}
+	mi := &file_proto_hotel_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomTypeList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomTypeList) ProtoMessage() {}
+
+func (x *RoomTypeList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomTypeList.ProtoReflect.Descriptor instead.
+func (*RoomTypeList) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RoomTypeList) GetRoomTypes() []*RoomType {
+	if x != nil {
+		return x.RoomTypes
+	}
+	return nil
+}
+
+type UpdateRoomTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	RoomType      *RoomType              `protobuf:"bytes,3,opt,name=room_type,json=roomType,proto3" json:"room_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoomTypeRequest) Reset() {
+	*x = UpdateRoomTypeRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoomTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomTypeRequest) ProtoMessage() {}
+
+func (x *UpdateRoomTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoomTypeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateRoomTypeRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *UpdateRoomTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRoomTypeRequest) GetRoomType() *RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return nil
+}
+
+type DeleteRoomTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomTypeRequest) Reset() {
+	*x = DeleteRoomTypeRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomTypeRequest) ProtoMessage() {}
+
+func (x *DeleteRoomTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoomTypeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteRoomTypeRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *DeleteRoomTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateRatePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RatePlan      *RatePlan              `protobuf:"bytes,2,opt,name=rate_plan,json=ratePlan,proto3" json:"rate_plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRatePlanRequest) Reset() {
+	*x = CreateRatePlanRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRatePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRatePlanRequest) ProtoMessage() {}
+
+func (x *CreateRatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRatePlanRequest.ProtoReflect.Descriptor instead.
+func (*CreateRatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateRatePlanRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *CreateRatePlanRequest) GetRatePlan() *RatePlan {
+	if x != nil {
+		return x.RatePlan
+	}
+	return nil
+}
+
+type GetRatePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatePlanRequest) Reset() {
+	*x = GetRatePlanRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatePlanRequest) ProtoMessage() {}
+
+func (x *GetRatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatePlanRequest.ProtoReflect.Descriptor instead.
+func (*GetRatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetRatePlanRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *GetRatePlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListRatePlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRatePlansRequest) Reset() {
+	*x = ListRatePlansRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRatePlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRatePlansRequest) ProtoMessage() {}
+
+func (x *ListRatePlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRatePlansRequest.ProtoReflect.Descriptor instead.
+func (*ListRatePlansRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListRatePlansRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+type RatePlanList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RatePlans     []*RatePlan            `protobuf:"bytes,1,rep,name=rate_plans,json=ratePlans,proto3" json:"rate_plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatePlanList) Reset() {
+	*x = RatePlanList{}
+	mi := &file_proto_hotel_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatePlanList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatePlanList) ProtoMessage() {}
+
+func (x *RatePlanList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatePlanList.ProtoReflect.Descriptor instead.
+func (*RatePlanList) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RatePlanList) GetRatePlans() []*RatePlan {
+	if x != nil {
+		return x.RatePlans
+	}
+	return nil
+}
+
+type UpdateRatePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	RatePlan      *RatePlan              `protobuf:"bytes,3,opt,name=rate_plan,json=ratePlan,proto3" json:"rate_plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRatePlanRequest) Reset() {
+	*x = UpdateRatePlanRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRatePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRatePlanRequest) ProtoMessage() {}
+
+func (x *UpdateRatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRatePlanRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateRatePlanRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *UpdateRatePlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRatePlanRequest) GetRatePlan() *RatePlan {
+	if x != nil {
+		return x.RatePlan
+	}
+	return nil
+}
+
+type DeleteRatePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRatePlanRequest) Reset() {
+	*x = DeleteRatePlanRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRatePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRatePlanRequest) ProtoMessage() {}
+
+func (x *DeleteRatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRatePlanRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteRatePlanRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *DeleteRatePlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreatePricingRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	PricingRule   *PricingRule           `protobuf:"bytes,2,opt,name=pricing_rule,json=pricingRule,proto3" json:"pricing_rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePricingRuleRequest) Reset() {
+	*x = CreatePricingRuleRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePricingRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePricingRuleRequest) ProtoMessage() {}
+
+func (x *CreatePricingRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePricingRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreatePricingRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreatePricingRuleRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *CreatePricingRuleRequest) GetPricingRule() *PricingRule {
+	if x != nil {
+		return x.PricingRule
+	}
+	return nil
 }
 
-type SearchRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Location          string                 `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	RequiredAmenities []string               `protobuf:"bytes,2,rep,name=required_amenities,json=requiredAmenities,proto3" json:"required_amenities,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type GetPricingRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchRequest) Reset() {
-	*x = SearchRequest{}
-	mi := &file_proto_hotel_proto_msgTypes[7]
+func (x *GetPricingRuleRequest) Reset() {
+	*x = GetPricingRuleRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchRequest) String() string {
+func (x *GetPricingRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchRequest) ProtoMessage() {}
+func (*GetPricingRuleRequest) ProtoMessage() {}
 
-func (x *SearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotel_proto_msgTypes[7]
+func (x *GetPricingRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -479,47 +2920,47 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
-func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_hotel_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetPricingRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetPricingRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *SearchRequest) GetLocation() string {
+func (x *GetPricingRuleRequest) GetHotelId() string {
 	if x != nil {
-		return x.Location
+		return x.HotelId
 	}
 	return ""
 }
 
-func (x *SearchRequest) GetRequiredAmenities() []string {
+func (x *GetPricingRuleRequest) GetId() string {
 	if x != nil {
-		return x.RequiredAmenities
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type HotelList struct {
+type ListPricingRulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hotels        []*Hotel               `protobuf:"bytes,1,rep,name=hotels,proto3" json:"hotels,omitempty"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HotelList) Reset() {
-	*x = HotelList{}
-	mi := &file_proto_hotel_proto_msgTypes[8]
+func (x *ListPricingRulesRequest) Reset() {
+	*x = ListPricingRulesRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HotelList) String() string {
+func (x *ListPricingRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HotelList) ProtoMessage() {}
+func (*ListPricingRulesRequest) ProtoMessage() {}
 
-func (x *HotelList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotel_proto_msgTypes[8]
+func (x *ListPricingRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -530,43 +2971,86 @@ func (x *HotelList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HotelList.ProtoReflect.Descriptor instead.
-func (*HotelList) Descriptor() ([]byte, []int) {
-	return file_proto_hotel_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListPricingRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListPricingRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *HotelList) GetHotels() []*Hotel {
+func (x *ListPricingRulesRequest) GetHotelId() string {
 	if x != nil {
-		return x.Hotels
+		return x.HotelId
+	}
+	return ""
+}
+
+type PricingRuleList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PricingRules  []*PricingRule         `protobuf:"bytes,1,rep,name=pricing_rules,json=pricingRules,proto3" json:"pricing_rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricingRuleList) Reset() {
+	*x = PricingRuleList{}
+	mi := &file_proto_hotel_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricingRuleList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingRuleList) ProtoMessage() {}
+
+func (x *PricingRuleList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingRuleList.ProtoReflect.Descriptor instead.
+func (*PricingRuleList) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PricingRuleList) GetPricingRules() []*PricingRule {
+	if x != nil {
+		return x.PricingRules
 	}
 	return nil
 }
 
-type AddRoomRequest struct {
+type UpdatePricingRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Amenities     []string               `protobuf:"bytes,3,rep,name=amenities,proto3" json:"amenities,omitempty"`
-	PricePerNight float64                `protobuf:"fixed64,4,opt,name=price_per_night,json=pricePerNight,proto3" json:"price_per_night,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	PricingRule   *PricingRule           `protobuf:"bytes,3,opt,name=pricing_rule,json=pricingRule,proto3" json:"pricing_rule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddRoomRequest) Reset() {
-	*x = AddRoomRequest{}
-	mi := &file_proto_hotel_proto_msgTypes[9]
+func (x *UpdatePricingRuleRequest) Reset() {
+	*x = UpdatePricingRuleRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddRoomRequest) String() string {
+func (x *UpdatePricingRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddRoomRequest) ProtoMessage() {}
+func (*UpdatePricingRuleRequest) ProtoMessage() {}
 
-func (x *AddRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotel_proto_msgTypes[9]
+func (x *UpdatePricingRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,65 +3061,110 @@ func (x *AddRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddRoomRequest.ProtoReflect.Descriptor instead.
-func (*AddRoomRequest) Descriptor() ([]byte, []int) {
-	return file_proto_hotel_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UpdatePricingRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePricingRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *AddRoomRequest) GetHotelId() string {
+func (x *UpdatePricingRuleRequest) GetHotelId() string {
 	if x != nil {
 		return x.HotelId
 	}
 	return ""
 }
 
-func (x *AddRoomRequest) GetType() string {
+func (x *UpdatePricingRuleRequest) GetId() string {
 	if x != nil {
-		return x.Type
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AddRoomRequest) GetAmenities() []string {
+func (x *UpdatePricingRuleRequest) GetPricingRule() *PricingRule {
 	if x != nil {
-		return x.Amenities
+		return x.PricingRule
 	}
 	return nil
 }
 
-func (x *AddRoomRequest) GetPricePerNight() float64 {
+type DeletePricingRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePricingRuleRequest) Reset() {
+	*x = DeletePricingRuleRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePricingRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePricingRuleRequest) ProtoMessage() {}
+
+func (x *DeletePricingRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[46]
 	if x != nil {
-		return x.PricePerNight
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type UpdateRoomRequest struct {
+// Deprecated: Use DeletePricingRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeletePricingRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeletePricingRuleRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *DeletePricingRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type QuotePriceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Amenities     []string               `protobuf:"bytes,4,rep,name=amenities,proto3" json:"amenities,omitempty"`
-	PricePerNight float64                `protobuf:"fixed64,5,opt,name=price_per_night,json=pricePerNight,proto3" json:"price_per_night,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	RatePlanId    string                 `protobuf:"bytes,5,opt,name=rate_plan_id,json=ratePlanId,proto3" json:"rate_plan_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRoomRequest) Reset() {
-	*x = UpdateRoomRequest{}
-	mi := &file_proto_hotel_proto_msgTypes[10]
+func (x *QuotePriceRequest) Reset() {
+	*x = QuotePriceRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRoomRequest) String() string {
+func (x *QuotePriceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRoomRequest) ProtoMessage() {}
+func (*QuotePriceRequest) ProtoMessage() {}
 
-func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotel_proto_msgTypes[10]
+func (x *QuotePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,69 +3175,197 @@ func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRoomRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRoomRequest) Descriptor() ([]byte, []int) {
-	return file_proto_hotel_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use QuotePriceRequest.ProtoReflect.Descriptor instead.
+func (*QuotePriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *UpdateRoomRequest) GetHotelId() string {
+func (x *QuotePriceRequest) GetHotelId() string {
 	if x != nil {
 		return x.HotelId
 	}
 	return ""
 }
 
-func (x *UpdateRoomRequest) GetId() string {
+func (x *QuotePriceRequest) GetRoomId() string {
 	if x != nil {
-		return x.Id
+		return x.RoomId
 	}
 	return ""
 }
 
-func (x *UpdateRoomRequest) GetType() string {
+func (x *QuotePriceRequest) GetStartDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Type
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *QuotePriceRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *QuotePriceRequest) GetRatePlanId() string {
+	if x != nil {
+		return x.RatePlanId
 	}
 	return ""
 }
 
-func (x *UpdateRoomRequest) GetAmenities() []string {
+type NightlyPrice struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Date           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	BasePrice      float64                `protobuf:"fixed64,2,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	Price          float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	PricingRuleIds []string               `protobuf:"bytes,4,rep,name=pricing_rule_ids,json=pricingRuleIds,proto3" json:"pricing_rule_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NightlyPrice) Reset() {
+	*x = NightlyPrice{}
+	mi := &file_proto_hotel_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NightlyPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NightlyPrice) ProtoMessage() {}
+
+func (x *NightlyPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[48]
 	if x != nil {
-		return x.Amenities
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NightlyPrice.ProtoReflect.Descriptor instead.
+func (*NightlyPrice) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *NightlyPrice) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
 	}
 	return nil
 }
 
-func (x *UpdateRoomRequest) GetPricePerNight() float64 {
+func (x *NightlyPrice) GetBasePrice() float64 {
 	if x != nil {
-		return x.PricePerNight
+		return x.BasePrice
 	}
 	return 0
 }
 
-type DeleteRoomRequest struct {
+func (x *NightlyPrice) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *NightlyPrice) GetPricingRuleIds() []string {
+	if x != nil {
+		return x.PricingRuleIds
+	}
+	return nil
+}
+
+type QuotePriceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Nights        []*NightlyPrice        `protobuf:"bytes,1,rep,name=nights,proto3" json:"nights,omitempty"`
+	TotalPrice    float64                `protobuf:"fixed64,2,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	QuotedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=quoted_at,json=quotedAt,proto3" json:"quoted_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRoomRequest) Reset() {
-	*x = DeleteRoomRequest{}
-	mi := &file_proto_hotel_proto_msgTypes[11]
+func (x *QuotePriceResponse) Reset() {
+	*x = QuotePriceResponse{}
+	mi := &file_proto_hotel_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotePriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePriceResponse) ProtoMessage() {}
+
+func (x *QuotePriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotePriceResponse.ProtoReflect.Descriptor instead.
+func (*QuotePriceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *QuotePriceResponse) GetNights() []*NightlyPrice {
+	if x != nil {
+		return x.Nights
+	}
+	return nil
+}
+
+func (x *QuotePriceResponse) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *QuotePriceResponse) GetQuotedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QuotedAt
+	}
+	return nil
+}
+
+type GetRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomRequest) Reset() {
+	*x = GetRoomRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRoomRequest) String() string {
+func (x *GetRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRoomRequest) ProtoMessage() {}
+func (*GetRoomRequest) ProtoMessage() {}
 
-func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotel_proto_msgTypes[11]
+func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,49 +3376,47 @@ func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRoomRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRoomRequest) Descriptor() ([]byte, []int) {
-	return file_proto_hotel_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetRoomRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DeleteRoomRequest) GetHotelId() string {
+func (x *GetRoomRequest) GetHotelId() string {
 	if x != nil {
 		return x.HotelId
 	}
 	return ""
 }
 
-func (x *DeleteRoomRequest) GetId() string {
+func (x *GetRoomRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type AvailabilityRequest struct {
+type ListRoomsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AvailabilityRequest) Reset() {
-	*x = AvailabilityRequest{}
-	mi := &file_proto_hotel_proto_msgTypes[12]
+func (x *ListRoomsRequest) Reset() {
+	*x = ListRoomsRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AvailabilityRequest) String() string {
+func (x *ListRoomsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvailabilityRequest) ProtoMessage() {}
+func (*ListRoomsRequest) ProtoMessage() {}
 
-func (x *AvailabilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotel_proto_msgTypes[12]
+func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -772,56 +3427,86 @@ func (x *AvailabilityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvailabilityRequest.ProtoReflect.Descriptor instead.
-func (*AvailabilityRequest) Descriptor() ([]byte, []int) {
-	return file_proto_hotel_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListRoomsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *AvailabilityRequest) GetHotelId() string {
+func (x *ListRoomsRequest) GetHotelId() string {
 	if x != nil {
 		return x.HotelId
 	}
 	return ""
 }
 
-func (x *AvailabilityRequest) GetStartDate() *timestamppb.Timestamp {
+type RoomList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rooms         []*Room                `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomList) Reset() {
+	*x = RoomList{}
+	mi := &file_proto_hotel_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomList) ProtoMessage() {}
+
+func (x *RoomList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[52]
 	if x != nil {
-		return x.StartDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *AvailabilityRequest) GetEndDate() *timestamppb.Timestamp {
+// Deprecated: Use RoomList.ProtoReflect.Descriptor instead.
+func (*RoomList) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RoomList) GetRooms() []*Room {
 	if x != nil {
-		return x.EndDate
+		return x.Rooms
 	}
 	return nil
 }
 
-type AvailabilityResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	IsAvailable    bool                   `protobuf:"varint,1,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
-	AvailableRooms []*Room                `protobuf:"bytes,2,rep,name=available_rooms,json=availableRooms,proto3" json:"available_rooms,omitempty"`
-	TotalPrice     float64                `protobuf:"fixed64,3,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type HotelChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        ChangeType             `protobuf:"varint,1,opt,name=change,proto3,enum=hotel.ChangeType" json:"change,omitempty"`
+	Before        *Hotel                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         *Hotel                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AvailabilityResponse) Reset() {
-	*x = AvailabilityResponse{}
-	mi := &file_proto_hotel_proto_msgTypes[13]
+func (x *HotelChanged) Reset() {
+	*x = HotelChanged{}
+	mi := &file_proto_hotel_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AvailabilityResponse) String() string {
+func (x *HotelChanged) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvailabilityResponse) ProtoMessage() {}
+func (*HotelChanged) ProtoMessage() {}
 
-func (x *AvailabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotel_proto_msgTypes[13]
+func (x *HotelChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -832,55 +3517,57 @@ func (x *AvailabilityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvailabilityResponse.ProtoReflect.Descriptor instead.
-func (*AvailabilityResponse) Descriptor() ([]byte, []int) {
-	return file_proto_hotel_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use HotelChanged.ProtoReflect.Descriptor instead.
+func (*HotelChanged) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *AvailabilityResponse) GetIsAvailable() bool {
+func (x *HotelChanged) GetChange() ChangeType {
 	if x != nil {
-		return x.IsAvailable
+		return x.Change
 	}
-	return false
+	return ChangeType_CHANGE_TYPE_UNSPECIFIED
 }
 
-func (x *AvailabilityResponse) GetAvailableRooms() []*Room {
+func (x *HotelChanged) GetBefore() *Hotel {
 	if x != nil {
-		return x.AvailableRooms
+		return x.Before
 	}
 	return nil
 }
 
-func (x *AvailabilityResponse) GetTotalPrice() float64 {
+func (x *HotelChanged) GetAfter() *Hotel {
 	if x != nil {
-		return x.TotalPrice
+		return x.After
 	}
-	return 0
+	return nil
 }
 
-type GetRoomRequest struct {
+type RoomChanged struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Change        ChangeType             `protobuf:"varint,2,opt,name=change,proto3,enum=hotel.ChangeType" json:"change,omitempty"`
+	Before        *Room                  `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	After         *Room                  `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoomRequest) Reset() {
-	*x = GetRoomRequest{}
-	mi := &file_proto_hotel_proto_msgTypes[14]
+func (x *RoomChanged) Reset() {
+	*x = RoomChanged{}
+	mi := &file_proto_hotel_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoomRequest) String() string {
+func (x *RoomChanged) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoomRequest) ProtoMessage() {}
+func (*RoomChanged) ProtoMessage() {}
 
-func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotel_proto_msgTypes[14]
+func (x *RoomChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -891,47 +3578,68 @@ func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoomRequest.ProtoReflect.Descriptor instead.
-func (*GetRoomRequest) Descriptor() ([]byte, []int) {
-	return file_proto_hotel_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RoomChanged.ProtoReflect.Descriptor instead.
+func (*RoomChanged) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetRoomRequest) GetHotelId() string {
+func (x *RoomChanged) GetHotelId() string {
 	if x != nil {
 		return x.HotelId
 	}
 	return ""
 }
 
-func (x *GetRoomRequest) GetId() string {
+func (x *RoomChanged) GetChange() ChangeType {
 	if x != nil {
-		return x.Id
+		return x.Change
 	}
-	return ""
+	return ChangeType_CHANGE_TYPE_UNSPECIFIED
 }
 
-type ListRoomsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+func (x *RoomChanged) GetBefore() *Room {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *RoomChanged) GetAfter() *Room {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type CatalogEvent struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Revision    int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	ResumeToken string                 `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	Time        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// Types that are valid to be assigned to Change:
+	//
+	//	*CatalogEvent_Hotel
+	//	*CatalogEvent_Room
+	Change        isCatalogEvent_Change `protobuf_oneof:"change"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRoomsRequest) Reset() {
-	*x = ListRoomsRequest{}
-	mi := &file_proto_hotel_proto_msgTypes[15]
+func (x *CatalogEvent) Reset() {
+	*x = CatalogEvent{}
+	mi := &file_proto_hotel_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRoomsRequest) String() string {
+func (x *CatalogEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRoomsRequest) ProtoMessage() {}
+func (*CatalogEvent) ProtoMessage() {}
 
-func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotel_proto_msgTypes[15]
+func (x *CatalogEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -942,40 +3650,96 @@ func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRoomsRequest.ProtoReflect.Descriptor instead.
-func (*ListRoomsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_hotel_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use CatalogEvent.ProtoReflect.Descriptor instead.
+func (*CatalogEvent) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *ListRoomsRequest) GetHotelId() string {
+func (x *CatalogEvent) GetRevision() int64 {
 	if x != nil {
-		return x.HotelId
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *CatalogEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
 	}
 	return ""
 }
 
-type RoomList struct {
+func (x *CatalogEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *CatalogEvent) GetChange() isCatalogEvent_Change {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+func (x *CatalogEvent) GetHotel() *HotelChanged {
+	if x != nil {
+		if x, ok := x.Change.(*CatalogEvent_Hotel); ok {
+			return x.Hotel
+		}
+	}
+	return nil
+}
+
+func (x *CatalogEvent) GetRoom() *RoomChanged {
+	if x != nil {
+		if x, ok := x.Change.(*CatalogEvent_Room); ok {
+			return x.Room
+		}
+	}
+	return nil
+}
+
+type isCatalogEvent_Change interface {
+	isCatalogEvent_Change()
+}
+
+type CatalogEvent_Hotel struct {
+	Hotel *HotelChanged `protobuf:"bytes,10,opt,name=hotel,proto3,oneof"`
+}
+
+type CatalogEvent_Room struct {
+	Room *RoomChanged `protobuf:"bytes,11,opt,name=room,proto3,oneof"`
+}
+
+func (*CatalogEvent_Hotel) isCatalogEvent_Change() {}
+
+func (*CatalogEvent_Room) isCatalogEvent_Change() {}
+
+type WatchCatalogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rooms         []*Room                `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	HotelId       string                 `protobuf:"bytes,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoomList) Reset() {
-	*x = RoomList{}
-	mi := &file_proto_hotel_proto_msgTypes[16]
+func (x *WatchCatalogRequest) Reset() {
+	*x = WatchCatalogRequest{}
+	mi := &file_proto_hotel_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoomList) String() string {
+func (x *WatchCatalogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoomList) ProtoMessage() {}
+func (*WatchCatalogRequest) ProtoMessage() {}
 
-func (x *RoomList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotel_proto_msgTypes[16]
+func (x *WatchCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotel_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,42 +3750,119 @@ func (x *RoomList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoomList.ProtoReflect.Descriptor instead.
-func (*RoomList) Descriptor() ([]byte, []int) {
-	return file_proto_hotel_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use WatchCatalogRequest.ProtoReflect.Descriptor instead.
+func (*WatchCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotel_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *RoomList) GetRooms() []*Room {
+func (x *WatchCatalogRequest) GetResumeToken() string {
 	if x != nil {
-		return x.Rooms
+		return x.ResumeToken
 	}
-	return nil
+	return ""
+}
+
+func (x *WatchCatalogRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
 }
 
 var File_proto_hotel_proto protoreflect.FileDescriptor
 
 const file_proto_hotel_proto_rawDesc = "" +
 	"\n" +
-	"\x11proto/hotel.proto\x12\x05hotel\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x12auth_options.proto\"\xd7\x03\n" +
+	"\x11proto/hotel.proto\x12\x05hotel\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x12auth_options.proto\"\xa1\x04\n" +
 	"\x05Hotel\x12,\n" +
 	"\x02id\x18\x01 \x01(\tB\x1c\x92A\x192\x17Unique hotel identifierR\x02id\x12#\n" +
 	"\x04name\x18\x02 \x01(\tB\x0f\x92A\f2\n" +
 	"Hotel nameR\x04name\x124\n" +
 	"\aaddress\x18\x03 \x01(\tB\x1a\x92A\x172\x15Full physical addressR\aaddress\x12:\n" +
 	"\tamenities\x18\x04 \x03(\tB\x1c\x92A\x192\x17List of hotel amenitiesR\tamenities\x12?\n" +
-	"\x05rooms\x18\x05 \x03(\v2\v.hotel.RoomB\x1c\x92A\x192\x17List of available roomsR\x05rooms\x12V\n" +
+	"\x05rooms\x18\x05 \x03(\v2\v.hotel.RoomB\x1c\x92A\x192\x17List of available roomsR\x05rooms\x12H\n" +
+	"\x06photos\x18\x06 \x03(\v2\f.hotel.PhotoB\"\x92A\x1f2\x1dHotel photos in display orderR\x06photos\x12V\n" +
 	"\bmetadata\x18\x0f \x03(\v2\x1a.hotel.Hotel.MetadataEntryB\x1e\x92A\x1b2\x19Additional hotel metadataR\bmetadata\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:3\x92A0\n" +
-	".*\x05Hotel2%Hotel entity with rooms and amenities\"\xc4\x02\n" +
+	".*\x05Hotel2%Hotel entity with rooms and amenities\"\x81\x04\n" +
 	"\x04Room\x12+\n" +
 	"\x02id\x18\x01 \x01(\tB\x1b\x92A\x182\x16Unique room identifierR\x02id\x12+\n" +
 	"\x04type\x18\x02 \x01(\tB\x17\x92A\x142\x12Room type/categoryR\x04type\x12:\n" +
 	"\tamenities\x18\x03 \x03(\tB\x1c\x92A\x192\x17Room-specific amenitiesR\tamenities\x12C\n" +
 	"\fis_available\x18\x04 \x01(\bB \x92A\x1d2\x1bCurrent availability statusR\visAvailable\x12<\n" +
-	"\x0fprice_per_night\x18\x05 \x01(\x01B\x14\x92A\x112\x0fPrice per nightR\rpricePerNight:#\x92A \n" +
-	"\x1e*\x04Room2\x16Hotel room information\"\xad\x01\n" +
+	"\x0fprice_per_night\x18\x05 \x01(\x01B\x14\x92A\x112\x0fPrice per nightR\rpricePerNight\x12G\n" +
+	"\x06photos\x18\x06 \x03(\v2\f.hotel.PhotoB!\x92A\x1e2\x1cRoom photos in display orderR\x06photos\x12r\n" +
+	"\froom_type_id\x18\a \x01(\tBP\x92AM2KRoom type this physical room belongs to. type then holds the room type nameR\n" +
+	"roomTypeId:#\x92A \n" +
+	"\x1e*\x04Room2\x16Hotel room information\"\x91\x01\n" +
+	"\x10BedConfiguration\x12E\n" +
+	"\x04type\x18\x01 \x01(\tB1\x92A.2,Bed type, e.g. king, queen, twin or sofa bedR\x04type\x126\n" +
+	"\x05count\x18\x02 \x01(\x05B \x92A\x1d2\x1bNumber of beds of this typeR\x05count\"\xce\x04\n" +
+	"\bRoomType\x120\n" +
+	"\x02id\x18\x01 \x01(\tB \x92A\x1d2\x1bUnique room type identifierR\x02id\x12>\n" +
+	"\bhotel_id\x18\x02 \x01(\tB#\x92A 2\x1eHotel the room type belongs toR\ahotelId\x127\n" +
+	"\x04name\x18\x03 \x01(\tB#\x92A 2\x1eDisplay name, e.g. Deluxe KingR\x04name\x12<\n" +
+	"\vdescription\x18\x04 \x01(\tB\x1a\x92A\x172\x15Marketing descriptionR\vdescription\x12B\n" +
+	"\rmax_occupancy\x18\x05 \x01(\x05B\x1d\x92A\x1a2\x18Maximum number of guestsR\fmaxOccupancy\x12Q\n" +
+	"\x04beds\x18\x06 \x03(\v2\x17.hotel.BedConfigurationB$\x92A!2\x1fBeds in every room of this typeR\x04beds\x12:\n" +
+	"\bsize_sqm\x18\a \x01(\x01B\x1f\x92A\x1c2\x1aRoom size in square metersR\asizeSqm\x12G\n" +
+	"\tamenities\x18\b \x03(\tB)\x92A&2$Amenities of every room of this typeR\tamenities:=\x92A:\n" +
+	"8*\bRoomType2,Sellable category of rooms, e.g. Deluxe King\"\xa4\f\n" +
+	"\vPricingRule\x123\n" +
+	"\x02id\x18\x01 \x01(\tB#\x92A 2\x1eUnique pricing rule identifierR\x02id\x129\n" +
+	"\bhotel_id\x18\x02 \x01(\tB\x1e\x92A\x1b2\x19Hotel the rule belongs toR\ahotelId\x129\n" +
+	"\x04name\x18\x03 \x01(\tB%\x92A\"2 Display name, e.g. Summer seasonR\x04name\x12^\n" +
+	"\rroom_type_ids\x18\x04 \x03(\tB:\x92A725Room types the rule applies to. Empty means all roomsR\vroomTypeIds\x12\x86\x01\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampBK\x92AH2FFirst night the rule applies to (UTC date). Unset means no lower boundR\tstartDate\x12\x8e\x01\n" +
+	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampBW\x92AT2RNight the rule stops applying to, exclusive (UTC date). Unset means no upper boundR\aendDate\x12\x86\x01\n" +
+	"\fweekday_mask\x18\a \x01(\rBc\x92A`2^Nights the rule applies to as a bit mask: bit 0 is Sunday, bit 6 Saturday. 0 means every nightR\vweekdayMask\x12V\n" +
+	"\n" +
+	"min_nights\x18\b \x01(\x05B7\x92A422Only applies to stays of at least this many nightsR\tminNights\x12g\n" +
+	"\n" +
+	"max_nights\x18\t \x01(\x05BH\x92AE2COnly applies to stays of at most this many nights. 0 means no limitR\tmaxNights\x12\xa6\x01\n" +
+	"\x13arrival_within_days\x18\n" +
+	" \x01(\x05Bv\x92As2qOnly applies when the stay starts less than this many days after the quote, for last-minute deals. 0 means alwaysR\x11arrivalWithinDays\x12e\n" +
+	"\x0fadjustment_type\x18\v \x01(\x0e2\x15.hotel.AdjustmentTypeB%\x92A\"2 How adjustment changes the priceR\x0eadjustmentType\x12[\n" +
+	"\n" +
+	"adjustment\x18\f \x01(\x01B;\x92A826Percent, amount or price, depending on adjustment_typeR\n" +
+	"adjustment\x12N\n" +
+	"\bpriority\x18\r \x01(\x05B2\x92A/2-Rules apply in ascending priority, then by IDR\bpriority\x127\n" +
+	"\x06active\x18\x0e \x01(\bB\x1f\x92A\x1c2\x1aInactive rules are ignoredR\x06active:\xaf\x01\x92A\xab\x01\n" +
+	"\xa8\x01*\vPricingRule2\x98\x01Adjustment of the nightly room price, e.g. a weekend surcharge, a season or a last-minute deal. Matching rules apply to each night in ascending priority\"\xc8\x02\n" +
+	"\x12CancellationPolicy\x12O\n" +
+	"\n" +
+	"refundable\x18\x01 \x01(\bB/\x92A,2*Whether cancelling refunds anything at allR\n" +
+	"refundable\x12x\n" +
+	"\x17free_cancellation_hours\x18\x02 \x01(\x05B@\x92A=2;Cancelling at least this many hours before check-in is freeR\x15freeCancellationHours\x12g\n" +
+	"\x0fpenalty_percent\x18\x03 \x01(\x01B>\x92A;29Share of the total charged for later cancellations, 0-100R\x0epenaltyPercent\"\xdb\a\n" +
+	"\bRatePlan\x120\n" +
+	"\x02id\x18\x01 \x01(\tB \x92A\x1d2\x1bUnique rate plan identifierR\x02id\x12>\n" +
+	"\bhotel_id\x18\x02 \x01(\tB#\x92A 2\x1eHotel the rate plan belongs toR\ahotelId\x12C\n" +
+	"\x04name\x18\x03 \x01(\tB/\x92A,2*Display name, e.g. Flexible with breakfastR\x04name\x12Y\n" +
+	"\rroom_type_ids\x18\x04 \x03(\tB5\x92A220Room types the plan is sold for. Empty means allR\vroomTypeIds\x12A\n" +
+	"\tmeal_plan\x18\x05 \x01(\x0e2\x0f.hotel.MealPlanB\x13\x92A\x102\x0eIncluded mealsR\bmealPlan\x12c\n" +
+	"\x13cancellation_policy\x18\x06 \x01(\v2\x19.hotel.CancellationPolicyB\x17\x92A\x142\x12Cancellation termsR\x12cancellationPolicy\x12\x82\x01\n" +
+	"\x10price_multiplier\x18\a \x01(\x01BW\x92AT2RFactor applied to the room price per night, e.g. 0.9 for a 10% discount. 0 means 1R\x0fpriceMultiplier\x12s\n" +
+	"\x12nightly_supplement\x18\b \x01(\x01BD\x92AA2?Amount added per night after the multiplier, e.g. for breakfastR\x11nightlySupplement\x12L\n" +
+	"\n" +
+	"min_nights\x18\t \x01(\x05B-\x92A*2(Shortest stay the plan can be booked forR\tminNights\x12]\n" +
+	"\x06active\x18\n" +
+	" \x01(\bBE\x92AB2@Inactive plans are hidden from availability and cannot be bookedR\x06active:n\x92Ak\n" +
+	"i*\bRatePlan2]Conditions and price rules a room type is sold under, e.g. breakfast included, non-refundable\"\xdb\x03\n" +
+	"\x05Photo\x12,\n" +
+	"\x02id\x18\x01 \x01(\tB\x1c\x92A\x192\x17Unique photo identifierR\x02id\x12.\n" +
+	"\x03url\x18\x02 \x01(\tB\x1c\x92A\x192\x17Public URL of the imageR\x03url\x12/\n" +
+	"\acaption\x18\x03 \x01(\tB\x15\x92A\x122\x10Optional captionR\acaption\x12<\n" +
+	"\bposition\x18\x04 \x01(\x05B \x92A\x1d2\x1bZero-based display positionR\bposition\x12*\n" +
+	"\x05width\x18\x05 \x01(\x05B\x14\x92A\x112\x0fWidth in pixelsR\x05width\x12-\n" +
+	"\x06height\x18\x06 \x01(\x05B\x15\x92A\x122\x10Height in pixelsR\x06height\x12I\n" +
+	"\fcontent_type\x18\a \x01(\tB&\x92A#2!Image media type, e.g. image/jpegR\vcontentType\x127\n" +
+	"\n" +
+	"size_bytes\x18\b \x01(\x03B\x18\x92A\x152\x13Image size in bytesR\tsizeBytes:&\x92A#\n" +
+	"!*\x05Photo2\x18Image of a hotel or room\"\xad\x01\n" +
 	"\x12CreateHotelRequest\x12#\n" +
 	"\x04name\x18\x01 \x01(\tB\x0f\x92A\f2\n" +
 	"Hotel nameR\x04name\x124\n" +
@@ -1042,38 +3883,202 @@ const file_proto_hotel_proto_rawDesc = "" +
 	"\blocation\x18\x01 \x01(\tB\x1a\x92A\x172\x15Location search queryR\blocation\x12M\n" +
 	"\x12required_amenities\x18\x02 \x03(\tB\x1e\x92A\x1b2\x19Required amenities filterR\x11requiredAmenities\"O\n" +
 	"\tHotelList\x12B\n" +
-	"\x06hotels\x18\x01 \x03(\v2\f.hotel.HotelB\x1c\x92A\x192\x17List of matching hotelsR\x06hotels\"\xdf\x01\n" +
+	"\x06hotels\x18\x01 \x03(\v2\f.hotel.HotelB\x1c\x92A\x192\x17List of matching hotelsR\x06hotels\"\xb9\x02\n" +
 	"\x0eAddRoomRequest\x12/\n" +
-	"\bhotel_id\x18\x01 \x01(\tB\x14\x92A\x112\x0fParent hotel IDR\ahotelId\x12+\n" +
+	"\bhotel_id\x18\x01 \x01(\tB\x14\x92A\x112\x0fParent hotel IDR\ahotelId\x12X\n" +
+	"\froom_type_id\x18\x05 \x01(\tB6\x92A321Room type of the hotel. type defaults to its nameR\n" +
+	"roomTypeId\x12+\n" +
 	"\x04type\x18\x02 \x01(\tB\x17\x92A\x142\x12Room type/categoryR\x04type\x121\n" +
 	"\tamenities\x18\x03 \x03(\tB\x13\x92A\x102\x0eRoom amenitiesR\tamenities\x12<\n" +
-	"\x0fprice_per_night\x18\x04 \x01(\x01B\x14\x92A\x112\x0fPrice per nightR\rpricePerNight\"\x99\x02\n" +
+	"\x0fprice_per_night\x18\x04 \x01(\x01B\x14\x92A\x112\x0fPrice per nightR\rpricePerNight\"\xd3\x02\n" +
 	"\x11UpdateRoomRequest\x12/\n" +
 	"\bhotel_id\x18\x01 \x01(\tB\x14\x92A\x112\x0fParent hotel IDR\ahotelId\x12&\n" +
 	"\x02id\x18\x02 \x01(\tB\x16\x92A\x132\x11Room ID to updateR\x02id\x12*\n" +
 	"\x04type\x18\x03 \x01(\tB\x16\x92A\x132\x11Updated room typeR\x04type\x129\n" +
 	"\tamenities\x18\x04 \x03(\tB\x1b\x92A\x182\x16Updated amenities listR\tamenities\x12D\n" +
-	"\x0fprice_per_night\x18\x05 \x01(\x01B\x1c\x92A\x192\x17Updated price per nightR\rpricePerNight\"l\n" +
+	"\x0fprice_per_night\x18\x05 \x01(\x01B\x1c\x92A\x192\x17Updated price per nightR\rpricePerNight\x128\n" +
+	"\froom_type_id\x18\x06 \x01(\tB\x16\x92A\x132\x11Updated room typeR\n" +
+	"roomTypeId\"l\n" +
 	"\x11DeleteRoomRequest\x12/\n" +
 	"\bhotel_id\x18\x01 \x01(\tB\x14\x92A\x112\x0fParent hotel IDR\ahotelId\x12&\n" +
-	"\x02id\x18\x02 \x01(\tB\x16\x92A\x132\x11Room ID to deleteR\x02id\"\x8d\x02\n" +
+	"\x02id\x18\x02 \x01(\tB\x16\x92A\x132\x11Room ID to deleteR\x02id\"\xb1\x03\n" +
 	"\x13AvailabilityRequest\x12>\n" +
 	"\bhotel_id\x18\x01 \x01(\tB#\x92A 2\x1eHotel ID to check availabilityR\ahotelId\x12]\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\"\x92A\x1f2\x1dStart date of stay (ISO 8601)R\tstartDate\x12W\n" +
-	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB \x92A\x1d2\x1bEnd date of stay (ISO 8601)R\aendDate\"\xf6\x01\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB \x92A\x1d2\x1bEnd date of stay (ISO 8601)R\aendDate\x12Y\n" +
+	"\frate_plan_id\x18\x04 \x01(\tB7\x92A422Only offer this rate plan, and price rooms with itR\n" +
+	"ratePlanId\x12G\n" +
+	"\froom_type_id\x18\x05 \x01(\tB%\x92A\"2 Only consider rooms of this typeR\n" +
+	"roomTypeId\"\xde\x02\n" +
 	"\x14AvailabilityResponse\x12C\n" +
 	"\fis_available\x18\x01 \x01(\bB \x92A\x1d2\x1bOverall availability statusR\visAvailable\x12R\n" +
 	"\x0favailable_rooms\x18\x02 \x03(\v2\v.hotel.RoomB\x1c\x92A\x192\x17List of available roomsR\x0eavailableRooms\x12E\n" +
 	"\vtotal_price\x18\x03 \x01(\x01B$\x92A!2\x1fTotal price for selected periodR\n" +
-	"totalPrice\"~\n" +
+	"totalPrice\x12f\n" +
+	"\x06offers\x18\x04 \x03(\v2\x10.hotel.RateOfferB<\x92A927Bookable combinations of room type and active rate planR\x06offers\"\xa8\x02\n" +
+	"\tRateOffer\x128\n" +
+	"\froom_type_id\x18\x01 \x01(\tB\x16\x92A\x132\x11Offered room typeR\n" +
+	"roomTypeId\x12E\n" +
+	"\frate_plan_id\x18\x02 \x01(\tB#\x92A 2\x1eRate plan the price applies toR\n" +
+	"ratePlanId\x12;\n" +
+	"\broom_ids\x18\x03 \x03(\tB \x92A\x1d2\x1bAvailable rooms of the typeR\aroomIds\x12]\n" +
+	"\vtotal_price\x18\x04 \x01(\x01B<\x92A927Price of the cheapest of those rooms for the whole stayR\n" +
+	"totalPrice\"\xfb\x02\n" +
+	"\x1aCreateUploadSessionRequest\x12:\n" +
+	"\bhotel_id\x18\x01 \x01(\tB\x1f\x92A\x1c2\x1aHotel the photo belongs toR\ahotelId\x12P\n" +
+	"\aroom_id\x18\x02 \x01(\tB7\x92A422Room the photo belongs to. Empty for a hotel photoR\x06roomId\x12J\n" +
+	"\fcontent_type\x18\x03 \x01(\tB'\x92A$2\"image/jpeg, image/png or image/gifR\vcontentType\x12D\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03B%\x92A\"2 Exact size of the image in bytesR\tsizeBytes\x12=\n" +
+	"\x06sha256\x18\x05 \x01(\tB%\x92A\"2 Hex-encoded SHA-256 of the imageR\x06sha256\"\xaa\x02\n" +
+	"\rUploadSession\x12V\n" +
+	"\tupload_id\x18\x01 \x01(\tB9\x92A624Identifies the upload in UploadPhoto and AttachPhotoR\buploadId\x12l\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB1\x92A.2,The upload must be attached before this timeR\texpiresAt\x12S\n" +
+	"\x0fmax_chunk_bytes\x18\x03 \x01(\x05B+\x92A(2&Largest data chunk UploadPhoto acceptsR\rmaxChunkBytes\"\xf3\x01\n" +
+	"\x12UploadPhotoRequest\x12T\n" +
+	"\tupload_id\x18\x01 \x01(\tB7\x92A422Upload session. Required in the first message onlyR\buploadId\x12U\n" +
+	"\x06offset\x18\x02 \x01(\x03B=\x92A:28Position of data in the image; chunks must be contiguousR\x06offset\x120\n" +
+	"\x04data\x18\x03 \x01(\fB\x1c\x92A\x192\x17Next chunk of the imageR\x04data\"\xf2\x01\n" +
+	"\x13UploadPhotoResponse\x12:\n" +
+	"\tupload_id\x18\x01 \x01(\tB\x1d\x92A\x1a2\x18Completed upload sessionR\buploadId\x122\n" +
+	"\n" +
+	"size_bytes\x18\x02 \x01(\x03B\x13\x92A\x102\x0eBytes receivedR\tsizeBytes\x123\n" +
+	"\x05width\x18\x03 \x01(\x05B\x1d\x92A\x1a2\x18Detected width in pixelsR\x05width\x126\n" +
+	"\x06height\x18\x04 \x01(\x05B\x1e\x92A\x1b2\x19Detected height in pixelsR\x06height\"\x8a\x02\n" +
+	"\x12AttachPhotoRequest\x12@\n" +
+	"\bhotel_id\x18\x01 \x01(\tB%\x92A\"2 Hotel the upload was created forR\ahotelId\x12E\n" +
+	"\aroom_id\x18\x02 \x01(\tB,\x92A)2'Room the upload was created for, if anyR\x06roomId\x12:\n" +
+	"\tupload_id\x18\x03 \x01(\tB\x1d\x92A\x1a2\x18Completed upload sessionR\buploadId\x12/\n" +
+	"\acaption\x18\x04 \x01(\tB\x15\x92A\x122\x10Optional captionR\acaption\"\xf4\x01\n" +
+	"\x14ReorderPhotosRequest\x12=\n" +
+	"\bhotel_id\x18\x01 \x01(\tB\"\x92A\x1f2\x1dHotel whose photos to reorderR\ahotelId\x12R\n" +
+	"\aroom_id\x18\x02 \x01(\tB9\x92A624Room whose photos to reorder. Empty for hotel photosR\x06roomId\x12I\n" +
+	"\tphoto_ids\x18\x03 \x03(\tB,\x92A)2'Every photo ID in the new display orderR\bphotoIds\"1\n" +
+	"\tPhotoList\x12$\n" +
+	"\x06photos\x18\x01 \x03(\v2\f.hotel.PhotoR\x06photos\"\xd6\x01\n" +
+	"\x12DeletePhotoRequest\x12:\n" +
+	"\bhotel_id\x18\x01 \x01(\tB\x1f\x92A\x1c2\x1aHotel the photo belongs toR\ahotelId\x12P\n" +
+	"\aroom_id\x18\x02 \x01(\tB7\x92A422Room the photo belongs to. Empty for a hotel photoR\x06roomId\x122\n" +
+	"\bphoto_id\x18\x03 \x01(\tB\x17\x92A\x142\x12Photo ID to deleteR\aphotoId\"\xbb\x01\n" +
+	"\x15CreateRoomTypeRequest\x12=\n" +
+	"\bhotel_id\x18\x01 \x01(\tB\"\x92A\x1f2\x1dHotel to add the room type toR\ahotelId\x12c\n" +
+	"\troom_type\x18\x02 \x01(\v2\x0f.hotel.RoomTypeB5\x92A220Room type to create. id and hotel_id are ignoredR\broomType\"w\n" +
+	"\x12GetRoomTypeRequest\x12>\n" +
+	"\bhotel_id\x18\x01 \x01(\tB#\x92A 2\x1eHotel the room type belongs toR\ahotelId\x12!\n" +
+	"\x02id\x18\x02 \x01(\tB\x11\x92A\x0e2\fRoom type IDR\x02id\"V\n" +
+	"\x14ListRoomTypesRequest\x12>\n" +
+	"\bhotel_id\x18\x01 \x01(\tB#\x92A 2\x1eHotel whose room types to listR\ahotelId\">\n" +
+	"\fRoomTypeList\x12.\n" +
+	"\n" +
+	"room_types\x18\x01 \x03(\v2\x0f.hotel.RoomTypeR\troomTypes\"\xda\x01\n" +
+	"\x15UpdateRoomTypeRequest\x12>\n" +
+	"\bhotel_id\x18\x01 \x01(\tB#\x92A 2\x1eHotel the room type belongs toR\ahotelId\x12+\n" +
+	"\x02id\x18\x02 \x01(\tB\x1b\x92A\x182\x16Room type ID to updateR\x02id\x12T\n" +
+	"\troom_type\x18\x03 \x01(\v2\x0f.hotel.RoomTypeB&\x92A#2!New values; empty fields are keptR\broomType\"\x84\x01\n" +
+	"\x15DeleteRoomTypeRequest\x12>\n" +
+	"\bhotel_id\x18\x01 \x01(\tB#\x92A 2\x1eHotel the room type belongs toR\ahotelId\x12+\n" +
+	"\x02id\x18\x02 \x01(\tB\x1b\x92A\x182\x16Room type ID to deleteR\x02id\"\xbb\x01\n" +
+	"\x15CreateRatePlanRequest\x12=\n" +
+	"\bhotel_id\x18\x01 \x01(\tB\"\x92A\x1f2\x1dHotel to add the rate plan toR\ahotelId\x12c\n" +
+	"\trate_plan\x18\x02 \x01(\v2\x0f.hotel.RatePlanB5\x92A220Rate plan to create. id and hotel_id are ignoredR\bratePlan\"w\n" +
+	"\x12GetRatePlanRequest\x12>\n" +
+	"\bhotel_id\x18\x01 \x01(\tB#\x92A 2\x1eHotel the rate plan belongs toR\ahotelId\x12!\n" +
+	"\x02id\x18\x02 \x01(\tB\x11\x92A\x0e2\fRate plan IDR\x02id\"V\n" +
+	"\x14ListRatePlansRequest\x12>\n" +
+	"\bhotel_id\x18\x01 \x01(\tB#\x92A 2\x1eHotel whose rate plans to listR\ahotelId\">\n" +
+	"\fRatePlanList\x12.\n" +
+	"\n" +
+	"rate_plans\x18\x01 \x03(\v2\x0f.hotel.RatePlanR\tratePlans\"\xe7\x01\n" +
+	"\x15UpdateRatePlanRequest\x12>\n" +
+	"\bhotel_id\x18\x01 \x01(\tB#\x92A 2\x1eHotel the rate plan belongs toR\ahotelId\x12+\n" +
+	"\x02id\x18\x02 \x01(\tB\x1b\x92A\x182\x16Rate plan ID to updateR\x02id\x12a\n" +
+	"\trate_plan\x18\x03 \x01(\v2\x0f.hotel.RatePlanB3\x92A02.Complete new plan. id and hotel_id are ignoredR\bratePlan\"\x84\x01\n" +
+	"\x15DeleteRatePlanRequest\x12>\n" +
+	"\bhotel_id\x18\x01 \x01(\tB#\x92A 2\x1eHotel the rate plan belongs toR\ahotelId\x12+\n" +
+	"\x02id\x18\x02 \x01(\tB\x1b\x92A\x182\x16Rate plan ID to deleteR\x02id\"\xcd\x01\n" +
+	"\x18CreatePricingRuleRequest\x12@\n" +
+	"\bhotel_id\x18\x01 \x01(\tB%\x92A\"2 Hotel to add the pricing rule toR\ahotelId\x12o\n" +
+	"\fpricing_rule\x18\x02 \x01(\v2\x12.hotel.PricingRuleB8\x92A523Pricing rule to create. id and hotel_id are ignoredR\vpricingRule\"\x80\x01\n" +
+	"\x15GetPricingRuleRequest\x12A\n" +
+	"\bhotel_id\x18\x01 \x01(\tB&\x92A#2!Hotel the pricing rule belongs toR\ahotelId\x12$\n" +
+	"\x02id\x18\x02 \x01(\tB\x14\x92A\x112\x0fPricing rule IDR\x02id\"\\\n" +
+	"\x17ListPricingRulesRequest\x12A\n" +
+	"\bhotel_id\x18\x01 \x01(\tB&\x92A#2!Hotel whose pricing rules to listR\ahotelId\"J\n" +
+	"\x0fPricingRuleList\x127\n" +
+	"\rpricing_rules\x18\x01 \x03(\v2\x12.hotel.PricingRuleR\fpricingRules\"\xf9\x01\n" +
+	"\x18UpdatePricingRuleRequest\x12A\n" +
+	"\bhotel_id\x18\x01 \x01(\tB&\x92A#2!Hotel the pricing rule belongs toR\ahotelId\x12.\n" +
+	"\x02id\x18\x02 \x01(\tB\x1e\x92A\x1b2\x19Pricing rule ID to updateR\x02id\x12j\n" +
+	"\fpricing_rule\x18\x03 \x01(\v2\x12.hotel.PricingRuleB3\x92A02.Complete new rule. id and hotel_id are ignoredR\vpricingRule\"\x8d\x01\n" +
+	"\x18DeletePricingRuleRequest\x12A\n" +
+	"\bhotel_id\x18\x01 \x01(\tB&\x92A#2!Hotel the pricing rule belongs toR\ahotelId\x12.\n" +
+	"\x02id\x18\x02 \x01(\tB\x1e\x92A\x1b2\x19Pricing rule ID to deleteR\x02id\"\x90\x03\n" +
+	"\x11QuotePriceRequest\x121\n" +
+	"\bhotel_id\x18\x01 \x01(\tB\x16\x92A\x132\x11Hotel of the roomR\ahotelId\x12+\n" +
+	"\aroom_id\x18\x02 \x01(\tB\x12\x92A\x0f2\rRoom to priceR\x06roomId\x12]\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\"\x92A\x1f2\x1dStart date of stay (ISO 8601)R\tstartDate\x12W\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB \x92A\x1d2\x1bEnd date of stay (ISO 8601)R\aendDate\x12c\n" +
+	"\frate_plan_id\x18\x05 \x01(\tBA\x92A>2<Rate plan to price the stay under. Empty uses the room priceR\n" +
+	"ratePlanId\"\xb7\x02\n" +
+	"\fNightlyPrice\x12G\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x17\x92A\x142\x12Start of the nightR\x04date\x12A\n" +
+	"\n" +
+	"base_price\x18\x02 \x01(\x01B\"\x92A\x1f2\x1dRoom price before adjustmentsR\tbasePrice\x12F\n" +
+	"\x05price\x18\x03 \x01(\x01B0\x92A-2+Price after pricing rules and the rate planR\x05price\x12S\n" +
+	"\x10pricing_rule_ids\x18\x04 \x03(\tB)\x92A&2$Rules applied to the night, in orderR\x0epricingRuleIds\"\xa4\x02\n" +
+	"\x12QuotePriceResponse\x12R\n" +
+	"\x06nights\x18\x01 \x03(\v2\x13.hotel.NightlyPriceB%\x92A\"2 Price of every night of the stayR\x06nights\x12?\n" +
+	"\vtotal_price\x18\x02 \x01(\x01B\x1e\x92A\x1b2\x19Sum of the nightly pricesR\n" +
+	"totalPrice\x12y\n" +
+	"\tquoted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB@\x92A=2;Time the quote was computed; last-minute rules depend on itR\bquotedAt\"~\n" +
 	"\x0eGetRoomRequest\x12B\n" +
 	"\bhotel_id\x18\x01 \x01(\tB'\x92A$2\"Hotel ID to which the room belongsR\ahotelId\x12(\n" +
 	"\x02id\x18\x02 \x01(\tB\x18\x92A\x152\x13Room ID to retrieveR\x02id\"X\n" +
 	"\x10ListRoomsRequest\x12D\n" +
 	"\bhotel_id\x18\x01 \x01(\tB)\x92A&2$Hotel ID for which to list all roomsR\ahotelId\"-\n" +
 	"\bRoomList\x12!\n" +
-	"\x05rooms\x18\x01 \x03(\v2\v.hotel.RoomR\x05rooms2\xe6\x0f\n" +
+	"\x05rooms\x18\x01 \x03(\v2\v.hotel.RoomR\x05rooms\"\x93\x02\n" +
+	"\fHotelChanged\x12>\n" +
+	"\x06change\x18\x01 \x01(\x0e2\x11.hotel.ChangeTypeB\x13\x92A\x102\x0eKind of changeR\x06change\x12b\n" +
+	"\x06before\x18\x02 \x01(\v2\f.hotel.HotelB<\x92A927Hotel before the change, without rooms. Unset on createR\x06before\x12_\n" +
+	"\x05after\x18\x03 \x01(\v2\f.hotel.HotelB;\x92A826Hotel after the change, without rooms. Unset on deleteR\x05after\"\xb4\x02\n" +
+	"\vRoomChanged\x12B\n" +
+	"\bhotel_id\x18\x01 \x01(\tB'\x92A$2\"Hotel ID to which the room belongsR\ahotelId\x12>\n" +
+	"\x06change\x18\x02 \x01(\x0e2\x11.hotel.ChangeTypeB\x13\x92A\x102\x0eKind of changeR\x06change\x12Q\n" +
+	"\x06before\x18\x03 \x01(\v2\v.hotel.RoomB,\x92A)2'Room before the change. Unset on createR\x06before\x12N\n" +
+	"\x05after\x18\x04 \x01(\v2\v.hotel.RoomB+\x92A(2&Room after the change. Unset on deleteR\x05after\"\xe3\x02\n" +
+	"\fCatalogEvent\x12O\n" +
+	"\brevision\x18\x01 \x01(\x03B3\x92A02.Catalog revision, increasing by one per changeR\brevision\x12X\n" +
+	"\fresume_token\x18\x02 \x01(\tB5\x92A220Opaque token to resume watching after this eventR\vresumeToken\x12G\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x17\x92A\x142\x12Time of the changeR\x04time\x12+\n" +
+	"\x05hotel\x18\n" +
+	" \x01(\v2\x13.hotel.HotelChangedH\x00R\x05hotel\x12(\n" +
+	"\x04room\x18\v \x01(\v2\x12.hotel.RoomChangedH\x00R\x04roomB\b\n" +
+	"\x06change\"\xf5\x01\n" +
+	"\x13WatchCatalogRequest\x12\x8d\x01\n" +
+	"\fresume_token\x18\x01 \x01(\tBj\x92Ag2eResume after the event carrying this token. Empty starts with the next change, after a bookmark eventR\vresumeToken\x12N\n" +
+	"\bhotel_id\x18\x02 \x01(\tB3\x92A02.Only watch changes to this hotel and its roomsR\ahotelId*\xa8\x01\n" +
+	"\bMealPlan\x12\x19\n" +
+	"\x15MEAL_PLAN_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13MEAL_PLAN_ROOM_ONLY\x10\x01\x12\x17\n" +
+	"\x13MEAL_PLAN_BREAKFAST\x10\x02\x12\x18\n" +
+	"\x14MEAL_PLAN_HALF_BOARD\x10\x03\x12\x18\n" +
+	"\x14MEAL_PLAN_FULL_BOARD\x10\x04\x12\x1b\n" +
+	"\x17MEAL_PLAN_ALL_INCLUSIVE\x10\x05*\x8b\x01\n" +
+	"\x0eAdjustmentType\x12\x1f\n" +
+	"\x1bADJUSTMENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17ADJUSTMENT_TYPE_PERCENT\x10\x01\x12\x1a\n" +
+	"\x16ADJUSTMENT_TYPE_AMOUNT\x10\x02\x12\x1f\n" +
+	"\x1bADJUSTMENT_TYPE_FIXED_PRICE\x10\x03*t\n" +
+	"\n" +
+	"ChangeType\x12\x1b\n" +
+	"\x17CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13CHANGE_TYPE_CREATED\x10\x01\x12\x17\n" +
+	"\x13CHANGE_TYPE_UPDATED\x10\x02\x12\x17\n" +
+	"\x13CHANGE_TYPE_DELETED\x10\x032\xcb:\n" +
 	"\fHotelService\x12\xa0\x01\n" +
 	"\vCreateHotel\x12\x19.hotel.CreateHotelRequest\x1a\f.hotel.Hotel\"h\x92AL\x12\x10Create new hotel\x1a\x19Requires admin privileges*\vCreateHotelb\x10\n" +
 	"\x0e\n" +
@@ -1112,8 +4117,80 @@ const file_proto_hotel_proto_rawDesc = "" +
 	"DeleteRoomb\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02\"* /v1/hotels/{hotel_id}/rooms/{id}\x12\xd3\x01\n" +
-	"\x11CheckAvailability\x12\x1a.hotel.AvailabilityRequest\x1a\x1b.hotel.AvailabilityResponse\"\x84\x01\x92AS\x12\x17Check room availability\x1a%Check available rooms for given dates*\x11CheckAvailability\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02$\x12\"/v1/hotels/{hotel_id}/availabilityB\x8d\x02\x92A\xd1\x01\x12\x84\x01\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02\"* /v1/hotels/{hotel_id}/rooms/{id}\x12\xcb\x01\n" +
+	"\x0eCreateRoomType\x12\x1c.hotel.CreateRoomTypeRequest\x1a\x0f.hotel.RoomType\"\x89\x01\x92AO\x12\x10Create room type\x1a\x19Requires admin privileges*\x0eCreateRoomTypeb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02-:\troom_type\" /v1/hotels/{hotel_id}/room-types\x12\x8b\x01\n" +
+	"\vGetRoomType\x12\x19.hotel.GetRoomTypeRequest\x1a\x0f.hotel.RoomType\"P\x92A\x1c\x12\rGet room type*\vGetRoomType\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02'\x12%/v1/hotels/{hotel_id}/room-types/{id}\x12\xb9\x01\n" +
+	"\rListRoomTypes\x12\x1b.hotel.ListRoomTypesRequest\x1a\x13.hotel.RoomTypeList\"v\x92AG\x12\x0fList room types\x1a%Room types of a hotel ordered by name*\rListRoomTypes\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\"\x12 /v1/hotels/{hotel_id}/room-types\x12\xfc\x01\n" +
+	"\x0eUpdateRoomType\x12\x1c.hotel.UpdateRoomTypeRequest\x1a\x0f.hotel.RoomType\"\xba\x01\x92A{\x12\x10Update room type\x1aENon-empty fields replace the stored values. Requires admin privileges*\x0eUpdateRoomTypeb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x022:\troom_type\x1a%/v1/hotels/{hotel_id}/room-types/{id}\x12\xf7\x01\n" +
+	"\x0eDeleteRoomType\x12\x1c.hotel.DeleteRoomTypeRequest\x1a\x15.hotel.DeleteResponse\"\xaf\x01\x92A{\x12\x10Delete room type\x1aEFails while rooms still reference the type. Requires admin privileges*\x0eDeleteRoomTypeb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02'*%/v1/hotels/{hotel_id}/room-types/{id}\x12\xcb\x01\n" +
+	"\x0eCreateRatePlan\x12\x1c.hotel.CreateRatePlanRequest\x1a\x0f.hotel.RatePlan\"\x89\x01\x92AO\x12\x10Create rate plan\x1a\x19Requires admin privileges*\x0eCreateRatePlanb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02-:\trate_plan\" /v1/hotels/{hotel_id}/rate-plans\x12\x8b\x01\n" +
+	"\vGetRatePlan\x12\x19.hotel.GetRatePlanRequest\x1a\x0f.hotel.RatePlan\"P\x92A\x1c\x12\rGet rate plan*\vGetRatePlan\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02'\x12%/v1/hotels/{hotel_id}/rate-plans/{id}\x12\xb9\x01\n" +
+	"\rListRatePlans\x12\x1b.hotel.ListRatePlansRequest\x1a\x13.hotel.RatePlanList\"v\x92AG\x12\x0fList rate plans\x1a%Rate plans of a hotel ordered by name*\rListRatePlans\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\"\x12 /v1/hotels/{hotel_id}/rate-plans\x12\x8f\x02\n" +
+	"\x0eUpdateRatePlan\x12\x1c.hotel.UpdateRatePlanRequest\x1a\x0f.hotel.RatePlan\"\xcd\x01\x92A\x8d\x01\x12\x10Update rate plan\x1aWReplaces the stored plan. Existing bookings keep their price. Requires admin privileges*\x0eUpdateRatePlanb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x022:\trate_plan\x1a%/v1/hotels/{hotel_id}/rate-plans/{id}\x12\xcb\x01\n" +
+	"\x0eDeleteRatePlan\x12\x1c.hotel.DeleteRatePlanRequest\x1a\x15.hotel.DeleteResponse\"\x83\x01\x92AO\x12\x10Delete rate plan\x1a\x19Requires admin privileges*\x0eDeleteRatePlanb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02'*%/v1/hotels/{hotel_id}/rate-plans/{id}\x12\xe0\x01\n" +
+	"\x11CreatePricingRule\x12\x1f.hotel.CreatePricingRuleRequest\x1a\x12.hotel.PricingRule\"\x95\x01\x92AU\x12\x13Create pricing rule\x1a\x19Requires admin privileges*\x11CreatePricingRuleb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x023:\fpricing_rule\"#/v1/hotels/{hotel_id}/pricing-rules\x12\xcb\x01\n" +
+	"\x0eGetPricingRule\x12\x1c.hotel.GetPricingRuleRequest\x1a\x12.hotel.PricingRule\"\x86\x01\x92AO\x12\x10Get pricing rule\x1a\x19Requires admin privileges*\x0eGetPricingRuleb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02*\x12(/v1/hotels/{hotel_id}/pricing-rules/{id}\x12\x85\x02\n" +
+	"\x10ListPricingRules\x12\x1e.hotel.ListPricingRulesRequest\x1a\x16.hotel.PricingRuleList\"\xb8\x01\x92A\x85\x01\x12\x12List pricing rules\x1aKPricing rules of a hotel in the order they apply. Requires admin privileges*\x10ListPricingRulesb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02%\x12#/v1/hotels/{hotel_id}/pricing-rules\x12\xa4\x02\n" +
+	"\x11UpdatePricingRule\x12\x1f.hotel.UpdatePricingRuleRequest\x1a\x12.hotel.PricingRule\"\xd9\x01\x92A\x93\x01\x12\x13Update pricing rule\x1aWReplaces the stored rule. Existing bookings keep their price. Requires admin privileges*\x11UpdatePricingRuleb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x028:\fpricing_rule\x1a(/v1/hotels/{hotel_id}/pricing-rules/{id}\x12\xda\x01\n" +
+	"\x11DeletePricingRule\x12\x1f.hotel.DeletePricingRuleRequest\x1a\x15.hotel.DeleteResponse\"\x8c\x01\x92AU\x12\x13Delete pricing rule\x1a\x19Requires admin privileges*\x11DeletePricingRuleb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02**(/v1/hotels/{hotel_id}/pricing-rules/{id}\x12\x89\x02\n" +
+	"\n" +
+	"QuotePrice\x12\x18.hotel.QuotePriceRequest\x1a\x19.hotel.QuotePriceResponse\"\xc5\x01\x92A\x8a\x01\x12\x10Quote room price\x1ajPrice of every night of a stay after pricing rules and the optional rate plan. Does not check availability*\n" +
+	"QuotePrice\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02-\x12+/v1/hotels/{hotel_id}/rooms/{room_id}/quote\x12\xd3\x01\n" +
+	"\x11CheckAvailability\x12\x1a.hotel.AvailabilityRequest\x1a\x1b.hotel.AvailabilityResponse\"\x84\x01\x92AS\x12\x17Check room availability\x1a%Check available rooms for given dates*\x11CheckAvailability\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02$\x12\"/v1/hotels/{hotel_id}/availability\x12\xee\x02\n" +
+	"\x13CreateUploadSession\x12!.hotel.CreateUploadSessionRequest\x1a\x14.hotel.UploadSession\"\x9d\x02\x92A\xab\x01\x12\x12Start photo upload\x1anDeclares the size and SHA-256 of a photo before its bytes are sent with UploadPhoto. Requires admin privileges*\x13CreateUploadSessionb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02d:\x01*Z9:\x01*\"4/v1/hotels/{hotel_id}/rooms/{room_id}/photos/uploads\"$/v1/hotels/{hotel_id}/photos/uploads\x12L\n" +
+	"\vUploadPhoto\x12\x19.hotel.UploadPhotoRequest\x1a\x1a.hotel.UploadPhotoResponse\"\x04\x90\xb5\x18\x02(\x01\x12\xac\x02\n" +
+	"\vAttachPhoto\x12\x19.hotel.AttachPhotoRequest\x1a\f.hotel.Photo\"\xf3\x01\x92A\x91\x01\x12\x15Attach uploaded photo\x1aYAdds a completed upload as the last photo of the hotel or room. Requires admin privileges*\vAttachPhotob\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02T:\x01*Z1:\x01*\",/v1/hotels/{hotel_id}/rooms/{room_id}/photos\"\x1c/v1/hotels/{hotel_id}/photos\x12\xc1\x02\n" +
+	"\rReorderPhotos\x12\x1b.hotel.ReorderPhotosRequest\x1a\x10.hotel.PhotoList\"\x80\x02\x92A\x92\x01\x12\x0eReorder photos\x1a_Sets the display order. photo_ids must list every photo exactly once. Requires admin privileges*\rReorderPhotosb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02`:\x01*Z7:\x01*\x1a2/v1/hotels/{hotel_id}/rooms/{room_id}/photos/order\x1a\"/v1/hotels/{hotel_id}/photos/order\x12\xa3\x02\n" +
+	"\vDeletePhoto\x12\x19.hotel.DeletePhotoRequest\x1a\x15.hotel.DeleteResponse\"\xe1\x01\x92Ap\x12\fDelete photo\x1aARemoves the photo and its stored image. Requires admin privileges*\vDeletePhotob\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02dZ9*7/v1/hotels/{hotel_id}/rooms/{room_id}/photos/{photo_id}*'/v1/hotels/{hotel_id}/photos/{photo_id}\x12\xb8\x04\n" +
+	"\fWatchCatalog\x12\x1a.hotel.WatchCatalogRequest\x1a\x13.hotel.CatalogEvent\"\xf4\x03\x92A\xd3\x03\x12\x15Watch catalog changes\x1a\x99\x03Streams hotel and room changes in revision order. Pass the last resume_token to continue after a reconnect. Without a resume_token the stream starts with a bookmark event that carries the current revision and resume_token but no change. A stream filtered by hotel_id also gets bookmarks for changes to other hotels. A stream that falls too far behind ends with RESUME_TOKEN_EXPIRED. Requires a service account*\fWatchCatalogb\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"bearerAuth\x12\x00\x90\xb5\x18\x03\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/catalog/watch0\x01B\x8d\x02\x92A\xd1\x01\x12\x84\x01\n" +
 	"\rHotel Service\x12 Hotel and room management system\"L\n" +
 	"\fSupport Team\x12!https://hotel-service.com/support\x1a\x19support@hotel-service.com2\x031.0ZH\n" +
 	"F\n" +
@@ -1132,66 +4209,188 @@ func file_proto_hotel_proto_rawDescGZIP() []byte {
 	return file_proto_hotel_proto_rawDescData
 }
 
-var file_proto_hotel_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_proto_hotel_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_hotel_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_proto_hotel_proto_goTypes = []any{
-	(*Hotel)(nil),                 // 0: hotel.Hotel
-	(*Room)(nil),                  // 1: hotel.Room
-	(*CreateHotelRequest)(nil),    // 2: hotel.CreateHotelRequest
-	(*UpdateHotelRequest)(nil),    // 3: hotel.UpdateHotelRequest
-	(*DeleteHotelRequest)(nil),    // 4: hotel.DeleteHotelRequest
-	(*DeleteResponse)(nil),        // 5: hotel.DeleteResponse
-	(*GetHotelRequest)(nil),       // 6: hotel.GetHotelRequest
-	(*SearchRequest)(nil),         // 7: hotel.SearchRequest
-	(*HotelList)(nil),             // 8: hotel.HotelList
-	(*AddRoomRequest)(nil),        // 9: hotel.AddRoomRequest
-	(*UpdateRoomRequest)(nil),     // 10: hotel.UpdateRoomRequest
-	(*DeleteRoomRequest)(nil),     // 11: hotel.DeleteRoomRequest
-	(*AvailabilityRequest)(nil),   // 12: hotel.AvailabilityRequest
-	(*AvailabilityResponse)(nil),  // 13: hotel.AvailabilityResponse
-	(*GetRoomRequest)(nil),        // 14: hotel.GetRoomRequest
-	(*ListRoomsRequest)(nil),      // 15: hotel.ListRoomsRequest
-	(*RoomList)(nil),              // 16: hotel.RoomList
-	nil,                           // 17: hotel.Hotel.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 18: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 19: google.protobuf.Empty
+	(MealPlan)(0),                      // 0: hotel.MealPlan
+	(AdjustmentType)(0),                // 1: hotel.AdjustmentType
+	(ChangeType)(0),                    // 2: hotel.ChangeType
+	(*Hotel)(nil),                      // 3: hotel.Hotel
+	(*Room)(nil),                       // 4: hotel.Room
+	(*BedConfiguration)(nil),           // 5: hotel.BedConfiguration
+	(*RoomType)(nil),                   // 6: hotel.RoomType
+	(*PricingRule)(nil),                // 7: hotel.PricingRule
+	(*CancellationPolicy)(nil),         // 8: hotel.CancellationPolicy
+	(*RatePlan)(nil),                   // 9: hotel.RatePlan
+	(*Photo)(nil),                      // 10: hotel.Photo
+	(*CreateHotelRequest)(nil),         // 11: hotel.CreateHotelRequest
+	(*UpdateHotelRequest)(nil),         // 12: hotel.UpdateHotelRequest
+	(*DeleteHotelRequest)(nil),         // 13: hotel.DeleteHotelRequest
+	(*DeleteResponse)(nil),             // 14: hotel.DeleteResponse
+	(*GetHotelRequest)(nil),            // 15: hotel.GetHotelRequest
+	(*SearchRequest)(nil),              // 16: hotel.SearchRequest
+	(*HotelList)(nil),                  // 17: hotel.HotelList
+	(*AddRoomRequest)(nil),             // 18: hotel.AddRoomRequest
+	(*UpdateRoomRequest)(nil),          // 19: hotel.UpdateRoomRequest
+	(*DeleteRoomRequest)(nil),          // 20: hotel.DeleteRoomRequest
+	(*AvailabilityRequest)(nil),        // 21: hotel.AvailabilityRequest
+	(*AvailabilityResponse)(nil),       // 22: hotel.AvailabilityResponse
+	(*RateOffer)(nil),                  // 23: hotel.RateOffer
+	(*CreateUploadSessionRequest)(nil), // 24: hotel.CreateUploadSessionRequest
+	(*UploadSession)(nil),              // 25: hotel.UploadSession
+	(*UploadPhotoRequest)(nil),         // 26: hotel.UploadPhotoRequest
+	(*UploadPhotoResponse)(nil),        // 27: hotel.UploadPhotoResponse
+	(*AttachPhotoRequest)(nil),         // 28: hotel.AttachPhotoRequest
+	(*ReorderPhotosRequest)(nil),       // 29: hotel.ReorderPhotosRequest
+	(*PhotoList)(nil),                  // 30: hotel.PhotoList
+	(*DeletePhotoRequest)(nil),         // 31: hotel.DeletePhotoRequest
+	(*CreateRoomTypeRequest)(nil),      // 32: hotel.CreateRoomTypeRequest
+	(*GetRoomTypeRequest)(nil),         // 33: hotel.GetRoomTypeRequest
+	(*ListRoomTypesRequest)(nil),       // 34: hotel.ListRoomTypesRequest
+	(*RoomTypeList)(nil),               // 35: hotel.RoomTypeList
+	(*UpdateRoomTypeRequest)(nil),      // 36: hotel.UpdateRoomTypeRequest
+	(*DeleteRoomTypeRequest)(nil),      // 37: hotel.DeleteRoomTypeRequest
+	(*CreateRatePlanRequest)(nil),      // 38: hotel.CreateRatePlanRequest
+	(*GetRatePlanRequest)(nil),         // 39: hotel.GetRatePlanRequest
+	(*ListRatePlansRequest)(nil),       // 40: hotel.ListRatePlansRequest
+	(*RatePlanList)(nil),               // 41: hotel.RatePlanList
+	(*UpdateRatePlanRequest)(nil),      // 42: hotel.UpdateRatePlanRequest
+	(*DeleteRatePlanRequest)(nil),      // 43: hotel.DeleteRatePlanRequest
+	(*CreatePricingRuleRequest)(nil),   // 44: hotel.CreatePricingRuleRequest
+	(*GetPricingRuleRequest)(nil),      // 45: hotel.GetPricingRuleRequest
+	(*ListPricingRulesRequest)(nil),    // 46: hotel.ListPricingRulesRequest
+	(*PricingRuleList)(nil),            // 47: hotel.PricingRuleList
+	(*UpdatePricingRuleRequest)(nil),   // 48: hotel.UpdatePricingRuleRequest
+	(*DeletePricingRuleRequest)(nil),   // 49: hotel.DeletePricingRuleRequest
+	(*QuotePriceRequest)(nil),          // 50: hotel.QuotePriceRequest
+	(*NightlyPrice)(nil),               // 51: hotel.NightlyPrice
+	(*QuotePriceResponse)(nil),         // 52: hotel.QuotePriceResponse
+	(*GetRoomRequest)(nil),             // 53: hotel.GetRoomRequest
+	(*ListRoomsRequest)(nil),           // 54: hotel.ListRoomsRequest
+	(*RoomList)(nil),                   // 55: hotel.RoomList
+	(*HotelChanged)(nil),               // 56: hotel.HotelChanged
+	(*RoomChanged)(nil),                // 57: hotel.RoomChanged
+	(*CatalogEvent)(nil),               // 58: hotel.CatalogEvent
+	(*WatchCatalogRequest)(nil),        // 59: hotel.WatchCatalogRequest
+	nil,                                // 60: hotel.Hotel.MetadataEntry
+	(*timestamppb.Timestamp)(nil),      // 61: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 62: google.protobuf.Empty
 }
 var file_proto_hotel_proto_depIdxs = []int32{
-	1,  // 0: hotel.Hotel.rooms:type_name -> hotel.Room
-	17, // 1: hotel.Hotel.metadata:type_name -> hotel.Hotel.MetadataEntry
-	0,  // 2: hotel.HotelList.hotels:type_name -> hotel.Hotel
-	18, // 3: hotel.AvailabilityRequest.start_date:type_name -> google.protobuf.Timestamp
-	18, // 4: hotel.AvailabilityRequest.end_date:type_name -> google.protobuf.Timestamp
-	1,  // 5: hotel.AvailabilityResponse.available_rooms:type_name -> hotel.Room
-	1,  // 6: hotel.RoomList.rooms:type_name -> hotel.Room
-	2,  // 7: hotel.HotelService.CreateHotel:input_type -> hotel.CreateHotelRequest
-	3,  // 8: hotel.HotelService.UpdateHotel:input_type -> hotel.UpdateHotelRequest
-	4,  // 9: hotel.HotelService.DeleteHotel:input_type -> hotel.DeleteHotelRequest
-	6,  // 10: hotel.HotelService.GetHotel:input_type -> hotel.GetHotelRequest
-	7,  // 11: hotel.HotelService.SearchHotels:input_type -> hotel.SearchRequest
-	19, // 12: hotel.HotelService.ListHotels:input_type -> google.protobuf.Empty
-	15, // 13: hotel.HotelService.ListRooms:input_type -> hotel.ListRoomsRequest
-	14, // 14: hotel.HotelService.GetRoom:input_type -> hotel.GetRoomRequest
-	9,  // 15: hotel.HotelService.AddRoom:input_type -> hotel.AddRoomRequest
-	10, // 16: hotel.HotelService.UpdateRoom:input_type -> hotel.UpdateRoomRequest
-	11, // 17: hotel.HotelService.DeleteRoom:input_type -> hotel.DeleteRoomRequest
-	12, // 18: hotel.HotelService.CheckAvailability:input_type -> hotel.AvailabilityRequest
-	0,  // 19: hotel.HotelService.CreateHotel:output_type -> hotel.Hotel
-	0,  // 20: hotel.HotelService.UpdateHotel:output_type -> hotel.Hotel
-	5,  // 21: hotel.HotelService.DeleteHotel:output_type -> hotel.DeleteResponse
-	0,  // 22: hotel.HotelService.GetHotel:output_type -> hotel.Hotel
-	8,  // 23: hotel.HotelService.SearchHotels:output_type -> hotel.HotelList
-	8,  // 24: hotel.HotelService.ListHotels:output_type -> hotel.HotelList
-	16, // 25: hotel.HotelService.ListRooms:output_type -> hotel.RoomList
-	1,  // 26: hotel.HotelService.GetRoom:output_type -> hotel.Room
-	1,  // 27: hotel.HotelService.AddRoom:output_type -> hotel.Room
-	1,  // 28: hotel.HotelService.UpdateRoom:output_type -> hotel.Room
-	5,  // 29: hotel.HotelService.DeleteRoom:output_type -> hotel.DeleteResponse
-	13, // 30: hotel.HotelService.CheckAvailability:output_type -> hotel.AvailabilityResponse
-	19, // [19:31] is the sub-list for method output_type
-	7,  // [7:19] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	4,  // 0: hotel.Hotel.rooms:type_name -> hotel.Room
+	10, // 1: hotel.Hotel.photos:type_name -> hotel.Photo
+	60, // 2: hotel.Hotel.metadata:type_name -> hotel.Hotel.MetadataEntry
+	10, // 3: hotel.Room.photos:type_name -> hotel.Photo
+	5,  // 4: hotel.RoomType.beds:type_name -> hotel.BedConfiguration
+	61, // 5: hotel.PricingRule.start_date:type_name -> google.protobuf.Timestamp
+	61, // 6: hotel.PricingRule.end_date:type_name -> google.protobuf.Timestamp
+	1,  // 7: hotel.PricingRule.adjustment_type:type_name -> hotel.AdjustmentType
+	0,  // 8: hotel.RatePlan.meal_plan:type_name -> hotel.MealPlan
+	8,  // 9: hotel.RatePlan.cancellation_policy:type_name -> hotel.CancellationPolicy
+	3,  // 10: hotel.HotelList.hotels:type_name -> hotel.Hotel
+	61, // 11: hotel.AvailabilityRequest.start_date:type_name -> google.protobuf.Timestamp
+	61, // 12: hotel.AvailabilityRequest.end_date:type_name -> google.protobuf.Timestamp
+	4,  // 13: hotel.AvailabilityResponse.available_rooms:type_name -> hotel.Room
+	23, // 14: hotel.AvailabilityResponse.offers:type_name -> hotel.RateOffer
+	61, // 15: hotel.UploadSession.expires_at:type_name -> google.protobuf.Timestamp
+	10, // 16: hotel.PhotoList.photos:type_name -> hotel.Photo
+	6,  // 17: hotel.CreateRoomTypeRequest.room_type:type_name -> hotel.RoomType
+	6,  // 18: hotel.RoomTypeList.room_types:type_name -> hotel.RoomType
+	6,  // 19: hotel.UpdateRoomTypeRequest.room_type:type_name -> hotel.RoomType
+	9,  // 20: hotel.CreateRatePlanRequest.rate_plan:type_name -> hotel.RatePlan
+	9,  // 21: hotel.RatePlanList.rate_plans:type_name -> hotel.RatePlan
+	9,  // 22: hotel.UpdateRatePlanRequest.rate_plan:type_name -> hotel.RatePlan
+	7,  // 23: hotel.CreatePricingRuleRequest.pricing_rule:type_name -> hotel.PricingRule
+	7,  // 24: hotel.PricingRuleList.pricing_rules:type_name -> hotel.PricingRule
+	7,  // 25: hotel.UpdatePricingRuleRequest.pricing_rule:type_name -> hotel.PricingRule
+	61, // 26: hotel.QuotePriceRequest.start_date:type_name -> google.protobuf.Timestamp
+	61, // 27: hotel.QuotePriceRequest.end_date:type_name -> google.protobuf.Timestamp
+	61, // 28: hotel.NightlyPrice.date:type_name -> google.protobuf.Timestamp
+	51, // 29: hotel.QuotePriceResponse.nights:type_name -> hotel.NightlyPrice
+	61, // 30: hotel.QuotePriceResponse.quoted_at:type_name -> google.protobuf.Timestamp
+	4,  // 31: hotel.RoomList.rooms:type_name -> hotel.Room
+	2,  // 32: hotel.HotelChanged.change:type_name -> hotel.ChangeType
+	3,  // 33: hotel.HotelChanged.before:type_name -> hotel.Hotel
+	3,  // 34: hotel.HotelChanged.after:type_name -> hotel.Hotel
+	2,  // 35: hotel.RoomChanged.change:type_name -> hotel.ChangeType
+	4,  // 36: hotel.RoomChanged.before:type_name -> hotel.Room
+	4,  // 37: hotel.RoomChanged.after:type_name -> hotel.Room
+	61, // 38: hotel.CatalogEvent.time:type_name -> google.protobuf.Timestamp
+	56, // 39: hotel.CatalogEvent.hotel:type_name -> hotel.HotelChanged
+	57, // 40: hotel.CatalogEvent.room:type_name -> hotel.RoomChanged
+	11, // 41: hotel.HotelService.CreateHotel:input_type -> hotel.CreateHotelRequest
+	12, // 42: hotel.HotelService.UpdateHotel:input_type -> hotel.UpdateHotelRequest
+	13, // 43: hotel.HotelService.DeleteHotel:input_type -> hotel.DeleteHotelRequest
+	15, // 44: hotel.HotelService.GetHotel:input_type -> hotel.GetHotelRequest
+	16, // 45: hotel.HotelService.SearchHotels:input_type -> hotel.SearchRequest
+	62, // 46: hotel.HotelService.ListHotels:input_type -> google.protobuf.Empty
+	54, // 47: hotel.HotelService.ListRooms:input_type -> hotel.ListRoomsRequest
+	53, // 48: hotel.HotelService.GetRoom:input_type -> hotel.GetRoomRequest
+	18, // 49: hotel.HotelService.AddRoom:input_type -> hotel.AddRoomRequest
+	19, // 50: hotel.HotelService.UpdateRoom:input_type -> hotel.UpdateRoomRequest
+	20, // 51: hotel.HotelService.DeleteRoom:input_type -> hotel.DeleteRoomRequest
+	32, // 52: hotel.HotelService.CreateRoomType:input_type -> hotel.CreateRoomTypeRequest
+	33, // 53: hotel.HotelService.GetRoomType:input_type -> hotel.GetRoomTypeRequest
+	34, // 54: hotel.HotelService.ListRoomTypes:input_type -> hotel.ListRoomTypesRequest
+	36, // 55: hotel.HotelService.UpdateRoomType:input_type -> hotel.UpdateRoomTypeRequest
+	37, // 56: hotel.HotelService.DeleteRoomType:input_type -> hotel.DeleteRoomTypeRequest
+	38, // 57: hotel.HotelService.CreateRatePlan:input_type -> hotel.CreateRatePlanRequest
+	39, // 58: hotel.HotelService.GetRatePlan:input_type -> hotel.GetRatePlanRequest
+	40, // 59: hotel.HotelService.ListRatePlans:input_type -> hotel.ListRatePlansRequest
+	42, // 60: hotel.HotelService.UpdateRatePlan:input_type -> hotel.UpdateRatePlanRequest
+	43, // 61: hotel.HotelService.DeleteRatePlan:input_type -> hotel.DeleteRatePlanRequest
+	44, // 62: hotel.HotelService.CreatePricingRule:input_type -> hotel.CreatePricingRuleRequest
+	45, // 63: hotel.HotelService.GetPricingRule:input_type -> hotel.GetPricingRuleRequest
+	46, // 64: hotel.HotelService.ListPricingRules:input_type -> hotel.ListPricingRulesRequest
+	48, // 65: hotel.HotelService.UpdatePricingRule:input_type -> hotel.UpdatePricingRuleRequest
+	49, // 66: hotel.HotelService.DeletePricingRule:input_type -> hotel.DeletePricingRuleRequest
+	50, // 67: hotel.HotelService.QuotePrice:input_type -> hotel.QuotePriceRequest
+	21, // 68: hotel.HotelService.CheckAvailability:input_type -> hotel.AvailabilityRequest
+	24, // 69: hotel.HotelService.CreateUploadSession:input_type -> hotel.CreateUploadSessionRequest
+	26, // 70: hotel.HotelService.UploadPhoto:input_type -> hotel.UploadPhotoRequest
+	28, // 71: hotel.HotelService.AttachPhoto:input_type -> hotel.AttachPhotoRequest
+	29, // 72: hotel.HotelService.ReorderPhotos:input_type -> hotel.ReorderPhotosRequest
+	31, // 73: hotel.HotelService.DeletePhoto:input_type -> hotel.DeletePhotoRequest
+	59, // 74: hotel.HotelService.WatchCatalog:input_type -> hotel.WatchCatalogRequest
+	3,  // 75: hotel.HotelService.CreateHotel:output_type -> hotel.Hotel
+	3,  // 76: hotel.HotelService.UpdateHotel:output_type -> hotel.Hotel
+	14, // 77: hotel.HotelService.DeleteHotel:output_type -> hotel.DeleteResponse
+	3,  // 78: hotel.HotelService.GetHotel:output_type -> hotel.Hotel
+	17, // 79: hotel.HotelService.SearchHotels:output_type -> hotel.HotelList
+	17, // 80: hotel.HotelService.ListHotels:output_type -> hotel.HotelList
+	55, // 81: hotel.HotelService.ListRooms:output_type -> hotel.RoomList
+	4,  // 82: hotel.HotelService.GetRoom:output_type -> hotel.Room
+	4,  // 83: hotel.HotelService.AddRoom:output_type -> hotel.Room
+	4,  // 84: hotel.HotelService.UpdateRoom:output_type -> hotel.Room
+	14, // 85: hotel.HotelService.DeleteRoom:output_type -> hotel.DeleteResponse
+	6,  // 86: hotel.HotelService.CreateRoomType:output_type -> hotel.RoomType
+	6,  // 87: hotel.HotelService.GetRoomType:output_type -> hotel.RoomType
+	35, // 88: hotel.HotelService.ListRoomTypes:output_type -> hotel.RoomTypeList
+	6,  // 89: hotel.HotelService.UpdateRoomType:output_type -> hotel.RoomType
+	14, // 90: hotel.HotelService.DeleteRoomType:output_type -> hotel.DeleteResponse
+	9,  // 91: hotel.HotelService.CreateRatePlan:output_type -> hotel.RatePlan
+	9,  // 92: hotel.HotelService.GetRatePlan:output_type -> hotel.RatePlan
+	41, // 93: hotel.HotelService.ListRatePlans:output_type -> hotel.RatePlanList
+	9,  // 94: hotel.HotelService.UpdateRatePlan:output_type -> hotel.RatePlan
+	14, // 95: hotel.HotelService.DeleteRatePlan:output_type -> hotel.DeleteResponse
+	7,  // 96: hotel.HotelService.CreatePricingRule:output_type -> hotel.PricingRule
+	7,  // 97: hotel.HotelService.GetPricingRule:output_type -> hotel.PricingRule
+	47, // 98: hotel.HotelService.ListPricingRules:output_type -> hotel.PricingRuleList
+	7,  // 99: hotel.HotelService.UpdatePricingRule:output_type -> hotel.PricingRule
+	14, // 100: hotel.HotelService.DeletePricingRule:output_type -> hotel.DeleteResponse
+	52, // 101: hotel.HotelService.QuotePrice:output_type -> hotel.QuotePriceResponse
+	22, // 102: hotel.HotelService.CheckAvailability:output_type -> hotel.AvailabilityResponse
+	25, // 103: hotel.HotelService.CreateUploadSession:output_type -> hotel.UploadSession
+	27, // 104: hotel.HotelService.UploadPhoto:output_type -> hotel.UploadPhotoResponse
+	10, // 105: hotel.HotelService.AttachPhoto:output_type -> hotel.Photo
+	30, // 106: hotel.HotelService.ReorderPhotos:output_type -> hotel.PhotoList
+	14, // 107: hotel.HotelService.DeletePhoto:output_type -> hotel.DeleteResponse
+	58, // 108: hotel.HotelService.WatchCatalog:output_type -> hotel.CatalogEvent
+	75, // [75:109] is the sub-list for method output_type
+	41, // [41:75] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_proto_hotel_proto_init() }
@@ -1199,18 +4398,23 @@ func file_proto_hotel_proto_init() {
 	if File_proto_hotel_proto != nil {
 		return
 	}
+	file_proto_hotel_proto_msgTypes[55].OneofWrappers = []any{
+		(*CatalogEvent_Hotel)(nil),
+		(*CatalogEvent_Room)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_hotel_proto_rawDesc), len(file_proto_hotel_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   18,
+			NumEnums:      3,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_hotel_proto_goTypes,
 		DependencyIndexes: file_proto_hotel_proto_depIdxs,
+		EnumInfos:         file_proto_hotel_proto_enumTypes,
 		MessageInfos:      file_proto_hotel_proto_msgTypes,
 	}.Build()
 	File_proto_hotel_proto = out.File
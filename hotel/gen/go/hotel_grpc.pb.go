@@ -20,18 +20,40 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	HotelService_CreateHotel_FullMethodName       = "/hotel.HotelService/CreateHotel"
-	HotelService_UpdateHotel_FullMethodName       = "/hotel.HotelService/UpdateHotel"
-	HotelService_DeleteHotel_FullMethodName       = "/hotel.HotelService/DeleteHotel"
-	HotelService_GetHotel_FullMethodName          = "/hotel.HotelService/GetHotel"
-	HotelService_SearchHotels_FullMethodName      = "/hotel.HotelService/SearchHotels"
-	HotelService_ListHotels_FullMethodName        = "/hotel.HotelService/ListHotels"
-	HotelService_ListRooms_FullMethodName         = "/hotel.HotelService/ListRooms"
-	HotelService_GetRoom_FullMethodName           = "/hotel.HotelService/GetRoom"
-	HotelService_AddRoom_FullMethodName           = "/hotel.HotelService/AddRoom"
-	HotelService_UpdateRoom_FullMethodName        = "/hotel.HotelService/UpdateRoom"
-	HotelService_DeleteRoom_FullMethodName        = "/hotel.HotelService/DeleteRoom"
-	HotelService_CheckAvailability_FullMethodName = "/hotel.HotelService/CheckAvailability"
+	HotelService_CreateHotel_FullMethodName         = "/hotel.HotelService/CreateHotel"
+	HotelService_UpdateHotel_FullMethodName         = "/hotel.HotelService/UpdateHotel"
+	HotelService_DeleteHotel_FullMethodName         = "/hotel.HotelService/DeleteHotel"
+	HotelService_GetHotel_FullMethodName            = "/hotel.HotelService/GetHotel"
+	HotelService_SearchHotels_FullMethodName        = "/hotel.HotelService/SearchHotels"
+	HotelService_ListHotels_FullMethodName          = "/hotel.HotelService/ListHotels"
+	HotelService_ListRooms_FullMethodName           = "/hotel.HotelService/ListRooms"
+	HotelService_GetRoom_FullMethodName             = "/hotel.HotelService/GetRoom"
+	HotelService_AddRoom_FullMethodName             = "/hotel.HotelService/AddRoom"
+	HotelService_UpdateRoom_FullMethodName          = "/hotel.HotelService/UpdateRoom"
+	HotelService_DeleteRoom_FullMethodName          = "/hotel.HotelService/DeleteRoom"
+	HotelService_CreateRoomType_FullMethodName      = "/hotel.HotelService/CreateRoomType"
+	HotelService_GetRoomType_FullMethodName         = "/hotel.HotelService/GetRoomType"
+	HotelService_ListRoomTypes_FullMethodName       = "/hotel.HotelService/ListRoomTypes"
+	HotelService_UpdateRoomType_FullMethodName      = "/hotel.HotelService/UpdateRoomType"
+	HotelService_DeleteRoomType_FullMethodName      = "/hotel.HotelService/DeleteRoomType"
+	HotelService_CreateRatePlan_FullMethodName      = "/hotel.HotelService/CreateRatePlan"
+	HotelService_GetRatePlan_FullMethodName         = "/hotel.HotelService/GetRatePlan"
+	HotelService_ListRatePlans_FullMethodName       = "/hotel.HotelService/ListRatePlans"
+	HotelService_UpdateRatePlan_FullMethodName      = "/hotel.HotelService/UpdateRatePlan"
+	HotelService_DeleteRatePlan_FullMethodName      = "/hotel.HotelService/DeleteRatePlan"
+	HotelService_CreatePricingRule_FullMethodName   = "/hotel.HotelService/CreatePricingRule"
+	HotelService_GetPricingRule_FullMethodName      = "/hotel.HotelService/GetPricingRule"
+	HotelService_ListPricingRules_FullMethodName    = "/hotel.HotelService/ListPricingRules"
+	HotelService_UpdatePricingRule_FullMethodName   = "/hotel.HotelService/UpdatePricingRule"
+	HotelService_DeletePricingRule_FullMethodName   = "/hotel.HotelService/DeletePricingRule"
+	HotelService_QuotePrice_FullMethodName          = "/hotel.HotelService/QuotePrice"
+	HotelService_CheckAvailability_FullMethodName   = "/hotel.HotelService/CheckAvailability"
+	HotelService_CreateUploadSession_FullMethodName = "/hotel.HotelService/CreateUploadSession"
+	HotelService_UploadPhoto_FullMethodName         = "/hotel.HotelService/UploadPhoto"
+	HotelService_AttachPhoto_FullMethodName         = "/hotel.HotelService/AttachPhoto"
+	HotelService_ReorderPhotos_FullMethodName       = "/hotel.HotelService/ReorderPhotos"
+	HotelService_DeletePhoto_FullMethodName         = "/hotel.HotelService/DeletePhoto"
+	HotelService_WatchCatalog_FullMethodName        = "/hotel.HotelService/WatchCatalog"
 )
 
 // HotelServiceClient is the client API for HotelService service.
@@ -49,7 +71,31 @@ type HotelServiceClient interface {
 	AddRoom(ctx context.Context, in *AddRoomRequest, opts ...grpc.CallOption) (*Room, error)
 	UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*Room, error)
 	DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	CreateRoomType(ctx context.Context, in *CreateRoomTypeRequest, opts ...grpc.CallOption) (*RoomType, error)
+	GetRoomType(ctx context.Context, in *GetRoomTypeRequest, opts ...grpc.CallOption) (*RoomType, error)
+	ListRoomTypes(ctx context.Context, in *ListRoomTypesRequest, opts ...grpc.CallOption) (*RoomTypeList, error)
+	UpdateRoomType(ctx context.Context, in *UpdateRoomTypeRequest, opts ...grpc.CallOption) (*RoomType, error)
+	DeleteRoomType(ctx context.Context, in *DeleteRoomTypeRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	CreateRatePlan(ctx context.Context, in *CreateRatePlanRequest, opts ...grpc.CallOption) (*RatePlan, error)
+	GetRatePlan(ctx context.Context, in *GetRatePlanRequest, opts ...grpc.CallOption) (*RatePlan, error)
+	ListRatePlans(ctx context.Context, in *ListRatePlansRequest, opts ...grpc.CallOption) (*RatePlanList, error)
+	UpdateRatePlan(ctx context.Context, in *UpdateRatePlanRequest, opts ...grpc.CallOption) (*RatePlan, error)
+	DeleteRatePlan(ctx context.Context, in *DeleteRatePlanRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	CreatePricingRule(ctx context.Context, in *CreatePricingRuleRequest, opts ...grpc.CallOption) (*PricingRule, error)
+	GetPricingRule(ctx context.Context, in *GetPricingRuleRequest, opts ...grpc.CallOption) (*PricingRule, error)
+	ListPricingRules(ctx context.Context, in *ListPricingRulesRequest, opts ...grpc.CallOption) (*PricingRuleList, error)
+	UpdatePricingRule(ctx context.Context, in *UpdatePricingRuleRequest, opts ...grpc.CallOption) (*PricingRule, error)
+	DeletePricingRule(ctx context.Context, in *DeletePricingRuleRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	QuotePrice(ctx context.Context, in *QuotePriceRequest, opts ...grpc.CallOption) (*QuotePriceResponse, error)
 	CheckAvailability(ctx context.Context, in *AvailabilityRequest, opts ...grpc.CallOption) (*AvailabilityResponse, error)
+	CreateUploadSession(ctx context.Context, in *CreateUploadSessionRequest, opts ...grpc.CallOption) (*UploadSession, error)
+	// UploadPhoto streams the bytes of an upload session in order. The first
+	// message must carry upload_id. Only available over gRPC.
+	UploadPhoto(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadPhotoRequest, UploadPhotoResponse], error)
+	AttachPhoto(ctx context.Context, in *AttachPhotoRequest, opts ...grpc.CallOption) (*Photo, error)
+	ReorderPhotos(ctx context.Context, in *ReorderPhotosRequest, opts ...grpc.CallOption) (*PhotoList, error)
+	DeletePhoto(ctx context.Context, in *DeletePhotoRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	WatchCatalog(ctx context.Context, in *WatchCatalogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CatalogEvent], error)
 }
 
 type hotelServiceClient struct {
@@ -170,6 +216,166 @@ func (c *hotelServiceClient) DeleteRoom(ctx context.Context, in *DeleteRoomReque
 	return out, nil
 }
 
+func (c *hotelServiceClient) CreateRoomType(ctx context.Context, in *CreateRoomTypeRequest, opts ...grpc.CallOption) (*RoomType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoomType)
+	err := c.cc.Invoke(ctx, HotelService_CreateRoomType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) GetRoomType(ctx context.Context, in *GetRoomTypeRequest, opts ...grpc.CallOption) (*RoomType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoomType)
+	err := c.cc.Invoke(ctx, HotelService_GetRoomType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) ListRoomTypes(ctx context.Context, in *ListRoomTypesRequest, opts ...grpc.CallOption) (*RoomTypeList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoomTypeList)
+	err := c.cc.Invoke(ctx, HotelService_ListRoomTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) UpdateRoomType(ctx context.Context, in *UpdateRoomTypeRequest, opts ...grpc.CallOption) (*RoomType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoomType)
+	err := c.cc.Invoke(ctx, HotelService_UpdateRoomType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) DeleteRoomType(ctx context.Context, in *DeleteRoomTypeRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, HotelService_DeleteRoomType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) CreateRatePlan(ctx context.Context, in *CreateRatePlanRequest, opts ...grpc.CallOption) (*RatePlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RatePlan)
+	err := c.cc.Invoke(ctx, HotelService_CreateRatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) GetRatePlan(ctx context.Context, in *GetRatePlanRequest, opts ...grpc.CallOption) (*RatePlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RatePlan)
+	err := c.cc.Invoke(ctx, HotelService_GetRatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) ListRatePlans(ctx context.Context, in *ListRatePlansRequest, opts ...grpc.CallOption) (*RatePlanList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RatePlanList)
+	err := c.cc.Invoke(ctx, HotelService_ListRatePlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) UpdateRatePlan(ctx context.Context, in *UpdateRatePlanRequest, opts ...grpc.CallOption) (*RatePlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RatePlan)
+	err := c.cc.Invoke(ctx, HotelService_UpdateRatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) DeleteRatePlan(ctx context.Context, in *DeleteRatePlanRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, HotelService_DeleteRatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) CreatePricingRule(ctx context.Context, in *CreatePricingRuleRequest, opts ...grpc.CallOption) (*PricingRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PricingRule)
+	err := c.cc.Invoke(ctx, HotelService_CreatePricingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) GetPricingRule(ctx context.Context, in *GetPricingRuleRequest, opts ...grpc.CallOption) (*PricingRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PricingRule)
+	err := c.cc.Invoke(ctx, HotelService_GetPricingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) ListPricingRules(ctx context.Context, in *ListPricingRulesRequest, opts ...grpc.CallOption) (*PricingRuleList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PricingRuleList)
+	err := c.cc.Invoke(ctx, HotelService_ListPricingRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) UpdatePricingRule(ctx context.Context, in *UpdatePricingRuleRequest, opts ...grpc.CallOption) (*PricingRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PricingRule)
+	err := c.cc.Invoke(ctx, HotelService_UpdatePricingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) DeletePricingRule(ctx context.Context, in *DeletePricingRuleRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, HotelService_DeletePricingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) QuotePrice(ctx context.Context, in *QuotePriceRequest, opts ...grpc.CallOption) (*QuotePriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotePriceResponse)
+	err := c.cc.Invoke(ctx, HotelService_QuotePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *hotelServiceClient) CheckAvailability(ctx context.Context, in *AvailabilityRequest, opts ...grpc.CallOption) (*AvailabilityResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AvailabilityResponse)
@@ -180,6 +386,78 @@ func (c *hotelServiceClient) CheckAvailability(ctx context.Context, in *Availabi
 	return out, nil
 }
 
+func (c *hotelServiceClient) CreateUploadSession(ctx context.Context, in *CreateUploadSessionRequest, opts ...grpc.CallOption) (*UploadSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadSession)
+	err := c.cc.Invoke(ctx, HotelService_CreateUploadSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) UploadPhoto(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadPhotoRequest, UploadPhotoResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HotelService_ServiceDesc.Streams[0], HotelService_UploadPhoto_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadPhotoRequest, UploadPhotoResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HotelService_UploadPhotoClient = grpc.ClientStreamingClient[UploadPhotoRequest, UploadPhotoResponse]
+
+func (c *hotelServiceClient) AttachPhoto(ctx context.Context, in *AttachPhotoRequest, opts ...grpc.CallOption) (*Photo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Photo)
+	err := c.cc.Invoke(ctx, HotelService_AttachPhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) ReorderPhotos(ctx context.Context, in *ReorderPhotosRequest, opts ...grpc.CallOption) (*PhotoList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PhotoList)
+	err := c.cc.Invoke(ctx, HotelService_ReorderPhotos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) DeletePhoto(ctx context.Context, in *DeletePhotoRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, HotelService_DeletePhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) WatchCatalog(ctx context.Context, in *WatchCatalogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CatalogEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HotelService_ServiceDesc.Streams[1], HotelService_WatchCatalog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchCatalogRequest, CatalogEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HotelService_WatchCatalogClient = grpc.ServerStreamingClient[CatalogEvent]
+
 // HotelServiceServer is the server API for HotelService service.
 // All implementations must embed UnimplementedHotelServiceServer
 // for forward compatibility.
@@ -195,7 +473,31 @@ type HotelServiceServer interface {
 	AddRoom(context.Context, *AddRoomRequest) (*Room, error)
 	UpdateRoom(context.Context, *UpdateRoomRequest) (*Room, error)
 	DeleteRoom(context.Context, *DeleteRoomRequest) (*DeleteResponse, error)
+	CreateRoomType(context.Context, *CreateRoomTypeRequest) (*RoomType, error)
+	GetRoomType(context.Context, *GetRoomTypeRequest) (*RoomType, error)
+	ListRoomTypes(context.Context, *ListRoomTypesRequest) (*RoomTypeList, error)
+	UpdateRoomType(context.Context, *UpdateRoomTypeRequest) (*RoomType, error)
+	DeleteRoomType(context.Context, *DeleteRoomTypeRequest) (*DeleteResponse, error)
+	CreateRatePlan(context.Context, *CreateRatePlanRequest) (*RatePlan, error)
+	GetRatePlan(context.Context, *GetRatePlanRequest) (*RatePlan, error)
+	ListRatePlans(context.Context, *ListRatePlansRequest) (*RatePlanList, error)
+	UpdateRatePlan(context.Context, *UpdateRatePlanRequest) (*RatePlan, error)
+	DeleteRatePlan(context.Context, *DeleteRatePlanRequest) (*DeleteResponse, error)
+	CreatePricingRule(context.Context, *CreatePricingRuleRequest) (*PricingRule, error)
+	GetPricingRule(context.Context, *GetPricingRuleRequest) (*PricingRule, error)
+	ListPricingRules(context.Context, *ListPricingRulesRequest) (*PricingRuleList, error)
+	UpdatePricingRule(context.Context, *UpdatePricingRuleRequest) (*PricingRule, error)
+	DeletePricingRule(context.Context, *DeletePricingRuleRequest) (*DeleteResponse, error)
+	QuotePrice(context.Context, *QuotePriceRequest) (*QuotePriceResponse, error)
 	CheckAvailability(context.Context, *AvailabilityRequest) (*AvailabilityResponse, error)
+	CreateUploadSession(context.Context, *CreateUploadSessionRequest) (*UploadSession, error)
+	// UploadPhoto streams the bytes of an upload session in order. The first
+	// message must carry upload_id. Only available over gRPC.
+	UploadPhoto(grpc.ClientStreamingServer[UploadPhotoRequest, UploadPhotoResponse]) error
+	AttachPhoto(context.Context, *AttachPhotoRequest) (*Photo, error)
+	ReorderPhotos(context.Context, *ReorderPhotosRequest) (*PhotoList, error)
+	DeletePhoto(context.Context, *DeletePhotoRequest) (*DeleteResponse, error)
+	WatchCatalog(*WatchCatalogRequest, grpc.ServerStreamingServer[CatalogEvent]) error
 	mustEmbedUnimplementedHotelServiceServer()
 }
 
@@ -239,9 +541,75 @@ func (UnimplementedHotelServiceServer) UpdateRoom(context.Context, *UpdateRoomRe
 func (UnimplementedHotelServiceServer) DeleteRoom(context.Context, *DeleteRoomRequest) (*DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoom not implemented")
 }
+func (UnimplementedHotelServiceServer) CreateRoomType(context.Context, *CreateRoomTypeRequest) (*RoomType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoomType not implemented")
+}
+func (UnimplementedHotelServiceServer) GetRoomType(context.Context, *GetRoomTypeRequest) (*RoomType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoomType not implemented")
+}
+func (UnimplementedHotelServiceServer) ListRoomTypes(context.Context, *ListRoomTypesRequest) (*RoomTypeList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoomTypes not implemented")
+}
+func (UnimplementedHotelServiceServer) UpdateRoomType(context.Context, *UpdateRoomTypeRequest) (*RoomType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoomType not implemented")
+}
+func (UnimplementedHotelServiceServer) DeleteRoomType(context.Context, *DeleteRoomTypeRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoomType not implemented")
+}
+func (UnimplementedHotelServiceServer) CreateRatePlan(context.Context, *CreateRatePlanRequest) (*RatePlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRatePlan not implemented")
+}
+func (UnimplementedHotelServiceServer) GetRatePlan(context.Context, *GetRatePlanRequest) (*RatePlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatePlan not implemented")
+}
+func (UnimplementedHotelServiceServer) ListRatePlans(context.Context, *ListRatePlansRequest) (*RatePlanList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRatePlans not implemented")
+}
+func (UnimplementedHotelServiceServer) UpdateRatePlan(context.Context, *UpdateRatePlanRequest) (*RatePlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRatePlan not implemented")
+}
+func (UnimplementedHotelServiceServer) DeleteRatePlan(context.Context, *DeleteRatePlanRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRatePlan not implemented")
+}
+func (UnimplementedHotelServiceServer) CreatePricingRule(context.Context, *CreatePricingRuleRequest) (*PricingRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePricingRule not implemented")
+}
+func (UnimplementedHotelServiceServer) GetPricingRule(context.Context, *GetPricingRuleRequest) (*PricingRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPricingRule not implemented")
+}
+func (UnimplementedHotelServiceServer) ListPricingRules(context.Context, *ListPricingRulesRequest) (*PricingRuleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPricingRules not implemented")
+}
+func (UnimplementedHotelServiceServer) UpdatePricingRule(context.Context, *UpdatePricingRuleRequest) (*PricingRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePricingRule not implemented")
+}
+func (UnimplementedHotelServiceServer) DeletePricingRule(context.Context, *DeletePricingRuleRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePricingRule not implemented")
+}
+func (UnimplementedHotelServiceServer) QuotePrice(context.Context, *QuotePriceRequest) (*QuotePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotePrice not implemented")
+}
 func (UnimplementedHotelServiceServer) CheckAvailability(context.Context, *AvailabilityRequest) (*AvailabilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckAvailability not implemented")
 }
+func (UnimplementedHotelServiceServer) CreateUploadSession(context.Context, *CreateUploadSessionRequest) (*UploadSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUploadSession not implemented")
+}
+func (UnimplementedHotelServiceServer) UploadPhoto(grpc.ClientStreamingServer[UploadPhotoRequest, UploadPhotoResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadPhoto not implemented")
+}
+func (UnimplementedHotelServiceServer) AttachPhoto(context.Context, *AttachPhotoRequest) (*Photo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachPhoto not implemented")
+}
+func (UnimplementedHotelServiceServer) ReorderPhotos(context.Context, *ReorderPhotosRequest) (*PhotoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderPhotos not implemented")
+}
+func (UnimplementedHotelServiceServer) DeletePhoto(context.Context, *DeletePhotoRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePhoto not implemented")
+}
+func (UnimplementedHotelServiceServer) WatchCatalog(*WatchCatalogRequest, grpc.ServerStreamingServer[CatalogEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCatalog not implemented")
+}
 func (UnimplementedHotelServiceServer) mustEmbedUnimplementedHotelServiceServer() {}
 func (UnimplementedHotelServiceServer) testEmbeddedByValue()                      {}
 
@@ -461,6 +829,294 @@ func _HotelService_DeleteRoom_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HotelService_CreateRoomType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).CreateRoomType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_CreateRoomType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).CreateRoomType(ctx, req.(*CreateRoomTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_GetRoomType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).GetRoomType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_GetRoomType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).GetRoomType(ctx, req.(*GetRoomTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_ListRoomTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoomTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).ListRoomTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_ListRoomTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).ListRoomTypes(ctx, req.(*ListRoomTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_UpdateRoomType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoomTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).UpdateRoomType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_UpdateRoomType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).UpdateRoomType(ctx, req.(*UpdateRoomTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_DeleteRoomType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoomTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).DeleteRoomType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_DeleteRoomType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).DeleteRoomType(ctx, req.(*DeleteRoomTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_CreateRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).CreateRatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_CreateRatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).CreateRatePlan(ctx, req.(*CreateRatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_GetRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).GetRatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_GetRatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).GetRatePlan(ctx, req.(*GetRatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_ListRatePlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRatePlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).ListRatePlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_ListRatePlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).ListRatePlans(ctx, req.(*ListRatePlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_UpdateRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).UpdateRatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_UpdateRatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).UpdateRatePlan(ctx, req.(*UpdateRatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_DeleteRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).DeleteRatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_DeleteRatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).DeleteRatePlan(ctx, req.(*DeleteRatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_CreatePricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePricingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).CreatePricingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_CreatePricingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).CreatePricingRule(ctx, req.(*CreatePricingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_GetPricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPricingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).GetPricingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_GetPricingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).GetPricingRule(ctx, req.(*GetPricingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_ListPricingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPricingRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).ListPricingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_ListPricingRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).ListPricingRules(ctx, req.(*ListPricingRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_UpdatePricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePricingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).UpdatePricingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_UpdatePricingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).UpdatePricingRule(ctx, req.(*UpdatePricingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_DeletePricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePricingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).DeletePricingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_DeletePricingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).DeletePricingRule(ctx, req.(*DeletePricingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_QuotePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).QuotePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_QuotePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).QuotePrice(ctx, req.(*QuotePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HotelService_CheckAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AvailabilityRequest)
 	if err := dec(in); err != nil {
@@ -479,6 +1135,96 @@ func _HotelService_CheckAvailability_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HotelService_CreateUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUploadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).CreateUploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_CreateUploadSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).CreateUploadSession(ctx, req.(*CreateUploadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_UploadPhoto_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(HotelServiceServer).UploadPhoto(&grpc.GenericServerStream[UploadPhotoRequest, UploadPhotoResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HotelService_UploadPhotoServer = grpc.ClientStreamingServer[UploadPhotoRequest, UploadPhotoResponse]
+
+func _HotelService_AttachPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachPhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).AttachPhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_AttachPhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).AttachPhoto(ctx, req.(*AttachPhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_ReorderPhotos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderPhotosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).ReorderPhotos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_ReorderPhotos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).ReorderPhotos(ctx, req.(*ReorderPhotosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_DeletePhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).DeletePhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_DeletePhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).DeletePhoto(ctx, req.(*DeletePhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_WatchCatalog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCatalogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HotelServiceServer).WatchCatalog(m, &grpc.GenericServerStream[WatchCatalogRequest, CatalogEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HotelService_WatchCatalogServer = grpc.ServerStreamingServer[CatalogEvent]
+
 // HotelService_ServiceDesc is the grpc.ServiceDesc for HotelService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -530,11 +1276,102 @@ var HotelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRoom",
 			Handler:    _HotelService_DeleteRoom_Handler,
 		},
+		{
+			MethodName: "CreateRoomType",
+			Handler:    _HotelService_CreateRoomType_Handler,
+		},
+		{
+			MethodName: "GetRoomType",
+			Handler:    _HotelService_GetRoomType_Handler,
+		},
+		{
+			MethodName: "ListRoomTypes",
+			Handler:    _HotelService_ListRoomTypes_Handler,
+		},
+		{
+			MethodName: "UpdateRoomType",
+			Handler:    _HotelService_UpdateRoomType_Handler,
+		},
+		{
+			MethodName: "DeleteRoomType",
+			Handler:    _HotelService_DeleteRoomType_Handler,
+		},
+		{
+			MethodName: "CreateRatePlan",
+			Handler:    _HotelService_CreateRatePlan_Handler,
+		},
+		{
+			MethodName: "GetRatePlan",
+			Handler:    _HotelService_GetRatePlan_Handler,
+		},
+		{
+			MethodName: "ListRatePlans",
+			Handler:    _HotelService_ListRatePlans_Handler,
+		},
+		{
+			MethodName: "UpdateRatePlan",
+			Handler:    _HotelService_UpdateRatePlan_Handler,
+		},
+		{
+			MethodName: "DeleteRatePlan",
+			Handler:    _HotelService_DeleteRatePlan_Handler,
+		},
+		{
+			MethodName: "CreatePricingRule",
+			Handler:    _HotelService_CreatePricingRule_Handler,
+		},
+		{
+			MethodName: "GetPricingRule",
+			Handler:    _HotelService_GetPricingRule_Handler,
+		},
+		{
+			MethodName: "ListPricingRules",
+			Handler:    _HotelService_ListPricingRules_Handler,
+		},
+		{
+			MethodName: "UpdatePricingRule",
+			Handler:    _HotelService_UpdatePricingRule_Handler,
+		},
+		{
+			MethodName: "DeletePricingRule",
+			Handler:    _HotelService_DeletePricingRule_Handler,
+		},
+		{
+			MethodName: "QuotePrice",
+			Handler:    _HotelService_QuotePrice_Handler,
+		},
 		{
 			MethodName: "CheckAvailability",
 			Handler:    _HotelService_CheckAvailability_Handler,
 		},
+		{
+			MethodName: "CreateUploadSession",
+			Handler:    _HotelService_CreateUploadSession_Handler,
+		},
+		{
+			MethodName: "AttachPhoto",
+			Handler:    _HotelService_AttachPhoto_Handler,
+		},
+		{
+			MethodName: "ReorderPhotos",
+			Handler:    _HotelService_ReorderPhotos_Handler,
+		},
+		{
+			MethodName: "DeletePhoto",
+			Handler:    _HotelService_DeletePhoto_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadPhoto",
+			Handler:       _HotelService_UploadPhoto_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchCatalog",
+			Handler:       _HotelService_WatchCatalog_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/hotel.proto",
 }
@@ -0,0 +1,112 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewLocalStore(t.TempDir(), "http://cdn.example.com/media/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Put(ctx, "hotels/h-1/a.png", strings.NewReader("first")); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Put(ctx, "hotels/h-1/a.png", strings.NewReader("second")); err != nil {
+		t.Fatal(err)
+	}
+	failing := io.MultiReader(strings.NewReader("partial"), errReader{errors.New("client went away")})
+	if err := s.Put(ctx, "hotels/h-1/a.png", failing); err == nil {
+		t.Fatal("Put with a failing reader succeeded")
+	}
+
+	rc, err := s.Open(ctx, "hotels/h-1/a.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := io.ReadAll(rc)
+	rc.Close()
+	if string(got) != "second" {
+		t.Errorf("Open = %q, want the last complete Put", got)
+	}
+	entries, _ := os.ReadDir(filepath.Join(s.Dir, "hotels", "h-1"))
+	if len(entries) != 1 {
+		t.Errorf("directory holds %d entries, want no temporary files left", len(entries))
+	}
+	if u := s.URL("hotels/h-1/a.png"); u != "http://cdn.example.com/media/hotels/h-1/a.png" {
+		t.Errorf("URL = %s", u)
+	}
+
+	for _, key := range []string{"", "/abs", "../up", "a/../../b"} {
+		if err := s.Put(ctx, key, strings.NewReader("x")); err == nil {
+			t.Errorf("Put(%q) accepted an invalid key", key)
+		}
+	}
+
+	if err := s.Delete(ctx, "hotels/h-1/a.png"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Open(ctx, "hotels/h-1/a.png"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Open after Delete = %v, want ErrNotFound", err)
+	}
+	if err := s.Delete(ctx, "hotels/h-1/a.png"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("second Delete = %v, want ErrNotFound", err)
+	}
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+func TestLocalStoreHandler(t *testing.T) {
+	s, err := NewLocalStore(t.TempDir(), "/media")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Put(context.Background(), "hotels/h-1/a.png", strings.NewReader("pixels")); err != nil {
+		t.Fatal(err)
+	}
+	// An upload in progress, as Put leaves it while reading.
+	if err := os.WriteFile(filepath.Join(s.Dir, "hotels", "h-1", ".upload-123"), []byte("part"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	h := http.StripPrefix("/media", s.Handler())
+
+	tests := []struct {
+		path     string
+		wantCode int
+		wantBody string
+	}{
+		{"/media/hotels/h-1/a.png", http.StatusOK, "pixels"},
+		{"/media/hotels/h-1/.upload-123", http.StatusNotFound, ""},
+		{"/media/hotels/h-1/", http.StatusNotFound, ""},
+		{"/media/hotels/h-1", http.StatusNotFound, ""},
+		{"/media/", http.StatusNotFound, ""},
+		{"/media/hotels/h-1/missing.png", http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if rec.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantCode)
+			}
+			if tt.wantBody != "" && rec.Body.String() != tt.wantBody {
+				t.Errorf("body = %q, want %q", rec.Body.String(), tt.wantBody)
+			}
+			if strings.Contains(rec.Body.String(), "a.png") || strings.Contains(rec.Body.String(), ".upload-") {
+				t.Errorf("response lists files: %q", rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Package media stores hotel and room photos. Uploads are declared with
+// CreateUploadSession, streamed in chunks with UploadPhoto and checked by
+// Receive against the declared size and SHA-256 before they are kept in a
+// Store.
+package media
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/JunBSer/services_proto/apierrors"
+)
+
+const (
+	// MaxPhotoBytes is the largest accepted image.
+	MaxPhotoBytes = 20 << 20
+	// MaxChunkBytes is the largest data chunk of one UploadPhoto message.
+	MaxChunkBytes = 1 << 20
+)
+
+// ContentTypes maps the accepted media types to file extensions.
+var ContentTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+var ErrNotFound = errors.New("media: blob not found")
+
+// Store keeps image blobs under slash-separated keys.
+type Store interface {
+	// Put stores the contents of r under key. It must be atomic: when r
+	// fails, nothing is stored and a previous blob is kept.
+	Put(ctx context.Context, key string, r io.Reader) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete returns ErrNotFound if there is no blob under key.
+	Delete(ctx context.Context, key string) error
+	// URL returns where clients can fetch the blob.
+	URL(key string) string
+}
+
+// Key returns the blob key of an upload. roomID is empty for hotel photos.
+func Key(hotelID, roomID, uploadID, contentType string) string {
+	name := uploadID + ContentTypes[contentType]
+	if roomID == "" {
+		return path.Join("hotels", hotelID, name)
+	}
+	return path.Join("hotels", hotelID, "rooms", roomID, name)
+}
+
+var sha256Hex = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+
+// CheckUpload validates the declaration of an upload session.
+func CheckUpload(contentType string, size int64, sha256 string) error {
+	var v []apierrors.FieldViolation
+	if _, ok := ContentTypes[contentType]; !ok {
+		v = append(v, apierrors.FieldViolation{Field: "content_type", Description: "must be image/jpeg, image/png or image/gif"})
+	}
+	if size <= 0 || size > MaxPhotoBytes {
+		v = append(v, apierrors.FieldViolation{Field: "size_bytes", Description: "must be between 1 byte and 20 MiB"})
+	}
+	if !sha256Hex.MatchString(sha256) {
+		v = append(v, apierrors.FieldViolation{Field: "sha256", Description: "must be 64 hex digits"})
+	}
+	if len(v) > 0 {
+		return apierrors.Validation("invalid upload", v...)
+	}
+	return nil
+}
+
+// validKey rejects keys that could escape a store's root.
+func validKey(key string) bool {
+	return key != "" && !strings.HasPrefix(key, "/") && path.Clean(key) == key && !strings.HasPrefix(key, "../") && key != ".."
+}
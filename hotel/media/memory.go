@@ -0,0 +1,75 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// MemoryStore keeps blobs in process memory, for tests and the fakes.
+type MemoryStore struct {
+	BaseURL string
+
+	mu    sync.RWMutex
+	blobs map[string][]byte
+}
+
+func NewMemoryStore(baseURL string) *MemoryStore {
+	return &MemoryStore{BaseURL: strings.TrimRight(baseURL, "/"), blobs: make(map[string][]byte)}
+}
+
+func (s *MemoryStore) Put(ctx context.Context, key string, r io.Reader) error {
+	if !validKey(key) {
+		return fmt.Errorf("media: invalid key %q", key)
+	}
+	b, err := io.ReadAll(r)
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.blobs[key] = b
+	return nil
+}
+
+func (s *MemoryStore) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	b, ok := s.blobs[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(b)), nil
+}
+
+func (s *MemoryStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.blobs[key]; !ok {
+		return ErrNotFound
+	}
+	delete(s.blobs, key)
+	return nil
+}
+
+func (s *MemoryStore) URL(key string) string {
+	return s.BaseURL + "/" + key
+}
+
+// Len returns the number of stored blobs.
+func (s *MemoryStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.blobs)
+}
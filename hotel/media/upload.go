@@ -0,0 +1,149 @@
+package media
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/JunBSer/services_proto/apierrors"
+)
+
+// errDecoded ends the decoder's pipe once the image header has been read.
+var errDecoded = errors.New("media: image header decoded")
+
+// Expect is what an upload session declared.
+type Expect struct {
+	ContentType string
+	Size        int64
+	// SHA256 is hex-encoded.
+	SHA256 string
+}
+
+// Chunk is one UploadPhoto message.
+type Chunk struct {
+	Offset int64
+	Data   []byte
+}
+
+// Result describes a stored image.
+type Result struct {
+	Size          int64
+	Width, Height int
+}
+
+// Receive reads chunks from next until it returns io.EOF and stores them
+// under key. Chunks must be contiguous, and the whole upload must match the
+// declared size, SHA-256 and content type; otherwise nothing is stored.
+func Receive(ctx context.Context, store Store, key string, want Expect, next func() (Chunk, error)) (Result, error) {
+	pr, pw := io.Pipe()
+	stored := make(chan error, 1)
+	go func() {
+		err := store.Put(ctx, key, pr)
+		pr.CloseWithError(err)
+		stored <- err
+	}()
+
+	dec := newConfigDecoder()
+	fail := func(err error) (Result, error) {
+		pw.CloseWithError(err)
+		<-stored
+		dec.result()
+		return Result{}, err
+	}
+
+	sum := sha256.New()
+	var n int64
+	for {
+		c, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fail(err)
+		}
+		switch {
+		case len(c.Data) > MaxChunkBytes:
+			return fail(apierrors.Validation("chunk too large",
+				apierrors.FieldViolation{Field: "data", Description: fmt.Sprintf("must be at most %d bytes", MaxChunkBytes)}))
+		case c.Offset != n:
+			return fail(apierrors.Validation("chunks out of order",
+				apierrors.FieldViolation{Field: "offset", Description: fmt.Sprintf("must be %d", n)}))
+		case n+int64(len(c.Data)) > want.Size:
+			return fail(apierrors.Validation("upload larger than declared",
+				apierrors.FieldViolation{Field: "data", Description: fmt.Sprintf("exceeds size_bytes %d", want.Size)}))
+		}
+
+		sum.Write(c.Data)
+		dec.Write(c.Data)
+		if _, err := pw.Write(c.Data); err != nil {
+			return fail(err)
+		}
+		n += int64(len(c.Data))
+	}
+
+	if n != want.Size {
+		return fail(apierrors.Validation("upload incomplete",
+			apierrors.FieldViolation{Field: "data", Description: fmt.Sprintf("received %d of %d bytes", n, want.Size)}))
+	}
+	if got := hex.EncodeToString(sum.Sum(nil)); got != strings.ToLower(want.SHA256) {
+		return fail(apierrors.New(codes.InvalidArgument, apierrors.ReasonChecksumMismatch,
+			"uploaded bytes do not match the declared sha256"))
+	}
+	cfg, format, err := dec.result()
+	if err != nil || "image/"+format != want.ContentType {
+		return fail(apierrors.Validation("invalid image",
+			apierrors.FieldViolation{Field: "data", Description: "is not a " + want.ContentType + " image"}))
+	}
+
+	pw.Close()
+	if err := <-stored; err != nil {
+		return Result{}, fmt.Errorf("media: store %s: %w", key, err)
+	}
+	return Result{Size: n, Width: cfg.Width, Height: cfg.Height}, nil
+}
+
+// configDecoder reads an image's format and dimensions from the data written
+// to it as the upload streams past, however large the metadata segments in
+// front of them are. Once the header is decoded the rest is ignored.
+type configDecoder struct {
+	pw   *io.PipeWriter
+	done chan struct{}
+
+	cfg    image.Config
+	format string
+	err    error
+}
+
+func newConfigDecoder() *configDecoder {
+	pr, pw := io.Pipe()
+	d := &configDecoder{pw: pw, done: make(chan struct{})}
+	go func() {
+		defer close(d.done)
+		d.cfg, d.format, d.err = image.DecodeConfig(pr)
+		pr.CloseWithError(errDecoded)
+	}()
+	return d
+}
+
+// Write never fails; after decoding, or when decoding failed, p is dropped.
+func (d *configDecoder) Write(p []byte) (int, error) {
+	d.pw.Write(p)
+	return len(p), nil
+}
+
+// result ends the input and returns what DecodeConfig found.
+func (d *configDecoder) result() (image.Config, string, error) {
+	d.pw.Close()
+	<-d.done
+	return d.cfg, d.format, d.err
+}
@@ -0,0 +1,264 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/JunBSer/services_proto/apierrors"
+)
+
+func testImage(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for x := range w {
+		img.Set(x, x%h, color.RGBA{R: 200, A: 255})
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+
+	var b bytes.Buffer
+	if err := png.Encode(&b, testImage(w, h)); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+func encodeGIF(t *testing.T, w, h int) []byte {
+	t.Helper()
+
+	var b bytes.Buffer
+	if err := gif.Encode(&b, testImage(w, h), nil); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+// encodeJPEG returns a JPEG with metaBytes of APP1 (EXIF/XMP) segments in
+// front of the frame header that holds the dimensions.
+func encodeJPEG(t *testing.T, w, h, metaBytes int) []byte {
+	t.Helper()
+
+	var b bytes.Buffer
+	if err := jpeg.Encode(&b, testImage(w, h), nil); err != nil {
+		t.Fatal(err)
+	}
+	img := b.Bytes()
+
+	out := append([]byte(nil), img[:2]...) // SOI
+	for metaBytes > 0 {
+		n := min(metaBytes, 0xffff-2)
+		out = append(out, 0xff, 0xe1, byte((n+2)>>8), byte(n+2))
+		out = append(out, bytes.Repeat([]byte{'x'}, n)...)
+		metaBytes -= n
+	}
+	return append(out, img[2:]...)
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// chunks returns a next function that yields data in pieces of size bytes.
+func chunks(data []byte, size int) func() (Chunk, error) {
+	var off int
+	return func() (Chunk, error) {
+		if off >= len(data) {
+			return Chunk{}, io.EOF
+		}
+		end := min(off+size, len(data))
+		c := Chunk{Offset: int64(off), Data: data[off:end]}
+		off = end
+		return c, nil
+	}
+}
+
+func TestReceive(t *testing.T) {
+	pngData := encodePNG(t, 40, 30)
+	gifData := encodeGIF(t, 20, 10)
+	bigMetaJPEG := encodeJPEG(t, 64, 48, 200<<10)
+	streamErr := errors.New("stream reset")
+
+	tests := []struct {
+		name string
+		want Expect
+		// next defaults to data in 1 KiB chunks.
+		data       []byte
+		next       func() (Chunk, error)
+		wantResult Result
+		wantCode   codes.Code
+		wantReason apierrors.Reason
+		wantErr    error
+	}{
+		{
+			name:       "png",
+			data:       pngData,
+			want:       Expect{ContentType: "image/png", Size: int64(len(pngData)), SHA256: checksum(pngData)},
+			wantResult: Result{Size: int64(len(pngData)), Width: 40, Height: 30},
+		},
+		{
+			name:       "gif with upper-case checksum",
+			data:       gifData,
+			want:       Expect{ContentType: "image/gif", Size: int64(len(gifData)), SHA256: strings.ToUpper(checksum(gifData))},
+			wantResult: Result{Size: int64(len(gifData)), Width: 20, Height: 10},
+		},
+		{
+			name:       "jpeg with large metadata",
+			data:       bigMetaJPEG,
+			next:       chunks(bigMetaJPEG, 16<<10),
+			want:       Expect{ContentType: "image/jpeg", Size: int64(len(bigMetaJPEG)), SHA256: checksum(bigMetaJPEG)},
+			wantResult: Result{Size: int64(len(bigMetaJPEG)), Width: 64, Height: 48},
+		},
+		{
+			name:       "checksum mismatch",
+			data:       pngData,
+			want:       Expect{ContentType: "image/png", Size: int64(len(pngData)), SHA256: checksum([]byte("other"))},
+			wantCode:   codes.InvalidArgument,
+			wantReason: apierrors.ReasonChecksumMismatch,
+		},
+		{
+			name:     "wrong content type",
+			data:     pngData,
+			want:     Expect{ContentType: "image/jpeg", Size: int64(len(pngData)), SHA256: checksum(pngData)},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "not an image",
+			data:     []byte("plain text, not pixels"),
+			want:     Expect{ContentType: "image/png", Size: 22, SHA256: checksum([]byte("plain text, not pixels"))},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "incomplete",
+			data:     pngData[:len(pngData)/2],
+			want:     Expect{ContentType: "image/png", Size: int64(len(pngData)), SHA256: checksum(pngData)},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "larger than declared",
+			data:     pngData,
+			want:     Expect{ContentType: "image/png", Size: int64(len(pngData)) - 1, SHA256: checksum(pngData)},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "out of order",
+			next: func() func() (Chunk, error) {
+				sent := false
+				return func() (Chunk, error) {
+					if sent {
+						return Chunk{}, io.EOF
+					}
+					sent = true
+					return Chunk{Offset: 5, Data: pngData}, nil
+				}
+			}(),
+			want:     Expect{ContentType: "image/png", Size: int64(len(pngData)) + 5, SHA256: checksum(pngData)},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "chunk too large",
+			next:     chunks(make([]byte, MaxChunkBytes+1), MaxChunkBytes+1),
+			want:     Expect{ContentType: "image/png", Size: MaxChunkBytes + 1},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "stream error",
+			next: func() (Chunk, error) { return Chunk{}, streamErr },
+			want: Expect{ContentType: "image/png", Size: 1},
+			// The stream's own error is returned unchanged.
+			wantCode: codes.Unknown,
+			wantErr:  streamErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryStore("/media")
+			next := tt.next
+			if next == nil {
+				next = chunks(tt.data, 1<<10)
+			}
+
+			res, err := Receive(context.Background(), store, "hotels/h-1/p.img", tt.want, next)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("Receive error = %v, want code %v", err, tt.wantCode)
+			}
+			if tt.wantReason != "" && apierrors.ReasonOf(err) != tt.wantReason {
+				t.Errorf("reason = %s, want %s", apierrors.ReasonOf(err), tt.wantReason)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+			if res != tt.wantResult {
+				t.Errorf("result = %+v, want %+v", res, tt.wantResult)
+			}
+
+			if err != nil {
+				if store.Len() != 0 {
+					t.Error("a failed upload was stored")
+				}
+				return
+			}
+			rc, err := store.Open(context.Background(), "hotels/h-1/p.img")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rc.Close()
+			if got, _ := io.ReadAll(rc); !bytes.Equal(got, tt.data) {
+				t.Errorf("stored %d bytes, want the %d uploaded", len(got), len(tt.data))
+			}
+		})
+	}
+}
+
+func TestCheckUpload(t *testing.T) {
+	valid := checksum([]byte("x"))
+	tests := []struct {
+		name        string
+		contentType string
+		size        int64
+		sha256      string
+		wantFields  []string
+	}{
+		{"valid", "image/png", 1, valid, nil},
+		{"max size", "image/jpeg", MaxPhotoBytes, strings.ToUpper(valid), nil},
+		{"bad type", "image/webp", 1, valid, []string{"content_type"}},
+		{"empty", "image/gif", 0, valid, []string{"size_bytes"}},
+		{"too large", "image/gif", MaxPhotoBytes + 1, valid, []string{"size_bytes"}},
+		{"bad checksum", "image/png", 1, "abc", []string{"sha256"}},
+		{"everything", "text/plain", -1, "", []string{"content_type", "size_bytes", "sha256"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckUpload(tt.contentType, tt.size, tt.sha256)
+			var fields []string
+			for _, d := range status.Convert(err).Details() {
+				if br, ok := d.(*errdetails.BadRequest); ok {
+					for _, v := range br.GetFieldViolations() {
+						fields = append(fields, v.GetField())
+					}
+				}
+			}
+			if !slices.Equal(fields, tt.wantFields) {
+				t.Errorf("CheckUpload violations = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
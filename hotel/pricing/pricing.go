@@ -0,0 +1,192 @@
+// Package pricing computes the nightly prices of a stay from the room price,
+// the hotel's pricing rules and an optional rate plan. Quotes depend only on
+// their inputs, so the same stay quoted at the same time always costs the
+// same, whichever service computes it.
+package pricing
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+	"github.com/JunBSer/services_proto/hotel/rates"
+)
+
+const day = 24 * time.Hour
+
+// MaxNights is the longest stay that can be quoted or booked.
+const MaxNights = 365
+
+// ValidateStay checks that a stay from start to end lasts at least one and
+// at most MaxNights nights.
+func ValidateStay(start, end time.Time) error {
+	if !end.After(start) {
+		return apierrors.New(codes.InvalidArgument, apierrors.ReasonInvalidDateRange, "end_date must be after start_date")
+	}
+	if end.Sub(start) > MaxNights*day {
+		return apierrors.WithMetadata(codes.InvalidArgument, apierrors.ReasonInvalidDateRange,
+			fmt.Sprintf("stays are limited to %d nights", MaxNights), map[string]string{"max_nights": fmt.Sprint(MaxNights)})
+	}
+	return nil
+}
+
+// ValidateRule checks the fields a pricing rule must have.
+func ValidateRule(r *hotelpb.PricingRule) error {
+	var v []apierrors.FieldViolation
+	if strings.TrimSpace(r.GetName()) == "" {
+		v = append(v, apierrors.FieldViolation{Field: "name", Description: "is required"})
+	}
+	if r.GetStartDate() != nil && r.GetEndDate() != nil && !r.GetEndDate().AsTime().After(r.GetStartDate().AsTime()) {
+		v = append(v, apierrors.FieldViolation{Field: "end_date", Description: "must be after start_date"})
+	}
+	if r.GetWeekdayMask() > 0x7f {
+		v = append(v, apierrors.FieldViolation{Field: "weekday_mask", Description: "must only use bits 0 (Sunday) to 6 (Saturday)"})
+	}
+	if r.GetMinNights() < 0 || r.GetMinNights() > MaxNights {
+		v = append(v, apierrors.FieldViolation{Field: "min_nights", Description: fmt.Sprintf("must be between 0 and %d", MaxNights)})
+	}
+	if r.GetMaxNights() < 0 || r.GetMaxNights() > MaxNights || (r.GetMaxNights() > 0 && r.GetMaxNights() < r.GetMinNights()) {
+		v = append(v, apierrors.FieldViolation{Field: "max_nights", Description: fmt.Sprintf("must be 0 or between min_nights and %d", MaxNights)})
+	}
+	if r.GetArrivalWithinDays() < 0 {
+		v = append(v, apierrors.FieldViolation{Field: "arrival_within_days", Description: "must not be negative"})
+	}
+	a := r.GetAdjustment()
+	switch {
+	case math.IsNaN(a) || math.IsInf(a, 0):
+		v = append(v, apierrors.FieldViolation{Field: "adjustment", Description: "must be a number"})
+	case r.GetAdjustmentType() == hotelpb.AdjustmentType_ADJUSTMENT_TYPE_PERCENT && a <= -100:
+		v = append(v, apierrors.FieldViolation{Field: "adjustment", Description: "must be above -100 percent"})
+	case r.GetAdjustmentType() == hotelpb.AdjustmentType_ADJUSTMENT_TYPE_FIXED_PRICE && a <= 0:
+		v = append(v, apierrors.FieldViolation{Field: "adjustment", Description: "must be a positive price"})
+	}
+	if _, ok := hotelpb.AdjustmentType_name[int32(r.GetAdjustmentType())]; !ok || r.GetAdjustmentType() == hotelpb.AdjustmentType_ADJUSTMENT_TYPE_UNSPECIFIED {
+		v = append(v, apierrors.FieldViolation{Field: "adjustment_type", Description: "is required"})
+	}
+	if len(v) > 0 {
+		return apierrors.Validation("invalid pricing rule", v...)
+	}
+	return nil
+}
+
+// Sort orders rules the way they apply: by priority, then by ID.
+func Sort(rules []*hotelpb.PricingRule) {
+	slices.SortFunc(rules, func(a, b *hotelpb.PricingRule) int {
+		return cmp.Or(cmp.Compare(a.GetPriority(), b.GetPriority()), cmp.Compare(a.GetId(), b.GetId()))
+	})
+}
+
+// Stay is what a quote prices.
+type Stay struct {
+	Start, End time.Time
+	RoomTypeID string
+	// BasePrice is the room's price_per_night.
+	BasePrice float64
+	// Plan is applied after the pricing rules. Nil prices the bare room.
+	Plan *hotelpb.RatePlan
+	// QuotedAt decides whether last-minute rules apply.
+	QuotedAt time.Time
+}
+
+// Night is the price of one night of a stay.
+type Night struct {
+	Date      time.Time
+	BasePrice float64
+	Price     float64
+	RuleIDs   []string
+}
+
+type Quote struct {
+	Nights []Night
+	Total  float64
+}
+
+// Engine prices stays under a fixed set of pricing rules.
+type Engine struct {
+	rules []*hotelpb.PricingRule
+}
+
+// NewEngine returns an engine for the active rules among rules. The slice is
+// not modified.
+func NewEngine(rules []*hotelpb.PricingRule) *Engine {
+	e := &Engine{}
+	for _, r := range rules {
+		if r.GetActive() {
+			e.rules = append(e.rules, r)
+		}
+	}
+	Sort(e.rules)
+	return e
+}
+
+// Quote prices every night of s. Each night starts at the room price, is
+// adjusted by the matching rules in order, never drops below zero, then has
+// the rate plan applied and is rounded to cents. Total is the sum of nights.
+// Stays rejected by ValidateStay fail with its error.
+func (e *Engine) Quote(s Stay) (Quote, error) {
+	if err := ValidateStay(s.Start, s.End); err != nil {
+		return Quote{}, err
+	}
+	n := rates.Nights(s.Start, s.End)
+	q := Quote{Nights: make([]Night, 0, n)}
+	for i := range n {
+		date := s.Start.Add(time.Duration(i) * day)
+		night := Night{Date: date, BasePrice: s.BasePrice}
+		price := s.BasePrice
+		for _, r := range e.rules {
+			if !matches(r, s, n, date) {
+				continue
+			}
+			price = max(adjust(price, r), 0)
+			night.RuleIDs = append(night.RuleIDs, r.GetId())
+		}
+		night.Price = rates.Nightly(price, s.Plan)
+		q.Nights = append(q.Nights, night)
+		q.Total += night.Price
+	}
+	q.Total = math.Round(q.Total*100) / 100
+	return q, nil
+}
+
+// matches reports whether r applies to the night starting at date of a stay
+// of n nights.
+func matches(r *hotelpb.PricingRule, s Stay, n int, date time.Time) bool {
+	if len(r.GetRoomTypeIds()) > 0 && !slices.Contains(r.GetRoomTypeIds(), s.RoomTypeID) {
+		return false
+	}
+	if r.GetStartDate() != nil && date.Before(r.GetStartDate().AsTime()) {
+		return false
+	}
+	if r.GetEndDate() != nil && !date.Before(r.GetEndDate().AsTime()) {
+		return false
+	}
+	if mask := r.GetWeekdayMask(); mask != 0 && mask&(1<<date.UTC().Weekday()) == 0 {
+		return false
+	}
+	if n < int(r.GetMinNights()) || (r.GetMaxNights() > 0 && n > int(r.GetMaxNights())) {
+		return false
+	}
+	if d := r.GetArrivalWithinDays(); d > 0 && !s.Start.Before(s.QuotedAt.Add(time.Duration(d)*day)) {
+		return false
+	}
+	return true
+}
+
+func adjust(price float64, r *hotelpb.PricingRule) float64 {
+	switch r.GetAdjustmentType() {
+	case hotelpb.AdjustmentType_ADJUSTMENT_TYPE_PERCENT:
+		return price * (1 + r.GetAdjustment()/100)
+	case hotelpb.AdjustmentType_ADJUSTMENT_TYPE_AMOUNT:
+		return price + r.GetAdjustment()
+	case hotelpb.AdjustmentType_ADJUSTMENT_TYPE_FIXED_PRICE:
+		return r.GetAdjustment()
+	}
+	return price
+}
@@ -0,0 +1,282 @@
+package pricing
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+)
+
+// monday is the first night of the stays below.
+var monday = time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func date(days int) time.Time { return monday.AddDate(0, 0, days) }
+
+func rule(id string, typ hotelpb.AdjustmentType, adj float64, opts ...func(*hotelpb.PricingRule)) *hotelpb.PricingRule {
+	r := &hotelpb.PricingRule{Id: id, Name: id, Active: true, AdjustmentType: typ, Adjustment: adj}
+	for _, o := range opts {
+		o(r)
+	}
+	return r
+}
+
+func percent(id string, adj float64, opts ...func(*hotelpb.PricingRule)) *hotelpb.PricingRule {
+	return rule(id, hotelpb.AdjustmentType_ADJUSTMENT_TYPE_PERCENT, adj, opts...)
+}
+
+func TestEngineQuote(t *testing.T) {
+	stay := Stay{Start: monday, End: date(3), RoomTypeID: "std", BasePrice: 100, QuotedAt: date(-30)}
+
+	tests := []struct {
+		name       string
+		rules      []*hotelpb.PricingRule
+		stay       func(*Stay)
+		wantPrices []float64
+		wantRules  [][]string
+		wantTotal  float64
+	}{
+		{
+			name:       "no rules",
+			wantPrices: []float64{100, 100, 100},
+			wantTotal:  300,
+		},
+		{
+			name:       "percent",
+			rules:      []*hotelpb.PricingRule{percent("p", 10)},
+			wantPrices: []float64{110, 110, 110},
+			wantRules:  [][]string{{"p"}, {"p"}, {"p"}},
+			wantTotal:  330,
+		},
+		{
+			name: "amount and fixed price apply by priority",
+			rules: []*hotelpb.PricingRule{
+				rule("amount", hotelpb.AdjustmentType_ADJUSTMENT_TYPE_AMOUNT, 15, func(r *hotelpb.PricingRule) { r.Priority = 2 }),
+				rule("fixed", hotelpb.AdjustmentType_ADJUSTMENT_TYPE_FIXED_PRICE, 80, func(r *hotelpb.PricingRule) { r.Priority = 1 }),
+			},
+			wantPrices: []float64{95, 95, 95},
+			wantRules:  [][]string{{"fixed", "amount"}, {"fixed", "amount"}, {"fixed", "amount"}},
+			wantTotal:  285,
+		},
+		{
+			name:       "never below zero",
+			rules:      []*hotelpb.PricingRule{rule("discount", hotelpb.AdjustmentType_ADJUSTMENT_TYPE_AMOUNT, -150)},
+			wantPrices: []float64{0, 0, 0},
+			wantRules:  [][]string{{"discount"}, {"discount"}, {"discount"}},
+		},
+		{
+			name:       "inactive rules are ignored",
+			rules:      []*hotelpb.PricingRule{percent("off", 50, func(r *hotelpb.PricingRule) { r.Active = false })},
+			wantPrices: []float64{100, 100, 100},
+			wantTotal:  300,
+		},
+		{
+			name:       "weekday mask",
+			rules:      []*hotelpb.PricingRule{percent("tue", 50, func(r *hotelpb.PricingRule) { r.WeekdayMask = 1 << time.Tuesday })},
+			wantPrices: []float64{100, 150, 100},
+			wantRules:  [][]string{nil, {"tue"}, nil},
+			wantTotal:  350,
+		},
+		{
+			name: "date window is half open",
+			rules: []*hotelpb.PricingRule{percent("season", 20, func(r *hotelpb.PricingRule) {
+				r.StartDate, r.EndDate = timestamppb.New(date(1)), timestamppb.New(date(2))
+			})},
+			wantPrices: []float64{100, 120, 100},
+			wantRules:  [][]string{nil, {"season"}, nil},
+			wantTotal:  320,
+		},
+		{
+			name: "stay length",
+			rules: []*hotelpb.PricingRule{
+				percent("long", -10, func(r *hotelpb.PricingRule) { r.MinNights = 3 }),
+				percent("short", 10, func(r *hotelpb.PricingRule) { r.MaxNights = 2 }),
+			},
+			wantPrices: []float64{90, 90, 90},
+			wantRules:  [][]string{{"long"}, {"long"}, {"long"}},
+			wantTotal:  270,
+		},
+		{
+			name:       "room types",
+			rules:      []*hotelpb.PricingRule{percent("suite", 50, func(r *hotelpb.PricingRule) { r.RoomTypeIds = []string{"suite"} })},
+			wantPrices: []float64{100, 100, 100},
+			wantTotal:  300,
+		},
+		{
+			name:       "last minute",
+			rules:      []*hotelpb.PricingRule{percent("late", -20, func(r *hotelpb.PricingRule) { r.ArrivalWithinDays = 2 })},
+			stay:       func(s *Stay) { s.QuotedAt = date(-1) },
+			wantPrices: []float64{80, 80, 80},
+			wantRules:  [][]string{{"late"}, {"late"}, {"late"}},
+			wantTotal:  240,
+		},
+		{
+			name:       "not last minute",
+			rules:      []*hotelpb.PricingRule{percent("late", -20, func(r *hotelpb.PricingRule) { r.ArrivalWithinDays = 2 })},
+			wantPrices: []float64{100, 100, 100},
+			wantTotal:  300,
+		},
+		{
+			name:  "rate plan after rules, rounded to cents",
+			rules: []*hotelpb.PricingRule{percent("p", 1.111)},
+			stay: func(s *Stay) {
+				s.Plan = &hotelpb.RatePlan{PriceMultiplier: 0.9, NightlySupplement: 12.5}
+			},
+			wantPrices: []float64{103.5, 103.5, 103.5},
+			wantRules:  [][]string{{"p"}, {"p"}, {"p"}},
+			wantTotal:  310.5,
+		},
+		{
+			name:       "partial day counts as a night",
+			stay:       func(s *Stay) { s.End = date(1).Add(time.Hour) },
+			wantPrices: []float64{100, 100},
+			wantTotal:  200,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := stay
+			if tt.stay != nil {
+				tt.stay(&s)
+			}
+			q, err := NewEngine(tt.rules).Quote(s)
+			if err != nil {
+				t.Fatalf("Quote: %v", err)
+			}
+			var prices []float64
+			for i, n := range q.Nights {
+				prices = append(prices, n.Price)
+				if !n.Date.Equal(date(i)) || n.BasePrice != s.BasePrice {
+					t.Errorf("night %d = %+v, want date %v and base %v", i, n, date(i), s.BasePrice)
+				}
+				var want []string
+				if tt.wantRules != nil {
+					want = tt.wantRules[i]
+				}
+				if !slices.Equal(n.RuleIDs, want) {
+					t.Errorf("night %d rules = %v, want %v", i, n.RuleIDs, want)
+				}
+			}
+			if !slices.Equal(prices, tt.wantPrices) {
+				t.Errorf("prices = %v, want %v", prices, tt.wantPrices)
+			}
+			if q.Total != tt.wantTotal {
+				t.Errorf("total = %v, want %v", q.Total, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestEngineQuoteDeterministic(t *testing.T) {
+	rules := []*hotelpb.PricingRule{percent("b", 10), percent("a", 10), percent("c", -5, func(r *hotelpb.PricingRule) { r.Priority = -1 })}
+	stay := Stay{Start: monday, End: date(2), BasePrice: 99.99}
+
+	want, err := NewEngine(rules).Quote(stay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Reverse(rules)
+	got, err := NewEngine(rules).Quote(stay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Total != want.Total || !slices.Equal(got.Nights[0].RuleIDs, []string{"c", "a", "b"}) {
+		t.Errorf("rule order changed the quote: %+v vs %+v", got, want)
+	}
+}
+
+func TestValidateStay(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		wantCode codes.Code
+	}{
+		{"one night", monday, date(1), codes.OK},
+		{"longest stay", monday, date(MaxNights), codes.OK},
+		{"too long", monday, date(MaxNights).Add(time.Hour), codes.InvalidArgument},
+		{"empty", monday, monday, codes.InvalidArgument},
+		{"end before start", date(3), monday, codes.InvalidArgument},
+		{"zero times", time.Time{}, time.Time{}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateStay(tt.start, tt.end)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("ValidateStay code = %v, want %v (%v)", got, tt.wantCode, err)
+			}
+			if err != nil && !apierrors.Is(err, apierrors.ReasonInvalidDateRange) {
+				t.Errorf("ValidateStay reason = %v, want INVALID_DATE_RANGE", apierrors.ReasonOf(err))
+			}
+
+			// Quote must fail the same way instead of panicking.
+			_, err = NewEngine(nil).Quote(Stay{Start: tt.start, End: tt.end, BasePrice: 100})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("Quote code = %v, want %v", got, tt.wantCode)
+			}
+		})
+	}
+}
+
+func violatedFields(err error) []string {
+	var fields []string
+	for _, d := range status.Convert(err).Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				fields = append(fields, v.GetField())
+			}
+		}
+	}
+	return fields
+}
+
+func TestValidateRule(t *testing.T) {
+	valid := func() *hotelpb.PricingRule { return percent("summer", 10) }
+
+	tests := []struct {
+		name      string
+		edit      func(*hotelpb.PricingRule)
+		wantField string
+	}{
+		{"valid", func(*hotelpb.PricingRule) {}, ""},
+		{"name", func(r *hotelpb.PricingRule) { r.Name = " " }, "name"},
+		{"date order", func(r *hotelpb.PricingRule) {
+			r.StartDate, r.EndDate = timestamppb.New(date(2)), timestamppb.New(date(1))
+		}, "end_date"},
+		{"weekday mask", func(r *hotelpb.PricingRule) { r.WeekdayMask = 0x80 }, "weekday_mask"},
+		{"negative min nights", func(r *hotelpb.PricingRule) { r.MinNights = -1 }, "min_nights"},
+		{"min nights over limit", func(r *hotelpb.PricingRule) { r.MinNights = MaxNights + 1 }, "min_nights"},
+		{"max below min", func(r *hotelpb.PricingRule) { r.MinNights, r.MaxNights = 5, 3 }, "max_nights"},
+		{"max nights over limit", func(r *hotelpb.PricingRule) { r.MaxNights = MaxNights + 1 }, "max_nights"},
+		{"max nights at limit", func(r *hotelpb.PricingRule) { r.MaxNights = MaxNights }, ""},
+		{"arrival window", func(r *hotelpb.PricingRule) { r.ArrivalWithinDays = -1 }, "arrival_within_days"},
+		{"percent floor", func(r *hotelpb.PricingRule) { r.Adjustment = -100 }, "adjustment"},
+		{"fixed price", func(r *hotelpb.PricingRule) {
+			r.AdjustmentType, r.Adjustment = hotelpb.AdjustmentType_ADJUSTMENT_TYPE_FIXED_PRICE, 0
+		}, "adjustment"},
+		{"adjustment type", func(r *hotelpb.PricingRule) { r.AdjustmentType = 0 }, "adjustment_type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := valid()
+			tt.edit(r)
+			err := ValidateRule(r)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Fatalf("ValidateRule = %v, want nil", err)
+				}
+				return
+			}
+			fields := violatedFields(err)
+			if !slices.Contains(fields, tt.wantField) {
+				t.Errorf("ValidateRule violations = %v, want %s", fields, tt.wantField)
+			}
+		})
+	}
+}
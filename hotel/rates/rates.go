@@ -0,0 +1,114 @@
+// Package rates validates room types and rate plans and applies a plan's
+// price rules to a night. Whole stays are priced by package pricing.
+package rates
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+)
+
+func positive(f float64) bool {
+	return f > 0 && !math.IsNaN(f) && !math.IsInf(f, 0)
+}
+
+// ValidateRoomType checks the fields a room type must have. Repeated fields
+// are checked entry by entry.
+func ValidateRoomType(t *hotelpb.RoomType) error {
+	var v []apierrors.FieldViolation
+	if strings.TrimSpace(t.GetName()) == "" {
+		v = append(v, apierrors.FieldViolation{Field: "name", Description: "is required"})
+	}
+	if t.GetMaxOccupancy() <= 0 {
+		v = append(v, apierrors.FieldViolation{Field: "max_occupancy", Description: "must be positive"})
+	}
+	if t.GetSizeSqm() != 0 && !positive(t.GetSizeSqm()) {
+		v = append(v, apierrors.FieldViolation{Field: "size_sqm", Description: "must be positive"})
+	}
+	for i, b := range t.GetBeds() {
+		if strings.TrimSpace(b.GetType()) == "" || b.GetCount() <= 0 {
+			v = append(v, apierrors.FieldViolation{
+				Field: fmt.Sprintf("beds[%d]", i), Description: "needs a type and a positive count",
+			})
+		}
+	}
+	if len(v) > 0 {
+		return apierrors.Validation("invalid room type", v...)
+	}
+	return nil
+}
+
+// ValidateRatePlan checks the fields a rate plan must have.
+func ValidateRatePlan(p *hotelpb.RatePlan) error {
+	var v []apierrors.FieldViolation
+	if strings.TrimSpace(p.GetName()) == "" {
+		v = append(v, apierrors.FieldViolation{Field: "name", Description: "is required"})
+	}
+	if _, ok := hotelpb.MealPlan_name[int32(p.GetMealPlan())]; !ok {
+		v = append(v, apierrors.FieldViolation{Field: "meal_plan", Description: "is not a known meal plan"})
+	}
+	if m := p.GetPriceMultiplier(); m != 0 && !positive(m) {
+		v = append(v, apierrors.FieldViolation{Field: "price_multiplier", Description: "must be positive"})
+	}
+	if s := p.GetNightlySupplement(); math.IsNaN(s) || math.IsInf(s, 0) || s < 0 {
+		v = append(v, apierrors.FieldViolation{Field: "nightly_supplement", Description: "must not be negative"})
+	}
+	if p.GetMinNights() < 0 {
+		v = append(v, apierrors.FieldViolation{Field: "min_nights", Description: "must not be negative"})
+	}
+	c := p.GetCancellationPolicy()
+	if c.GetFreeCancellationHours() < 0 {
+		v = append(v, apierrors.FieldViolation{Field: "cancellation_policy.free_cancellation_hours", Description: "must not be negative"})
+	}
+	if pct := c.GetPenaltyPercent(); math.IsNaN(pct) || pct < 0 || pct > 100 {
+		v = append(v, apierrors.FieldViolation{Field: "cancellation_policy.penalty_percent", Description: "must be between 0 and 100"})
+	}
+	if len(v) > 0 {
+		return apierrors.Validation("invalid rate plan", v...)
+	}
+	return nil
+}
+
+// Nights returns the nights between start and end. A started day counts as
+// a full night.
+func Nights(start, end time.Time) int {
+	return int(math.Ceil(end.Sub(start).Hours() / 24))
+}
+
+// Check reports why p cannot be booked for a room of roomTypeID and a stay
+// of nights, or nil if it can.
+func Check(p *hotelpb.RatePlan, roomTypeID string, nights int) error {
+	switch {
+	case roomTypeID == "":
+		return apierrors.New(codes.FailedPrecondition, apierrors.ReasonRatePlanUnavailable, "room has no room type")
+	case !p.GetActive():
+		return apierrors.New(codes.FailedPrecondition, apierrors.ReasonRatePlanUnavailable, "rate plan is not active")
+	case len(p.GetRoomTypeIds()) > 0 && !slices.Contains(p.GetRoomTypeIds(), roomTypeID):
+		return apierrors.New(codes.FailedPrecondition, apierrors.ReasonRatePlanUnavailable, "rate plan is not sold for this room type")
+	case nights < int(p.GetMinNights()):
+		return apierrors.WithMetadata(codes.FailedPrecondition, apierrors.ReasonRatePlanUnavailable,
+			"stay is shorter than the rate plan allows", map[string]string{"min_nights": fmt.Sprint(p.GetMinNights())})
+	}
+	return nil
+}
+
+// Nightly returns the price of one night for a room priced at base under p.
+// A nil plan leaves base unchanged. Prices are rounded to cents.
+func Nightly(base float64, p *hotelpb.RatePlan) float64 {
+	price := base
+	if m := p.GetPriceMultiplier(); m != 0 {
+		price *= m
+	}
+	return round(price + p.GetNightlySupplement())
+}
+
+func round(f float64) float64 {
+	return math.Round(f*100) / 100
+}
@@ -0,0 +1,212 @@
+package rates
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+)
+
+// violations returns the fields err reports as invalid.
+func violations(err error) []string {
+	var fields []string
+	for _, d := range status.Convert(err).Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				fields = append(fields, v.GetField())
+			}
+		}
+	}
+	return fields
+}
+
+func TestValidateRoomType(t *testing.T) {
+	valid := func(edit func(*hotelpb.RoomType)) *hotelpb.RoomType {
+		rt := &hotelpb.RoomType{
+			Name:         "Deluxe King",
+			MaxOccupancy: 2,
+			Beds:         []*hotelpb.BedConfiguration{{Type: "king", Count: 1}},
+			SizeSqm:      32,
+		}
+		if edit != nil {
+			edit(rt)
+		}
+		return rt
+	}
+
+	tests := []struct {
+		name       string
+		roomType   *hotelpb.RoomType
+		wantFields []string
+	}{
+		{"valid", valid(nil), nil},
+		{"size optional", valid(func(rt *hotelpb.RoomType) { rt.SizeSqm = 0 }), nil},
+		{"blank name", valid(func(rt *hotelpb.RoomType) { rt.Name = "  " }), []string{"name"}},
+		{"no occupancy", valid(func(rt *hotelpb.RoomType) { rt.MaxOccupancy = 0 }), []string{"max_occupancy"}},
+		{"negative size", valid(func(rt *hotelpb.RoomType) { rt.SizeSqm = -1 }), []string{"size_sqm"}},
+		{"NaN size", valid(func(rt *hotelpb.RoomType) { rt.SizeSqm = math.NaN() }), []string{"size_sqm"}},
+		{
+			name: "bad beds",
+			roomType: valid(func(rt *hotelpb.RoomType) {
+				rt.Beds = []*hotelpb.BedConfiguration{{Type: "king", Count: 1}, {Type: "", Count: 1}, {Type: "sofa", Count: 0}}
+			}),
+			wantFields: []string{"beds[1]", "beds[2]"},
+		},
+		{"empty", &hotelpb.RoomType{}, []string{"name", "max_occupancy"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRoomType(tt.roomType)
+			if (err != nil) != (tt.wantFields != nil) || (err != nil && status.Code(err) != codes.InvalidArgument) {
+				t.Fatalf("ValidateRoomType = %v, want violations %v", err, tt.wantFields)
+			}
+			if got := violations(err); !slices.Equal(got, tt.wantFields) {
+				t.Errorf("violations = %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestValidateRatePlan(t *testing.T) {
+	valid := func(edit func(*hotelpb.RatePlan)) *hotelpb.RatePlan {
+		p := &hotelpb.RatePlan{
+			Name:               "Breakfast, non-refundable",
+			MealPlan:           hotelpb.MealPlan_MEAL_PLAN_BREAKFAST,
+			PriceMultiplier:    0.9,
+			NightlySupplement:  15,
+			MinNights:          2,
+			CancellationPolicy: &hotelpb.CancellationPolicy{FreeCancellationHours: 48, PenaltyPercent: 100},
+		}
+		if edit != nil {
+			edit(p)
+		}
+		return p
+	}
+
+	tests := []struct {
+		name       string
+		plan       *hotelpb.RatePlan
+		wantFields []string
+	}{
+		{"valid", valid(nil), nil},
+		{"defaults", &hotelpb.RatePlan{Name: "Flexible"}, nil},
+		{"blank name", valid(func(p *hotelpb.RatePlan) { p.Name = "" }), []string{"name"}},
+		{"unknown meal plan", valid(func(p *hotelpb.RatePlan) { p.MealPlan = 99 }), []string{"meal_plan"}},
+		{"negative multiplier", valid(func(p *hotelpb.RatePlan) { p.PriceMultiplier = -1 }), []string{"price_multiplier"}},
+		{"infinite multiplier", valid(func(p *hotelpb.RatePlan) { p.PriceMultiplier = math.Inf(1) }), []string{"price_multiplier"}},
+		{"negative supplement", valid(func(p *hotelpb.RatePlan) { p.NightlySupplement = -5 }), []string{"nightly_supplement"}},
+		{"NaN supplement", valid(func(p *hotelpb.RatePlan) { p.NightlySupplement = math.NaN() }), []string{"nightly_supplement"}},
+		{"negative min nights", valid(func(p *hotelpb.RatePlan) { p.MinNights = -1 }), []string{"min_nights"}},
+		{
+			name:       "negative free cancellation",
+			plan:       valid(func(p *hotelpb.RatePlan) { p.CancellationPolicy.FreeCancellationHours = -1 }),
+			wantFields: []string{"cancellation_policy.free_cancellation_hours"},
+		},
+		{
+			name:       "penalty over 100",
+			plan:       valid(func(p *hotelpb.RatePlan) { p.CancellationPolicy.PenaltyPercent = 101 }),
+			wantFields: []string{"cancellation_policy.penalty_percent"},
+		},
+		{
+			name: "several",
+			plan: valid(func(p *hotelpb.RatePlan) {
+				p.Name, p.MinNights, p.CancellationPolicy.PenaltyPercent = "", -1, -1
+			}),
+			wantFields: []string{"name", "min_nights", "cancellation_policy.penalty_percent"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRatePlan(tt.plan)
+			if (err != nil) != (tt.wantFields != nil) || (err != nil && status.Code(err) != codes.InvalidArgument) {
+				t.Fatalf("ValidateRatePlan = %v, want violations %v", err, tt.wantFields)
+			}
+			if got := violations(err); !slices.Equal(got, tt.wantFields) {
+				t.Errorf("violations = %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestNights(t *testing.T) {
+	start := time.Date(2026, 6, 1, 14, 0, 0, 0, time.UTC)
+	tests := []struct {
+		end  time.Time
+		want int
+	}{
+		{start, 0},
+		{start.Add(time.Hour), 1},
+		{start.Add(24 * time.Hour), 1},
+		{start.Add(25 * time.Hour), 2},
+		{start.AddDate(0, 0, 7), 7},
+	}
+	for _, tt := range tests {
+		if got := Nights(start, tt.end); got != tt.want {
+			t.Errorf("Nights(%v) = %d, want %d", tt.end.Sub(start), got, tt.want)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	plan := &hotelpb.RatePlan{Active: true, RoomTypeIds: []string{"king", "twin"}, MinNights: 2}
+
+	tests := []struct {
+		name        string
+		plan        *hotelpb.RatePlan
+		roomType    string
+		nights      int
+		wantErr     bool
+		wantMinimum string
+	}{
+		{name: "bookable", plan: plan, roomType: "king", nights: 2},
+		{name: "any room type", plan: &hotelpb.RatePlan{Active: true}, roomType: "suite", nights: 1},
+		{name: "room without type", plan: plan, nights: 2, wantErr: true},
+		{name: "inactive", plan: &hotelpb.RatePlan{RoomTypeIds: []string{"king"}}, roomType: "king", nights: 2, wantErr: true},
+		{name: "other room type", plan: plan, roomType: "suite", nights: 2, wantErr: true},
+		{name: "too short", plan: plan, roomType: "twin", nights: 1, wantErr: true, wantMinimum: "2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Check(tt.plan, tt.roomType, tt.nights)
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("Check = %v, want nil", err)
+				}
+				return
+			}
+			if status.Code(err) != codes.FailedPrecondition || apierrors.ReasonOf(err) != apierrors.ReasonRatePlanUnavailable {
+				t.Fatalf("Check = %v, want FailedPrecondition %s", err, apierrors.ReasonRatePlanUnavailable)
+			}
+			if got := apierrors.InfoOf(err).GetMetadata()["min_nights"]; got != tt.wantMinimum {
+				t.Errorf("min_nights = %q, want %q", got, tt.wantMinimum)
+			}
+		})
+	}
+}
+
+func TestNightly(t *testing.T) {
+	tests := []struct {
+		name string
+		base float64
+		plan *hotelpb.RatePlan
+		want float64
+	}{
+		{"no plan", 100, nil, 100},
+		{"plan without rules", 100, &hotelpb.RatePlan{}, 100},
+		{"discount", 100, &hotelpb.RatePlan{PriceMultiplier: 0.85}, 85},
+		{"supplement after multiplier", 100, &hotelpb.RatePlan{PriceMultiplier: 0.9, NightlySupplement: 12.5}, 102.5},
+		{"rounded to cents", 99.99, &hotelpb.RatePlan{PriceMultiplier: 1.0 / 3}, 33.33},
+	}
+	for _, tt := range tests {
+		if got := Nightly(tt.base, tt.plan); got != tt.want {
+			t.Errorf("%s: Nightly = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package observability
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func (in *Instrumentation) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, c := in.startServer(ctx, info.FullMethod)
+		resp, err := handler(ctx, req)
+		c.end(ctx, err)
+		return resp, err
+	}
+}
+
+func (in *Instrumentation) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, c := in.startServer(ss.Context(), info.FullMethod)
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		c.end(ctx, err)
+		return err
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context { return s.ctx }
+
+func (in *Instrumentation) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, c := in.startClient(ctx, method)
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		c.end(ctx, err)
+		noteGatewayCall(ctx, method, err)
+		return err
+	}
+}
+
+func (in *Instrumentation) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, c := in.startClient(ctx, method)
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			c.end(ctx, err)
+			noteGatewayCall(ctx, method, err)
+			return nil, err
+		}
+		noteGatewayCall(ctx, method, nil)
+		s := &clientStream{ClientStream: cs, ctx: ctx, method: method, call: c, done: make(chan struct{})}
+		go s.watch()
+		return s, nil
+	}
+}
+
+// clientStream ends its span when the server closes the stream, a receive
+// fails or the stream's context is done, whichever happens first.
+type clientStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	method string
+	call   *call
+	once   sync.Once
+	done   chan struct{}
+}
+
+func (s *clientStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			s.finish(nil)
+		} else {
+			s.finish(err)
+		}
+	}
+	return err
+}
+
+// watch ends the call when the context is cancelled or expires before the
+// caller receives the stream's error.
+func (s *clientStream) watch() {
+	select {
+	case <-s.ctx.Done():
+		s.finish(status.FromContextError(s.ctx.Err()).Err())
+	case <-s.done:
+	}
+}
+
+func (s *clientStream) finish(err error) {
+	s.once.Do(func() {
+		close(s.done)
+		s.call.end(s.ctx, err)
+		if err != nil {
+			noteGatewayCall(s.ctx, s.method, err)
+		}
+	})
+}
+
+// ServerOptions installs the server interceptors.
+func (in *Instrumentation) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(in.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(in.StreamServerInterceptor()),
+	}
+}
+
+// DialOptions installs the client interceptors.
+func (in *Instrumentation) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(in.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(in.StreamClientInterceptor()),
+	}
+}
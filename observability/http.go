@@ -0,0 +1,104 @@
+package observability
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/status"
+)
+
+// gatewayCall collects the RPC made on behalf of an HTTP request, so the
+// middleware can label the request with it. The client interceptors fill it
+// in.
+type gatewayCall struct {
+	mu     sync.Mutex
+	method string
+	err    error
+}
+
+type gatewayCallKey struct{}
+
+func noteGatewayCall(ctx context.Context, method string, err error) {
+	gc, ok := ctx.Value(gatewayCallKey{}).(*gatewayCall)
+	if !ok {
+		return
+	}
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+
+	gc.method, gc.err = method, err
+}
+
+// Middleware traces gateway requests, counts them and records their
+// duration. Responses with a 5xx status count as errors. Trace
+// context from the incoming headers is continued and, when the gateway dials
+// backends with DialOptions, forwarded to them. Requests that reached a
+// backend are labelled with its RPC attributes and status code.
+func (in *Instrumentation) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := in.propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := in.tracer.Start(ctx, r.Method, trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attribute.String("http.request.method", r.Method),
+				attribute.String("url.path", r.URL.Path)))
+		gc := &gatewayCall{}
+		ctx = context.WithValue(ctx, gatewayCallKey{}, gc)
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+		next.ServeHTTP(rec, r.WithContext(ctx))
+		elapsed := time.Since(start)
+
+		attrs := []attribute.KeyValue{
+			attribute.String("http.request.method", r.Method),
+			attribute.Int("http.response.status_code", rec.status),
+		}
+		gc.mu.Lock()
+		if gc.method != "" {
+			attrs = append(attrs, MethodAttributes(gc.method)...)
+			attrs = append(attrs, codeAttribute(status.Code(gc.err)))
+			span.SetName(r.Method + " " + strings.TrimPrefix(gc.method, "/"))
+		}
+		gc.mu.Unlock()
+
+		span.SetAttributes(attrs...)
+		if rec.status >= http.StatusInternalServerError {
+			span.SetStatus(otelcodes.Error, http.StatusText(rec.status))
+		}
+		span.End()
+		in.http.record(ctx, elapsed, rec.status >= http.StatusInternalServerError, attrs...)
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	if !r.wroteHeader {
+		r.status, r.wroteHeader = code, true
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+// Flush keeps server-streaming responses working through the recorder.
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter { return r.ResponseWriter }
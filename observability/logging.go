@@ -0,0 +1,151 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/JunBSer/services_proto/options/fieldopts"
+)
+
+// Logging writes one slog record per RPC. Request and response messages are
+// passed through Redact first, so fields annotated (auth_options.sensitive)
+// never reach the log.
+type Logging struct {
+	// Logger defaults to slog.Default().
+	Logger *slog.Logger
+	// Redact defaults to fieldopts.Redact.
+	Redact func(proto.Message) proto.Message
+}
+
+func NewLogging(logger *slog.Logger) *Logging {
+	return &Logging{Logger: logger}
+}
+
+func (l *Logging) logger() *slog.Logger {
+	if l.Logger != nil {
+		return l.Logger
+	}
+	return slog.Default()
+}
+
+func (l *Logging) redact(m proto.Message) proto.Message {
+	if l.Redact != nil {
+		return l.Redact(m)
+	}
+	return fieldopts.Redact(m)
+}
+
+// level is Info for successful calls, Warn for caller errors and Error when
+// the server failed.
+func level(code codes.Code) slog.Level {
+	switch {
+	case code == codes.OK:
+		return slog.LevelInfo
+	case serverFault(code):
+		return slog.LevelError
+	default:
+		return slog.LevelWarn
+	}
+}
+
+func (l *Logging) log(ctx context.Context, msg, fullMethod string, start time.Time, err error, attrs ...slog.Attr) {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	code := status.Code(err)
+	attrs = append([]slog.Attr{
+		slog.String(string(KeyRPCService), service),
+		slog.String(string(KeyRPCMethod), method),
+		slog.String(string(KeyStatusCode), code.String()),
+		slog.Duration("duration", time.Since(start)),
+	}, attrs...)
+	if err != nil {
+		attrs = append(attrs, slog.String("error", status.Convert(err).Message()))
+	}
+	l.logger().LogAttrs(ctx, level(code), msg, attrs...)
+}
+
+// payload formats a message lazily, only when the record is enabled.
+type payload struct {
+	l *Logging
+	m any
+}
+
+func (p payload) LogValue() slog.Value {
+	m, ok := p.m.(proto.Message)
+	if !ok {
+		return slog.StringValue(fmt.Sprintf("%T", p.m))
+	}
+	b, err := protojson.Marshal(p.l.redact(m))
+	if err != nil {
+		return slog.StringValue(fmt.Sprintf("%T", p.m))
+	}
+	return slog.StringValue(string(b))
+}
+
+func (l *Logging) payloads(req, resp any, err error) []slog.Attr {
+	attrs := []slog.Attr{slog.Any("request", payload{l, req})}
+	if err == nil && resp != nil {
+		attrs = append(attrs, slog.Any("response", payload{l, resp}))
+	}
+	return attrs
+}
+
+func (l *Logging) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		l.log(ctx, "grpc server call", info.FullMethod, start, err, l.payloads(req, resp, err)...)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor logs streams once they finish. Stream messages are
+// counted, not logged.
+func (l *Logging) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		cs := &countingStream{ServerStream: ss}
+		err := handler(srv, cs)
+		l.log(ss.Context(), "grpc server stream", info.FullMethod, start, err,
+			slog.Int("messages_received", cs.received), slog.Int("messages_sent", cs.sent))
+		return err
+	}
+}
+
+func (l *Logging) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		l.log(ctx, "grpc client call", method, start, err, l.payloads(req, reply, err)...)
+		return err
+	}
+}
+
+type countingStream struct {
+	grpc.ServerStream
+	received, sent int
+}
+
+func (s *countingStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received++
+	}
+	return err
+}
+
+func (s *countingStream) SendMsg(m any) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent++
+	}
+	return err
+}
@@ -0,0 +1,105 @@
+package observability
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+)
+
+func TestLoggingUnary(t *testing.T) {
+	req := &authpb.LoginRequest{Email: "a@example.com", Password: "hunter2"}
+	resp := &authpb.LoginResponse{Tokens: &authpb.JWTPair{AccessToken: "access-secret", RefreshToken: "refresh-secret"}}
+
+	tests := []struct {
+		name         string
+		err          error
+		wantLevel    string
+		wantCode     string
+		wantResponse bool
+	}{
+		{"ok", nil, "INFO", "OK", true},
+		{"caller error", status.Error(codes.Unauthenticated, "bad password"), "WARN", "Unauthenticated", false},
+		{"server error", status.Error(codes.Internal, "db down"), "ERROR", "Internal", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			l := NewLogging(slog.New(slog.NewJSONHandler(&buf, nil)))
+			handler := func(context.Context, any) (any, error) {
+				if tt.err != nil {
+					return nil, tt.err
+				}
+				return resp, nil
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: "/proto.Auth/Login"}
+			if _, err := l.UnaryServerInterceptor()(context.Background(), req, info, handler); err != tt.err {
+				t.Fatalf("interceptor error = %v, want %v", err, tt.err)
+			}
+
+			out := buf.String()
+			for _, secret := range []string{"hunter2", "access-secret", "refresh-secret"} {
+				if strings.Contains(out, secret) {
+					t.Errorf("log contains %q: %s", secret, out)
+				}
+			}
+			var rec map[string]any
+			if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+				t.Fatalf("log is not one JSON record: %v\n%s", err, out)
+			}
+			if rec["level"] != tt.wantLevel || rec[string(KeyStatusCode)] != tt.wantCode ||
+				rec[string(KeyRPCService)] != "proto.Auth" || rec[string(KeyRPCMethod)] != "Login" {
+				t.Errorf("record = %v", rec)
+			}
+			if !strings.Contains(rec["request"].(string), "a@example.com") {
+				t.Errorf("request = %v, want the email kept", rec["request"])
+			}
+			if _, ok := rec["response"]; ok != tt.wantResponse {
+				t.Errorf("response logged = %v, want %v", ok, tt.wantResponse)
+			}
+		})
+	}
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s fakeServerStream) Context() context.Context { return s.ctx }
+func (s fakeServerStream) SendMsg(any) error        { return nil }
+func (s fakeServerStream) RecvMsg(any) error        { return nil }
+
+func TestLoggingStream(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewLogging(slog.New(slog.NewJSONHandler(&buf, nil)))
+	handler := func(_ any, ss grpc.ServerStream) error {
+		for range 3 {
+			if err := ss.SendMsg(&authpb.UserEvent{}); err != nil {
+				return err
+			}
+		}
+		return ss.RecvMsg(&authpb.StreamUserEventsRequest{})
+	}
+
+	info := &grpc.StreamServerInfo{FullMethod: "/proto.Auth/StreamUserEvents", IsServerStream: true}
+	if err := l.StreamServerInterceptor()(nil, fakeServerStream{ctx: context.Background()}, info, handler); err != nil {
+		t.Fatal(err)
+	}
+	var rec map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+		t.Fatal(err)
+	}
+	if rec["messages_sent"] != float64(3) || rec["messages_received"] != float64(1) {
+		t.Errorf("record = %v, want 3 sent and 1 received", rec)
+	}
+}
@@ -0,0 +1,237 @@
+// Package observability instruments the gRPC services, their clients and the
+// HTTP gateway with OpenTelemetry spans and RED (rate, errors, duration)
+// metrics: a request counter, an error counter and a duration histogram for
+// each of server RPCs, client RPCs and gateway requests. Spans and
+// measurements carry the service, method, auth_level and gRPC status code of
+// the call. Logging writes a slog record per call with sensitive fields
+// redacted.
+//
+// Instrumentation uses the global OpenTelemetry providers unless Options
+// names others. Tests can pass an SDK TracerProvider backed by
+// tracetest.NewInMemoryExporter and an SDK MeterProvider with a ManualReader
+// to inspect what was recorded.
+package observability
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/JunBSer/services_proto/options/methodopts"
+)
+
+const instrumentationName = "github.com/JunBSer/services_proto/observability"
+
+// Attribute keys. Service, method and status code follow the OpenTelemetry
+// RPC semantic conventions.
+const (
+	KeyRPCSystem  = attribute.Key("rpc.system")
+	KeyRPCService = attribute.Key("rpc.service")
+	KeyRPCMethod  = attribute.Key("rpc.method")
+	KeyStatusCode = attribute.Key("rpc.grpc.status_code")
+	KeyAuthLevel  = attribute.Key("rpc.auth_level")
+)
+
+type Options struct {
+	// TracerProvider defaults to otel.GetTracerProvider().
+	TracerProvider trace.TracerProvider
+	// MeterProvider defaults to otel.GetMeterProvider().
+	MeterProvider metric.MeterProvider
+	// Propagator defaults to otel.GetTextMapPropagator().
+	Propagator propagation.TextMapPropagator
+}
+
+// Instrumentation creates spans and records metrics. It is safe for
+// concurrent use.
+type Instrumentation struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+
+	server redMetrics
+	client redMetrics
+	http   redMetrics
+}
+
+// redMetrics are the request and error counters and the duration histogram
+// of one kind of call.
+type redMetrics struct {
+	requests metric.Int64Counter
+	errors   metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+// newRED creates the instruments prefix.requests, prefix.errors and
+// durationName. what describes the calls, e.g. "inbound RPCs".
+func newRED(meter metric.Meter, prefix, durationName, what string) (redMetrics, error) {
+	var (
+		m   redMetrics
+		err error
+	)
+	if m.requests, err = meter.Int64Counter(prefix+".requests",
+		metric.WithUnit("{request}"), metric.WithDescription("Number of "+what)); err != nil {
+		return m, err
+	}
+	if m.errors, err = meter.Int64Counter(prefix+".errors",
+		metric.WithUnit("{request}"), metric.WithDescription("Number of failed "+what)); err != nil {
+		return m, err
+	}
+	if m.duration, err = meter.Float64Histogram(durationName,
+		metric.WithUnit("s"), metric.WithDescription("Duration of "+what)); err != nil {
+		return m, err
+	}
+	return m, nil
+}
+
+// record counts a finished call and records its duration.
+func (m redMetrics) record(ctx context.Context, elapsed time.Duration, failed bool, attrs ...attribute.KeyValue) {
+	set := metric.WithAttributes(attrs...)
+	m.requests.Add(ctx, 1, set)
+	if failed {
+		m.errors.Add(ctx, 1, set)
+	}
+	m.duration.Record(ctx, elapsed.Seconds(), set)
+}
+
+func New(opts Options) (*Instrumentation, error) {
+	tp := opts.TracerProvider
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	mp := opts.MeterProvider
+	if mp == nil {
+		mp = otel.GetMeterProvider()
+	}
+	prop := opts.Propagator
+	if prop == nil {
+		prop = otel.GetTextMapPropagator()
+	}
+
+	meter := mp.Meter(instrumentationName)
+	in := &Instrumentation{
+		tracer:     tp.Tracer(instrumentationName),
+		propagator: prop,
+	}
+
+	var err error
+	if in.server, err = newRED(meter, "rpc.server", "rpc.server.duration", "inbound RPCs"); err != nil {
+		return nil, err
+	}
+	if in.client, err = newRED(meter, "rpc.client", "rpc.client.duration", "outbound RPCs"); err != nil {
+		return nil, err
+	}
+	if in.http, err = newRED(meter, "http.server", "http.server.request.duration", "gateway HTTP requests"); err != nil {
+		return nil, err
+	}
+	return in, nil
+}
+
+// MethodAttributes returns the service, method and auth_level attributes of a
+// gRPC full method name. Methods without an auth_level option are labelled
+// "UNSPECIFIED".
+func MethodAttributes(fullMethod string) []attribute.KeyValue {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	level := "UNSPECIFIED"
+	if l, ok := methodopts.AuthLevel(fullMethod); ok {
+		level = l.String()
+	}
+	return []attribute.KeyValue{
+		KeyRPCSystem.String("grpc"),
+		KeyRPCService.String(service),
+		KeyRPCMethod.String(method),
+		KeyAuthLevel.String(level),
+	}
+}
+
+func codeAttribute(code codes.Code) attribute.KeyValue {
+	return KeyStatusCode.Int64(int64(code))
+}
+
+// serverFault reports whether code means the server, not the caller, failed.
+// Following the semantic conventions only these mark server spans as errors.
+func serverFault(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented,
+		codes.Internal, codes.Unavailable, codes.DataLoss:
+		return true
+	}
+	return false
+}
+
+// call is an RPC in progress.
+type call struct {
+	span    trace.Span
+	start   time.Time
+	attrs   []attribute.KeyValue
+	metrics redMetrics
+	server  bool
+}
+
+// end finishes the span and records the call. Client calls count as failed
+// on any error, server calls only on a server fault.
+func (c *call) end(ctx context.Context, err error) {
+	code := status.Code(err)
+	failed := err != nil && (!c.server || serverFault(code))
+	c.span.SetAttributes(codeAttribute(code))
+	if failed {
+		c.span.RecordError(err)
+		c.span.SetStatus(otelcodes.Error, status.Convert(err).Message())
+	}
+	c.span.End()
+
+	attrs := append(c.attrs[:len(c.attrs):len(c.attrs)], codeAttribute(code))
+	c.metrics.record(ctx, time.Since(c.start), failed, attrs...)
+}
+
+func (in *Instrumentation) startServer(ctx context.Context, fullMethod string) (context.Context, *call) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = in.propagator.Extract(ctx, metadataCarrier(md))
+
+	attrs := MethodAttributes(fullMethod)
+	ctx, span := in.tracer.Start(ctx, strings.TrimPrefix(fullMethod, "/"),
+		trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(attrs...))
+	return ctx, &call{span: span, start: time.Now(), attrs: attrs, metrics: in.server, server: true}
+}
+
+func (in *Instrumentation) startClient(ctx context.Context, fullMethod string) (context.Context, *call) {
+	attrs := MethodAttributes(fullMethod)
+	ctx, span := in.tracer.Start(ctx, strings.TrimPrefix(fullMethod, "/"),
+		trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	in.propagator.Inject(ctx, metadataCarrier(md))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+	return ctx, &call{span: span, start: time.Now(), attrs: attrs, metrics: in.client}
+}
+
+// metadataCarrier adapts gRPC metadata to propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if v := metadata.MD(c).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
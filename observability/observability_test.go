@@ -0,0 +1,281 @@
+package observability
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+)
+
+// testEnv records spans in memory and metrics in a manual reader.
+type testEnv struct {
+	in     *Instrumentation
+	spans  *tracetest.InMemoryExporter
+	reader *sdkmetric.ManualReader
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+
+	spans := tracetest.NewInMemoryExporter()
+	reader := sdkmetric.NewManualReader()
+	in, err := New(Options{
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSyncer(spans)),
+		MeterProvider:  sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+		Propagator:     propagation.TraceContext{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testEnv{in: in, spans: spans, reader: reader}
+}
+
+// count sums the data points of the counter name whose attributes include
+// attr.
+func (e *testEnv) count(t *testing.T, name string, attr attribute.KeyValue) int64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	if err := e.reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	var n int64
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != name {
+				continue
+			}
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				if v, ok := dp.Attributes.Value(attr.Key); ok && v == attr.Value {
+					n += dp.Value
+				}
+			}
+		}
+	}
+	return n
+}
+
+// span returns the ended span with the given name and kind.
+func (e *testEnv) span(t *testing.T, name string, kind trace.SpanKind) tracetest.SpanStub {
+	t.Helper()
+
+	for _, s := range e.spans.GetSpans() {
+		if s.Name == name && s.SpanKind == kind {
+			return s
+		}
+	}
+	t.Fatalf("no %v span %q among %d spans", kind, name, len(e.spans.GetSpans()))
+	return tracetest.SpanStub{}
+}
+
+func spanAttr(s tracetest.SpanStub, key attribute.Key) attribute.Value {
+	for _, kv := range s.Attributes {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+// hotelServer fails GetHotel with the error named by the hotel ID and keeps
+// WatchCatalog streams open until the client goes away.
+type hotelServer struct {
+	hotelpb.UnimplementedHotelServiceServer
+}
+
+func (hotelServer) GetHotel(_ context.Context, req *hotelpb.GetHotelRequest) (*hotelpb.Hotel, error) {
+	switch req.GetId() {
+	case "missing":
+		return nil, status.Error(codes.NotFound, "hotel not found")
+	case "broken":
+		return nil, status.Error(codes.Internal, "database down")
+	}
+	return &hotelpb.Hotel{Id: req.GetId()}, nil
+}
+
+func (hotelServer) WatchCatalog(_ *hotelpb.WatchCatalogRequest, stream hotelpb.HotelService_WatchCatalogServer) error {
+	<-stream.Context().Done()
+	return stream.Context().Err()
+}
+
+func startHotelServer(t *testing.T, in *Instrumentation) hotelpb.HotelServiceClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer(in.ServerOptions()...)
+	hotelpb.RegisterHotelServiceServer(gs, hotelServer{})
+	go gs.Serve(lis)
+	t.Cleanup(gs.Stop)
+
+	opts := append(in.DialOptions(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient("passthrough:///bufnet", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return hotelpb.NewHotelServiceClient(conn)
+}
+
+func TestMethodAttributes(t *testing.T) {
+	tests := []struct {
+		method    string
+		service   string
+		name      string
+		authLevel string
+	}{
+		{hotelpb.HotelService_GetHotel_FullMethodName, "hotel.HotelService", "GetHotel", "USER"},
+		{hotelpb.HotelService_CreateHotel_FullMethodName, "hotel.HotelService", "CreateHotel", "ADMIN"},
+		{"/unknown.Service/Call", "unknown.Service", "Call", "UNSPECIFIED"},
+	}
+	for _, tt := range tests {
+		got := attribute.NewSet(MethodAttributes(tt.method)...)
+		want := attribute.NewSet(KeyRPCSystem.String("grpc"), KeyRPCService.String(tt.service),
+			KeyRPCMethod.String(tt.name), KeyAuthLevel.String(tt.authLevel))
+		if !got.Equals(&want) {
+			t.Errorf("MethodAttributes(%s) = %v, want %v", tt.method, got.ToSlice(), want.ToSlice())
+		}
+	}
+}
+
+func TestUnaryInstrumentation(t *testing.T) {
+	tests := []struct {
+		hotelID          string
+		code             codes.Code
+		serverSpanStatus otelcodes.Code
+		clientSpanStatus otelcodes.Code
+		serverErrors     int64
+		clientErrors     int64
+	}{
+		{"h-1", codes.OK, otelcodes.Unset, otelcodes.Unset, 0, 0},
+		{"missing", codes.NotFound, otelcodes.Unset, otelcodes.Error, 0, 1},
+		{"broken", codes.Internal, otelcodes.Error, otelcodes.Error, 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code.String(), func(t *testing.T) {
+			env := newTestEnv(t)
+			hotels := startHotelServer(t, env.in)
+
+			_, err := hotels.GetHotel(context.Background(), &hotelpb.GetHotelRequest{Id: tt.hotelID})
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("GetHotel code = %v, want %v", got, tt.code)
+			}
+
+			const name = "hotel.HotelService/GetHotel"
+			server := env.span(t, name, trace.SpanKindServer)
+			client := env.span(t, name, trace.SpanKindClient)
+			if server.Parent.TraceID() != client.SpanContext.TraceID() || server.Parent.SpanID() != client.SpanContext.SpanID() {
+				t.Error("server span is not a child of the client span")
+			}
+			for _, s := range []struct {
+				span tracetest.SpanStub
+				want otelcodes.Code
+			}{{server, tt.serverSpanStatus}, {client, tt.clientSpanStatus}} {
+				if s.span.Status.Code != s.want {
+					t.Errorf("%v span status = %v, want %v", s.span.SpanKind, s.span.Status.Code, s.want)
+				}
+				if got := spanAttr(s.span, KeyStatusCode).AsInt64(); got != int64(tt.code) {
+					t.Errorf("%v span %s = %d, want %d", s.span.SpanKind, KeyStatusCode, got, tt.code)
+				}
+				if got := spanAttr(s.span, KeyAuthLevel).AsString(); got != "USER" {
+					t.Errorf("%v span %s = %q, want USER", s.span.SpanKind, KeyAuthLevel, got)
+				}
+			}
+
+			method := KeyRPCMethod.String("GetHotel")
+			for _, c := range []struct {
+				name string
+				want int64
+			}{
+				{"rpc.server.requests", 1},
+				{"rpc.server.errors", tt.serverErrors},
+				{"rpc.client.requests", 1},
+				{"rpc.client.errors", tt.clientErrors},
+			} {
+				if got := env.count(t, c.name, method); got != c.want {
+					t.Errorf("%s = %d, want %d", c.name, got, c.want)
+				}
+			}
+		})
+	}
+}
+
+func TestClientStreamEndsOnCancel(t *testing.T) {
+	env := newTestEnv(t)
+	client := startHotelServer(t, env.in)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if _, err := client.WatchCatalog(ctx, &hotelpb.WatchCatalogRequest{}); err != nil {
+		t.Fatalf("WatchCatalog: %v", err)
+	}
+	// Cancel without ever calling Recv: the span must still end.
+	cancel()
+
+	const name = "hotel.HotelService/WatchCatalog"
+	deadline := time.Now().Add(5 * time.Second)
+	for env.count(t, "rpc.client.requests", KeyRPCMethod.String("WatchCatalog")) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("client stream span did not end after cancel")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	span := env.span(t, name, trace.SpanKindClient)
+	if got := spanAttr(span, KeyStatusCode).AsInt64(); got != int64(codes.Canceled) {
+		t.Errorf("span %s = %d, want %d", KeyStatusCode, got, codes.Canceled)
+	}
+	if got := env.count(t, "rpc.client.errors", KeyRPCMethod.String("WatchCatalog")); got != 1 {
+		t.Errorf("rpc.client.errors = %d, want 1", got)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		status     int
+		wantErrors int64
+		wantSpan   otelcodes.Code
+	}{
+		{http.StatusOK, 0, otelcodes.Unset},
+		{http.StatusNotFound, 0, otelcodes.Unset},
+		{http.StatusServiceUnavailable, 1, otelcodes.Error},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			env := newTestEnv(t)
+			h := env.in.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/hotels", nil))
+
+			span := env.span(t, http.MethodGet, trace.SpanKindServer)
+			if span.Status.Code != tt.wantSpan {
+				t.Errorf("span status = %v, want %v", span.Status.Code, tt.wantSpan)
+			}
+			code := attribute.Int("http.response.status_code", tt.status)
+			if got := env.count(t, "http.server.requests", code); got != 1 {
+				t.Errorf("http.server.requests = %d, want 1", got)
+			}
+			if got := env.count(t, "http.server.errors", code); got != tt.wantErrors {
+				t.Errorf("http.server.errors = %d, want %d", got, tt.wantErrors)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package openapi
+
+import (
+	"strings"
+)
+
+// parameterSchemaKeys are the OpenAPI v2 parameter fields that move into the
+// parameter's schema in OpenAPI 3.
+var parameterSchemaKeys = []string{
+	"type", "format", "items", "enum", "default", "maximum", "minimum",
+	"exclusiveMaximum", "exclusiveMinimum", "maxLength", "minLength",
+	"pattern", "maxItems", "minItems", "uniqueItems", "multipleOf",
+}
+
+// convertV3 converts a merged OpenAPI v2 document to OpenAPI 3.1.
+func convertV3(v2 map[string]any) map[string]any {
+	out := map[string]any{
+		"openapi": "3.1.0",
+		"info":    v2["info"],
+		"tags":    v2["tags"],
+		"servers": servers(v2),
+	}
+
+	schemas := map[string]any{}
+	for name, def := range asMap(v2["definitions"]) {
+		schemas[name] = rewriteRefs(def)
+	}
+	out["components"] = map[string]any{
+		"schemas": schemas,
+		"securitySchemes": map[string]any{
+			SecuritySchemeName: map[string]any{
+				"type":         "http",
+				"scheme":       "bearer",
+				"bearerFormat": "JWT",
+				"description":  unifiedSecurityScheme["description"],
+			},
+		},
+	}
+
+	paths := map[string]any{}
+	for path, item := range asMap(v2["paths"]) {
+		converted := map[string]any{}
+		for method, op := range asMap(item) {
+			if isMethod(method) {
+				converted[method] = convertOperation(asMap(op))
+			}
+		}
+		paths[path] = converted
+	}
+	out["paths"] = paths
+	return out
+}
+
+func servers(v2 map[string]any) []any {
+	host, _ := v2["host"].(string)
+	base, _ := v2["basePath"].(string)
+	if host == "" {
+		return []any{map[string]any{"url": "/" + strings.TrimPrefix(base, "/")}}
+	}
+
+	var out []any
+	for _, s := range asSlice(v2["schemes"]) {
+		out = append(out, map[string]any{"url": s.(string) + "://" + host + base})
+	}
+	if out == nil {
+		out = []any{map[string]any{"url": "//" + host + base}}
+	}
+	return out
+}
+
+func convertOperation(op map[string]any) map[string]any {
+	out := map[string]any{}
+	for k, v := range op {
+		switch k {
+		case "parameters", "responses", "consumes", "produces", "schemes":
+		default:
+			out[k] = v
+		}
+	}
+
+	var params []any
+	for _, p := range asSlice(op["parameters"]) {
+		pm := asMap(p)
+		if pm["in"] == "body" {
+			out["requestBody"] = map[string]any{
+				"required": pm["required"] == true,
+				"content": map[string]any{
+					"application/json": map[string]any{"schema": rewriteRefs(pm["schema"])},
+				},
+			}
+			continue
+		}
+		params = append(params, convertParameter(pm))
+	}
+	if params != nil {
+		out["parameters"] = params
+	}
+
+	responses := map[string]any{}
+	for code, r := range asMap(op["responses"]) {
+		rm := asMap(r)
+		resp := map[string]any{"description": rm["description"]}
+		if resp["description"] == nil {
+			resp["description"] = ""
+		}
+		if schema, ok := rm["schema"]; ok {
+			resp["content"] = map[string]any{
+				"application/json": map[string]any{"schema": rewriteRefs(schema)},
+			}
+		}
+		responses[code] = resp
+	}
+	out["responses"] = responses
+	return out
+}
+
+func convertParameter(p map[string]any) map[string]any {
+	out := map[string]any{}
+	schema := map[string]any{}
+	for k, v := range p {
+		switch k {
+		case "collectionFormat":
+			if v == "multi" {
+				out["style"] = "form"
+				out["explode"] = true
+			}
+		default:
+			if contains(parameterSchemaKeys, k) {
+				schema[k] = rewriteRefs(v)
+			} else {
+				out[k] = v
+			}
+		}
+	}
+	if len(schema) > 0 {
+		out["schema"] = schema
+	}
+	return out
+}
+
+// rewriteRefs returns a deep copy of v with v2 definition references pointing
+// to components/schemas.
+func rewriteRefs(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		for k, val := range t {
+			if k == "$ref" {
+				if ref, ok := val.(string); ok {
+					out[k] = strings.Replace(ref, "#/definitions/", "#/components/schemas/", 1)
+					continue
+				}
+			}
+			out[k] = rewriteRefs(val)
+		}
+		return out
+	case []any:
+		out := make([]any, len(t))
+		for i, val := range t {
+			out[i] = rewriteRefs(val)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package openapi
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Handler serves the documentation under the path it is mounted at:
+//
+//	/                           documentation UI
+//	/openapi.json               merged OpenAPI v2 document
+//	/openapi.v3.json            merged OpenAPI 3.1 document
+//	/specs/{service}.swagger.json  per-service OpenAPI v2 document
+//
+// Mount it with http.StripPrefix when serving below the root.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		path := "/" + strings.TrimPrefix(r.URL.Path, "/")
+		switch {
+		case path == "/" || path == "/index.html":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.Header().Set("Content-Security-Policy", "default-src 'self'; script-src 'unsafe-inline'; style-src 'unsafe-inline'")
+			_, _ = w.Write(indexHTML)
+		case path == "/openapi.json":
+			serveJSON(w, Merged)
+		case path == "/openapi.v3.json":
+			serveJSON(w, V3)
+		case strings.HasPrefix(path, "/specs/") && strings.HasSuffix(path, ".swagger.json"):
+			service := strings.TrimSuffix(strings.TrimPrefix(path, "/specs/"), ".swagger.json")
+			b, err := Spec(service)
+			if err != nil {
+				http.NotFound(w, r)
+				return
+			}
+			serveJSON(w, func() ([]byte, error) { return b, nil })
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+func serveJSON(w http.ResponseWriter, load func() ([]byte, error)) {
+	b, err := load()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	_, _ = w.Write(b)
+}
@@ -0,0 +1,159 @@
+package openapi
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// SecuritySchemeName is the name every operation's security requirement
+// refers to in the merged document.
+const SecuritySchemeName = "bearerAuth"
+
+var unifiedSecurityScheme = map[string]any{
+	"type":        "apiKey",
+	"in":          "header",
+	"name":        "Authorization",
+	"description": "JWT access token in format: Bearer <token>",
+}
+
+var httpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+
+func merge() (map[string]any, error) {
+	out := map[string]any{
+		"swagger": "2.0",
+		"info": map[string]any{
+			"title":       "Hotel Booking Platform API",
+			"description": "Auth, Hotel and Booking services exposed through the API gateway",
+			"version":     "1.0",
+		},
+		"consumes": []any{"application/json"},
+		"produces": []any{"application/json"},
+		"securityDefinitions": map[string]any{
+			SecuritySchemeName: unifiedSecurityScheme,
+		},
+	}
+
+	var (
+		tags        []any
+		seenTags    = map[string]bool{}
+		schemes     []any
+		seenSchemes = map[string]bool{}
+		paths       = map[string]any{}
+		definitions = map[string]any{}
+	)
+
+	for _, service := range Services {
+		doc, err := load(service)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range asSlice(doc["tags"]) {
+			name, _ := asMap(t)["name"].(string)
+			if !seenTags[name] {
+				seenTags[name] = true
+				tags = append(tags, t)
+			}
+		}
+		for _, s := range asSlice(doc["schemes"]) {
+			if name, _ := s.(string); !seenSchemes[name] {
+				seenSchemes[name] = true
+				schemes = append(schemes, s)
+			}
+		}
+
+		renames := securityRenames(asMap(doc["securityDefinitions"]))
+		global := doc["security"]
+
+		for path, item := range asMap(doc["paths"]) {
+			merged := asMap(paths[path])
+			if merged == nil {
+				merged = map[string]any{}
+				paths[path] = merged
+			}
+			for method, op := range asMap(item) {
+				if _, dup := merged[method]; dup {
+					return nil, fmt.Errorf("openapi: %s %s defined by more than one service", method, path)
+				}
+				if opMap := asMap(op); opMap != nil && isMethod(method) {
+					if _, ok := opMap["security"]; !ok && global != nil {
+						opMap["security"] = global
+					}
+					if sec, ok := opMap["security"]; ok {
+						opMap["security"] = renameSecurity(sec, renames)
+					}
+				}
+				merged[method] = op
+			}
+		}
+
+		for name, def := range asMap(doc["definitions"]) {
+			if existing, ok := definitions[name]; ok && !reflect.DeepEqual(existing, def) {
+				return nil, fmt.Errorf("openapi: conflicting definitions for %q", name)
+			}
+			definitions[name] = def
+		}
+	}
+
+	if len(schemes) > 0 {
+		out["schemes"] = schemes
+	}
+	out["tags"] = tags
+	out["paths"] = paths
+	out["definitions"] = definitions
+	return out, nil
+}
+
+// securityRenames maps every scheme a service declares to the unified name.
+func securityRenames(defs map[string]any) map[string]string {
+	renames := make(map[string]string, len(defs))
+	for name := range defs {
+		renames[name] = SecuritySchemeName
+	}
+	return renames
+}
+
+func renameSecurity(sec any, renames map[string]string) any {
+	reqs := asSlice(sec)
+	if reqs == nil {
+		return sec
+	}
+	out := make([]any, 0, len(reqs))
+	for _, req := range reqs {
+		renamed := map[string]any{}
+		keys := make([]string, 0, len(asMap(req)))
+		for k := range asMap(req) {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			name := k
+			if r, ok := renames[k]; ok {
+				name = r
+			}
+			renamed[name] = asMap(req)[k]
+		}
+		out = append(out, renamed)
+	}
+	return out
+}
+
+func isMethod(s string) bool {
+	for _, m := range httpMethods {
+		if s == m {
+			return true
+		}
+	}
+	return false
+}
+
+func asMap(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	return m
+}
+
+func asSlice(v any) []any {
+	s, _ := v.([]any)
+	return s
+}
@@ -0,0 +1,67 @@
+// Package openapi embeds the OpenAPI v2 documents generated from the
+// service protos and serves them, merged into one specification, together
+// with a self-hosted documentation UI.
+package openapi
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// Generated with protoc-gen-openapiv2 from proto/*.proto.
+//
+//go:embed gen/*.swagger.json
+var generated embed.FS
+
+//go:embed ui/index.html
+var indexHTML []byte
+
+// Services lists the embedded service specifications in merge order.
+var Services = []string{"auth", "hotel", "booking"}
+
+// Spec returns the generated OpenAPI v2 document of a single service.
+func Spec(service string) ([]byte, error) {
+	b, err := generated.ReadFile("gen/" + service + ".swagger.json")
+	if err != nil {
+		return nil, fmt.Errorf("openapi: unknown service %q", service)
+	}
+	return b, nil
+}
+
+var (
+	mergedOnce = sync.OnceValues(func() ([]byte, error) {
+		doc, err := merge()
+		if err != nil {
+			return nil, err
+		}
+		return json.MarshalIndent(doc, "", "  ")
+	})
+	v3Once = sync.OnceValues(func() ([]byte, error) {
+		doc, err := merge()
+		if err != nil {
+			return nil, err
+		}
+		return json.MarshalIndent(convertV3(doc), "", "  ")
+	})
+)
+
+// Merged returns a single OpenAPI v2 document covering all services with a
+// unified "bearerAuth" security scheme.
+func Merged() ([]byte, error) { return mergedOnce() }
+
+// V3 returns the merged document converted to OpenAPI 3.1.
+func V3() ([]byte, error) { return v3Once() }
+
+func load(service string) (map[string]any, error) {
+	b, err := Spec(service)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("openapi: decode %s: %w", service, err)
+	}
+	return doc, nil
+}
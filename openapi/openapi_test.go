@@ -0,0 +1,207 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		method      string
+		path        string
+		wantStatus  int
+		wantType    string
+		wantContain string
+	}{
+		{http.MethodGet, "/", http.StatusOK, "text/html; charset=utf-8", "<html"},
+		{http.MethodGet, "/index.html", http.StatusOK, "text/html; charset=utf-8", "<html"},
+		{http.MethodGet, "/openapi.json", http.StatusOK, "application/json", `"swagger": "2.0"`},
+		{http.MethodGet, "/openapi.v3.json", http.StatusOK, "application/json", `"openapi": "3.1.0"`},
+		{http.MethodHead, "/openapi.json", http.StatusOK, "application/json", ""},
+		{http.MethodGet, "/specs/hotel.swagger.json", http.StatusOK, "application/json", `"swagger"`},
+		{http.MethodGet, "/specs/payments.swagger.json", http.StatusNotFound, "", ""},
+		{http.MethodGet, "/specs/../openapi.go", http.StatusNotFound, "", ""},
+		{http.MethodGet, "/missing", http.StatusNotFound, "", ""},
+		{http.MethodPost, "/openapi.json", http.StatusMethodNotAllowed, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			Handler().ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantType != "" && rec.Header().Get("Content-Type") != tt.wantType {
+				t.Errorf("Content-Type = %q, want %q", rec.Header().Get("Content-Type"), tt.wantType)
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantContain) {
+				t.Errorf("body does not contain %q", tt.wantContain)
+			}
+			if tt.wantStatus == http.StatusMethodNotAllowed && rec.Header().Get("Allow") != "GET, HEAD" {
+				t.Errorf("Allow = %q", rec.Header().Get("Allow"))
+			}
+		})
+	}
+}
+
+func decode(t *testing.T, load func() ([]byte, error)) map[string]any {
+	t.Helper()
+
+	b, err := load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestMerged(t *testing.T) {
+	doc := decode(t, Merged)
+
+	if defs := asMap(doc["securityDefinitions"]); len(defs) != 1 || defs[SecuritySchemeName] == nil {
+		t.Errorf("securityDefinitions = %v, want only %s", defs, SecuritySchemeName)
+	}
+
+	paths := asMap(doc["paths"])
+	for _, service := range Services {
+		spec, err := load(service)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for path, item := range asMap(spec["paths"]) {
+			for method := range asMap(item) {
+				if asMap(paths[path])[method] == nil {
+					t.Errorf("%s: %s %s missing from merged document", service, method, path)
+				}
+			}
+		}
+	}
+
+	for path, item := range paths {
+		for method, op := range asMap(item) {
+			for _, req := range asSlice(asMap(op)["security"]) {
+				for name := range asMap(req) {
+					if name != SecuritySchemeName {
+						t.Errorf("%s %s requires scheme %q", method, path, name)
+					}
+				}
+			}
+		}
+	}
+
+	definitions := asMap(doc["definitions"])
+	b, _ := json.Marshal(doc)
+	for _, ref := range bytes.Split(b, []byte(`"$ref":"#/definitions/`))[1:] {
+		name := string(ref[:bytes.IndexByte(ref, '"')])
+		if definitions[name] == nil {
+			t.Errorf("reference to undefined definition %q", name)
+		}
+	}
+}
+
+func TestV3(t *testing.T) {
+	doc := decode(t, V3)
+	b, _ := json.Marshal(doc)
+	if bytes.Contains(b, []byte("#/definitions/")) {
+		t.Error("v3 document still references #/definitions/")
+	}
+
+	schemas := asMap(asMap(doc["components"])["schemas"])
+	for _, ref := range bytes.Split(b, []byte(`"$ref":"#/components/schemas/`))[1:] {
+		name := string(ref[:bytes.IndexByte(ref, '"')])
+		if schemas[name] == nil {
+			t.Errorf("reference to undefined schema %q", name)
+		}
+	}
+
+	for path, item := range asMap(doc["paths"]) {
+		for method, op := range asMap(item) {
+			for _, p := range asSlice(asMap(op)["parameters"]) {
+				if in := asMap(p)["in"]; in == "body" {
+					t.Errorf("%s %s keeps a body parameter", method, path)
+				}
+			}
+		}
+	}
+}
+
+func TestConvertParameter(t *testing.T) {
+	tests := []struct {
+		name string
+		in   map[string]any
+		want map[string]any
+	}{
+		{
+			name: "scalar",
+			in:   map[string]any{"name": "page", "in": "query", "type": "integer", "format": "int32"},
+			want: map[string]any{"name": "page", "in": "query", "schema": map[string]any{"type": "integer", "format": "int32"}},
+		},
+		{
+			name: "repeated",
+			in: map[string]any{
+				"name": "ids", "in": "query", "type": "array", "collectionFormat": "multi",
+				"items": map[string]any{"type": "string"},
+			},
+			want: map[string]any{
+				"name": "ids", "in": "query", "style": "form", "explode": true,
+				"schema": map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+			},
+		},
+		{
+			name: "enum",
+			in:   map[string]any{"name": "status", "in": "query", "required": true, "enum": []any{"A", "B"}, "default": "A"},
+			want: map[string]any{"name": "status", "in": "query", "required": true, "schema": map[string]any{"enum": []any{"A", "B"}, "default": "A"}},
+		},
+	}
+	for _, tt := range tests {
+		if got := convertParameter(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: convertParameter = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestConvertOperationBody(t *testing.T) {
+	op := convertOperation(map[string]any{
+		"operationId": "Create",
+		"parameters": []any{
+			map[string]any{"name": "body", "in": "body", "required": true, "schema": map[string]any{"$ref": "#/definitions/Hotel"}},
+			map[string]any{"name": "id", "in": "path", "type": "string"},
+		},
+		"responses": map[string]any{
+			"200": map[string]any{"description": "OK", "schema": map[string]any{"$ref": "#/definitions/Hotel"}},
+			"404": map[string]any{},
+		},
+	})
+
+	want := map[string]any{
+		"operationId": "Create",
+		"requestBody": map[string]any{
+			"required": true,
+			"content": map[string]any{
+				"application/json": map[string]any{"schema": map[string]any{"$ref": "#/components/schemas/Hotel"}},
+			},
+		},
+		"parameters": []any{map[string]any{"name": "id", "in": "path", "schema": map[string]any{"type": "string"}}},
+		"responses": map[string]any{
+			"200": map[string]any{
+				"description": "OK",
+				"content": map[string]any{
+					"application/json": map[string]any{"schema": map[string]any{"$ref": "#/components/schemas/Hotel"}},
+				},
+			},
+			"404": map[string]any{"description": ""},
+		},
+	}
+	if !reflect.DeepEqual(op, want) {
+		t.Errorf("convertOperation = %v, want %v", op, want)
+	}
+}
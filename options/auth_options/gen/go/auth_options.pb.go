@@ -28,6 +28,8 @@ const (
 	AuthLevel_NONE  AuthLevel = 0
 	AuthLevel_USER  AuthLevel = 1
 	AuthLevel_ADMIN AuthLevel = 2
+	// Callable only with a client credentials token issued to a service account.
+	AuthLevel_SERVICE AuthLevel = 3
 )
 
 // Enum value maps for AuthLevel.
@@ -36,11 +38,13 @@ var (
 		0: "NONE",
 		1: "USER",
 		2: "ADMIN",
+		3: "SERVICE",
 	}
 	AuthLevel_value = map[string]int32{
-		"NONE":  0,
-		"USER":  1,
-		"ADMIN": 2,
+		"NONE":    0,
+		"USER":    1,
+		"ADMIN":   2,
+		"SERVICE": 3,
 	}
 )
 
@@ -71,6 +75,181 @@ func (AuthLevel) EnumDescriptor() ([]byte, []int) {
 	return file_proto_auth_options_proto_rawDescGZIP(), []int{0}
 }
 
+// Selects whose calls share a rate limit bucket.
+type RateLimitKey int32
+
+const (
+	RateLimitKey_RATE_LIMIT_KEY_UNSPECIFIED RateLimitKey = 0
+	// Client IP address from x-forwarded-for, falling back to the peer address.
+	RateLimitKey_RATE_LIMIT_KEY_IP RateLimitKey = 1
+	// The email field of the request, case-insensitively.
+	RateLimitKey_RATE_LIMIT_KEY_EMAIL RateLimitKey = 2
+	// The authenticated user.
+	RateLimitKey_RATE_LIMIT_KEY_USER RateLimitKey = 3
+)
+
+// Enum value maps for RateLimitKey.
+var (
+	RateLimitKey_name = map[int32]string{
+		0: "RATE_LIMIT_KEY_UNSPECIFIED",
+		1: "RATE_LIMIT_KEY_IP",
+		2: "RATE_LIMIT_KEY_EMAIL",
+		3: "RATE_LIMIT_KEY_USER",
+	}
+	RateLimitKey_value = map[string]int32{
+		"RATE_LIMIT_KEY_UNSPECIFIED": 0,
+		"RATE_LIMIT_KEY_IP":          1,
+		"RATE_LIMIT_KEY_EMAIL":       2,
+		"RATE_LIMIT_KEY_USER":        3,
+	}
+)
+
+func (x RateLimitKey) Enum() *RateLimitKey {
+	p := new(RateLimitKey)
+	*p = x
+	return p
+}
+
+func (x RateLimitKey) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RateLimitKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_auth_options_proto_enumTypes[1].Descriptor()
+}
+
+func (RateLimitKey) Type() protoreflect.EnumType {
+	return &file_proto_auth_options_proto_enumTypes[1]
+}
+
+func (x RateLimitKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RateLimitKey.Descriptor instead.
+func (RateLimitKey) EnumDescriptor() ([]byte, []int) {
+	return file_proto_auth_options_proto_rawDescGZIP(), []int{1}
+}
+
+// A token bucket holding up to requests tokens that refills at
+// requests per window_seconds.
+type RateLimit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      uint32                 `protobuf:"varint,1,opt,name=requests,proto3" json:"requests,omitempty"`
+	WindowSeconds uint32                 `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	Key           RateLimitKey           `protobuf:"varint,3,opt,name=key,proto3,enum=auth_options.RateLimitKey" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
+	mi := &file_proto_auth_options_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit) ProtoMessage() {}
+
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_options_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return file_proto_auth_options_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RateLimit) GetRequests() uint32 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *RateLimit) GetWindowSeconds() uint32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *RateLimit) GetKey() RateLimitKey {
+	if x != nil {
+		return x.Key
+	}
+	return RateLimitKey_RATE_LIMIT_KEY_UNSPECIFIED
+}
+
+// Requires a step-up token from Auth.Reauthenticate, sent in the
+// x-reauth-token header and issued at most max_age_seconds ago.
+type RecentAuth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxAgeSeconds uint32                 `protobuf:"varint,1,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+	// When not empty, only calls setting one of these request fields are
+	// guarded, e.g. the email of UpdateProfile. Servers may let a field
+	// through unguarded when it repeats the caller's current value.
+	WhenSet       []string `protobuf:"bytes,2,rep,name=when_set,json=whenSet,proto3" json:"when_set,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecentAuth) Reset() {
+	*x = RecentAuth{}
+	mi := &file_proto_auth_options_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecentAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecentAuth) ProtoMessage() {}
+
+func (x *RecentAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_options_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecentAuth.ProtoReflect.Descriptor instead.
+func (*RecentAuth) Descriptor() ([]byte, []int) {
+	return file_proto_auth_options_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecentAuth) GetMaxAgeSeconds() uint32 {
+	if x != nil {
+		return x.MaxAgeSeconds
+	}
+	return 0
+}
+
+func (x *RecentAuth) GetWhenSet() []string {
+	if x != nil {
+		return x.WhenSet
+	}
+	return nil
+}
+
 var file_proto_auth_options_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
@@ -80,25 +259,82 @@ var file_proto_auth_options_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "varint,50002,opt,name=auth_level,enum=auth_options.AuthLevel",
 		Filename:      "proto/auth_options.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: ([]*RateLimit)(nil),
+		Field:         50004,
+		Name:          "auth_options.rate_limit",
+		Tag:           "bytes,50004,rep,name=rate_limit",
+		Filename:      "proto/auth_options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*RecentAuth)(nil),
+		Field:         50005,
+		Name:          "auth_options.require_recent_auth",
+		Tag:           "bytes,50005,opt,name=require_recent_auth",
+		Filename:      "proto/auth_options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         50003,
+		Name:          "auth_options.sensitive",
+		Tag:           "varint,50003,opt,name=sensitive",
+		Filename:      "proto/auth_options.proto",
+	},
 }
 
 // Extension fields to descriptorpb.MethodOptions.
 var (
 	// optional auth_options.AuthLevel auth_level = 50002;
 	E_AuthLevel = &file_proto_auth_options_proto_extTypes[0]
+	// Every listed limit must allow a call.
+	//
+	// repeated auth_options.RateLimit rate_limit = 50004;
+	E_RateLimit = &file_proto_auth_options_proto_extTypes[1]
+	// optional auth_options.RecentAuth require_recent_auth = 50005;
+	E_RequireRecentAuth = &file_proto_auth_options_proto_extTypes[2]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// Marks credentials such as passwords, tokens and secrets. Annotated fields
+	// are masked before messages are logged or audited.
+	//
+	// optional bool sensitive = 50003;
+	E_Sensitive = &file_proto_auth_options_proto_extTypes[3]
 )
 
 var File_proto_auth_options_proto protoreflect.FileDescriptor
 
 const file_proto_auth_options_proto_rawDesc = "" +
 	"\n" +
-	"\x18proto/auth_options.proto\x12\fauth_options\x1a google/protobuf/descriptor.proto**\n" +
+	"\x18proto/auth_options.proto\x12\fauth_options\x1a google/protobuf/descriptor.proto\"|\n" +
+	"\tRateLimit\x12\x1a\n" +
+	"\brequests\x18\x01 \x01(\rR\brequests\x12%\n" +
+	"\x0ewindow_seconds\x18\x02 \x01(\rR\rwindowSeconds\x12,\n" +
+	"\x03key\x18\x03 \x01(\x0e2\x1a.auth_options.RateLimitKeyR\x03key\"O\n" +
+	"\n" +
+	"RecentAuth\x12&\n" +
+	"\x0fmax_age_seconds\x18\x01 \x01(\rR\rmaxAgeSeconds\x12\x19\n" +
+	"\bwhen_set\x18\x02 \x03(\tR\awhenSet*7\n" +
 	"\tAuthLevel\x12\b\n" +
 	"\x04NONE\x10\x00\x12\b\n" +
 	"\x04USER\x10\x01\x12\t\n" +
-	"\x05ADMIN\x10\x02:X\n" +
+	"\x05ADMIN\x10\x02\x12\v\n" +
+	"\aSERVICE\x10\x03*x\n" +
+	"\fRateLimitKey\x12\x1e\n" +
+	"\x1aRATE_LIMIT_KEY_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11RATE_LIMIT_KEY_IP\x10\x01\x12\x18\n" +
+	"\x14RATE_LIMIT_KEY_EMAIL\x10\x02\x12\x17\n" +
+	"\x13RATE_LIMIT_KEY_USER\x10\x03:X\n" +
+	"\n" +
+	"auth_level\x12\x1e.google.protobuf.MethodOptions\x18҆\x03 \x01(\x0e2\x17.auth_options.AuthLevelR\tauthLevel:X\n" +
 	"\n" +
-	"auth_level\x12\x1e.google.protobuf.MethodOptions\x18҆\x03 \x01(\x0e2\x17.auth_options.AuthLevelR\tauthLevelBGZEgithub.com/JunBSer/services_proto/options/auth_options/gen/go;optionsb\x06proto3"
+	"rate_limit\x12\x1e.google.protobuf.MethodOptions\x18Ԇ\x03 \x03(\v2\x17.auth_options.RateLimitR\trateLimit:j\n" +
+	"\x13require_recent_auth\x12\x1e.google.protobuf.MethodOptions\x18Ն\x03 \x01(\v2\x18.auth_options.RecentAuthR\x11requireRecentAuth:=\n" +
+	"\tsensitive\x12\x1d.google.protobuf.FieldOptions\x18ӆ\x03 \x01(\bR\tsensitiveBGZEgithub.com/JunBSer/services_proto/options/auth_options/gen/go;optionsb\x06proto3"
 
 var (
 	file_proto_auth_options_proto_rawDescOnce sync.Once
@@ -112,19 +348,30 @@ func file_proto_auth_options_proto_rawDescGZIP() []byte {
 	return file_proto_auth_options_proto_rawDescData
 }
 
-var file_proto_auth_options_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_auth_options_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_auth_options_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_proto_auth_options_proto_goTypes = []any{
 	(AuthLevel)(0),                     // 0: auth_options.AuthLevel
-	(*descriptorpb.MethodOptions)(nil), // 1: google.protobuf.MethodOptions
+	(RateLimitKey)(0),                  // 1: auth_options.RateLimitKey
+	(*RateLimit)(nil),                  // 2: auth_options.RateLimit
+	(*RecentAuth)(nil),                 // 3: auth_options.RecentAuth
+	(*descriptorpb.MethodOptions)(nil), // 4: google.protobuf.MethodOptions
+	(*descriptorpb.FieldOptions)(nil),  // 5: google.protobuf.FieldOptions
 }
 var file_proto_auth_options_proto_depIdxs = []int32{
-	1, // 0: auth_options.auth_level:extendee -> google.protobuf.MethodOptions
-	0, // 1: auth_options.auth_level:type_name -> auth_options.AuthLevel
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	1, // [1:2] is the sub-list for extension type_name
-	0, // [0:1] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: auth_options.RateLimit.key:type_name -> auth_options.RateLimitKey
+	4, // 1: auth_options.auth_level:extendee -> google.protobuf.MethodOptions
+	4, // 2: auth_options.rate_limit:extendee -> google.protobuf.MethodOptions
+	4, // 3: auth_options.require_recent_auth:extendee -> google.protobuf.MethodOptions
+	5, // 4: auth_options.sensitive:extendee -> google.protobuf.FieldOptions
+	0, // 5: auth_options.auth_level:type_name -> auth_options.AuthLevel
+	2, // 6: auth_options.rate_limit:type_name -> auth_options.RateLimit
+	3, // 7: auth_options.require_recent_auth:type_name -> auth_options.RecentAuth
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	5, // [5:8] is the sub-list for extension type_name
+	1, // [1:5] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_proto_auth_options_proto_init() }
@@ -137,14 +384,15 @@ func file_proto_auth_options_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_auth_options_proto_rawDesc), len(file_proto_auth_options_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   0,
-			NumExtensions: 1,
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 4,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_auth_options_proto_goTypes,
 		DependencyIndexes: file_proto_auth_options_proto_depIdxs,
 		EnumInfos:         file_proto_auth_options_proto_enumTypes,
+		MessageInfos:      file_proto_auth_options_proto_msgTypes,
 		ExtensionInfos:    file_proto_auth_options_proto_extTypes,
 	}.Build()
 	File_proto_auth_options_proto = out.File
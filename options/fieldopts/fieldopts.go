@@ -0,0 +1,87 @@
+// Package fieldopts resolves the custom field options declared in
+// auth_options.proto and masks fields annotated as sensitive, so that
+// passwords and tokens never reach logs or the audit trail.
+package fieldopts
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	options "github.com/JunBSer/services_proto/options/auth_options/gen/go"
+)
+
+// Redacted replaces the value of sensitive string fields.
+const Redacted = "[REDACTED]"
+
+var cache sync.Map // field full name -> bool
+
+// Sensitive reports whether the field declares (auth_options.sensitive) = true.
+func Sensitive(fd protoreflect.FieldDescriptor) bool {
+	if v, ok := cache.Load(fd.FullName()); ok {
+		return v.(bool)
+	}
+
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	sensitive := ok && opts != nil && proto.GetExtension(opts, options.E_Sensitive).(bool)
+	cache.Store(fd.FullName(), sensitive)
+	return sensitive
+}
+
+// Redact returns a copy of m with every sensitive field masked, at any depth.
+// Strings become Redacted, bytes become its bytes and other kinds are
+// cleared. m itself is not modified.
+func Redact(m proto.Message) proto.Message {
+	if m == nil {
+		return nil
+	}
+	c := proto.Clone(m)
+	redact(c.ProtoReflect())
+	return c
+}
+
+func redact(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if Sensitive(fd) {
+			mask(m, fd, v)
+			return true
+		}
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := range list.Len() {
+				redact(list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				redact(mv.Message())
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			redact(v.Message())
+		}
+		return true
+	})
+}
+
+func mask(m protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+	if fd.IsMap() || (fd.Kind() != protoreflect.StringKind && fd.Kind() != protoreflect.BytesKind) {
+		m.Clear(fd)
+		return
+	}
+
+	masked := protoreflect.ValueOfString(Redacted)
+	if fd.Kind() == protoreflect.BytesKind {
+		masked = protoreflect.ValueOfBytes([]byte(Redacted))
+	}
+	if !fd.IsList() {
+		m.Set(fd, masked)
+		return
+	}
+	list := v.List()
+	for i := range list.Len() {
+		list.Set(i, masked)
+	}
+}
@@ -0,0 +1,205 @@
+package fieldopts
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	options "github.com/JunBSer/services_proto/options/auth_options/gen/go"
+)
+
+// testFile describes
+//
+//	message Secret {
+//	  string token = 1 [sensitive];
+//	  bytes key = 2 [sensitive];
+//	  repeated string codes = 3 [sensitive];
+//	  int64 pin = 4 [sensitive];
+//	  map<string, string> headers = 5 [sensitive];
+//	  string name = 6;
+//	}
+//	message Outer {
+//	  Secret one = 1;
+//	  repeated Secret many = 2;
+//	  map<string, Secret> by_name = 3;
+//	  Secret hidden = 4 [sensitive];
+//	  string note = 5;
+//	}
+func testFile(t *testing.T) protoreflect.FileDescriptor {
+	t.Helper()
+
+	sensitive := &descriptorpb.FieldOptions{}
+	proto.SetExtension(sensitive, options.E_Sensitive, true)
+
+	field := func(name string, num int32, typ descriptorpb.FieldDescriptorProto_Type, label descriptorpb.FieldDescriptorProto_Label, typeName string, secret bool) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(num),
+			Type:     typ.Enum(),
+			Label:    label.Enum(),
+		}
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+		if secret {
+			f.Options = sensitive
+		}
+		return f
+	}
+	const (
+		optional = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+		repeated = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+		str      = descriptorpb.FieldDescriptorProto_TYPE_STRING
+		msg      = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	)
+	mapEntry := func(name, valueType string, valueKind descriptorpb.FieldDescriptorProto_Type) *descriptorpb.DescriptorProto {
+		return &descriptorpb.DescriptorProto{
+			Name: proto.String(name),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("key", 1, str, optional, "", false),
+				field("value", 2, valueKind, optional, valueType, false),
+			},
+			Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+		}
+	}
+
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("fieldopts_test.proto"),
+		Package: proto.String("fieldoptstest"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Secret"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("token", 1, str, optional, "", true),
+					field("key", 2, descriptorpb.FieldDescriptorProto_TYPE_BYTES, optional, "", true),
+					field("codes", 3, str, repeated, "", true),
+					field("pin", 4, descriptorpb.FieldDescriptorProto_TYPE_INT64, optional, "", true),
+					field("headers", 5, msg, repeated, ".fieldoptstest.Secret.HeadersEntry", true),
+					field("name", 6, str, optional, "", false),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{mapEntry("HeadersEntry", "", str)},
+			},
+			{
+				Name: proto.String("Outer"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("one", 1, msg, optional, ".fieldoptstest.Secret", false),
+					field("many", 2, msg, repeated, ".fieldoptstest.Secret", false),
+					field("by_name", 3, msg, repeated, ".fieldoptstest.Outer.ByNameEntry", false),
+					field("hidden", 4, msg, optional, ".fieldoptstest.Secret", true),
+					field("note", 5, str, optional, "", false),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{mapEntry("ByNameEntry", ".fieldoptstest.Secret", msg)},
+			},
+		},
+	}
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fd
+}
+
+func TestRedact(t *testing.T) {
+	outer := testFile(t).Messages().ByName("Outer")
+	parse := func(t *testing.T, js string) proto.Message {
+		t.Helper()
+		m := dynamicpb.NewMessage(outer)
+		if err := protojson.Unmarshal([]byte(js), m); err != nil {
+			t.Fatalf("parse %s: %v", js, err)
+		}
+		return m
+	}
+	// "W1JFREFDVEVEXQ" is base64 for Redacted.
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty", `{}`, `{}`},
+		{"plain fields kept", `{"note": "hi", "one": {"name": "n"}}`, `{"note": "hi", "one": {"name": "n"}}`},
+		{"string", `{"one": {"token": "t", "name": "n"}}`, `{"one": {"token": "[REDACTED]", "name": "n"}}`},
+		{"bytes", `{"one": {"key": "c2VjcmV0"}}`, `{"one": {"key": "W1JFREFDVEVEXQ=="}}`},
+		{"repeated string", `{"one": {"codes": ["a", "b"]}}`, `{"one": {"codes": ["[REDACTED]", "[REDACTED]"]}}`},
+		{"other kinds cleared", `{"one": {"pin": "1234"}}`, `{"one": {}}`},
+		{"map cleared", `{"one": {"headers": {"authorization": "Bearer x"}}}`, `{"one": {}}`},
+		{"sensitive message cleared", `{"hidden": {"name": "n"}, "note": "x"}`, `{"note": "x"}`},
+		{
+			"list of messages",
+			`{"many": [{"token": "a"}, {"name": "b"}]}`,
+			`{"many": [{"token": "[REDACTED]"}, {"name": "b"}]}`,
+		},
+		{
+			"map of messages",
+			`{"by_name": {"x": {"token": "a", "name": "x"}}}`,
+			`{"by_name": {"x": {"token": "[REDACTED]", "name": "x"}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := parse(t, tt.in)
+			orig := proto.Clone(in)
+
+			got := Redact(in)
+			if want := parse(t, tt.want); !proto.Equal(got, want) {
+				t.Errorf("Redact = %v, want %v", got, want)
+			}
+			if !proto.Equal(in, orig) {
+				t.Errorf("Redact modified its input: %v", in)
+			}
+		})
+	}
+}
+
+func TestRedactGenerated(t *testing.T) {
+	tests := []struct {
+		name string
+		in   proto.Message
+		want proto.Message
+	}{
+		{"nil", nil, nil},
+		{
+			"login request",
+			&authpb.LoginRequest{Email: "a@example.com", Password: "hunter2"},
+			&authpb.LoginRequest{Email: "a@example.com", Password: Redacted},
+		},
+		{
+			"nested tokens",
+			&authpb.LoginResponse{Tokens: &authpb.JWTPair{AccessToken: "a", RefreshToken: "r"}},
+			&authpb.LoginResponse{Tokens: &authpb.JWTPair{AccessToken: Redacted, RefreshToken: Redacted}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Redact(tt.in); !proto.Equal(got, tt.want) {
+				t.Errorf("Redact = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSensitive(t *testing.T) {
+	fields := (&authpb.LoginRequest{}).ProtoReflect().Descriptor().Fields()
+	tests := []struct {
+		field protoreflect.Name
+		want  bool
+	}{
+		{"email", false},
+		{"password", true},
+	}
+	for _, tt := range tests {
+		for range 2 { // the second lookup is served from the cache
+			if got := Sensitive(fields.ByName(tt.field)); got != tt.want {
+				t.Errorf("Sensitive(%s) = %v, want %v", tt.field, got, tt.want)
+			}
+		}
+	}
+}
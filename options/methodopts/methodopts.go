@@ -0,0 +1,91 @@
+// Package methodopts resolves the custom method options declared in
+// auth_options.proto for a gRPC full method name such as
+// "/hotel.HotelService/DeleteHotel". Lookups go through the global proto
+// registry, so the generated package of the service must be linked in.
+package methodopts
+
+import (
+	"strings"
+	"sync"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	options "github.com/JunBSer/services_proto/options/auth_options/gen/go"
+)
+
+var cache sync.Map // full method name -> protoreflect.MethodDescriptor (nil if unknown)
+
+// Method returns the descriptor of a gRPC full method name.
+func Method(fullMethod string) (protoreflect.MethodDescriptor, bool) {
+	if v, ok := cache.Load(fullMethod); ok {
+		md, _ := v.(protoreflect.MethodDescriptor)
+		return md, md != nil
+	}
+
+	var md protoreflect.MethodDescriptor
+	name := strings.Replace(strings.TrimPrefix(fullMethod, "/"), "/", ".", 1)
+	if d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name)); err == nil {
+		md, _ = d.(protoreflect.MethodDescriptor)
+	}
+	cache.Store(fullMethod, md)
+	return md, md != nil
+}
+
+func methodOptions(fullMethod string) (*descriptorpb.MethodOptions, bool) {
+	md, ok := Method(fullMethod)
+	if !ok {
+		return nil, false
+	}
+	opts, ok := md.Options().(*descriptorpb.MethodOptions)
+	return opts, ok && opts != nil
+}
+
+// AuthLevel returns the auth_level option of the method. The second result
+// is false when the method is unknown or does not declare the option.
+func AuthLevel(fullMethod string) (options.AuthLevel, bool) {
+	opts, ok := methodOptions(fullMethod)
+	if !ok || !proto.HasExtension(opts, options.E_AuthLevel) {
+		return options.AuthLevel_NONE, false
+	}
+	return proto.GetExtension(opts, options.E_AuthLevel).(options.AuthLevel), true
+}
+
+// RateLimits returns the rate_limit options of the method.
+func RateLimits(fullMethod string) []*options.RateLimit {
+	opts, ok := methodOptions(fullMethod)
+	if !ok || !proto.HasExtension(opts, options.E_RateLimit) {
+		return nil
+	}
+	return proto.GetExtension(opts, options.E_RateLimit).([]*options.RateLimit)
+}
+
+// RecentAuth returns the require_recent_auth option of the method.
+func RecentAuth(fullMethod string) (*options.RecentAuth, bool) {
+	opts, ok := methodOptions(fullMethod)
+	if !ok || !proto.HasExtension(opts, options.E_RequireRecentAuth) {
+		return nil, false
+	}
+	ra, ok := proto.GetExtension(opts, options.E_RequireRecentAuth).(*options.RecentAuth)
+	return ra, ok && ra != nil
+}
+
+// HTTPRule returns the google.api.http binding of the method.
+func HTTPRule(fullMethod string) (*annotations.HttpRule, bool) {
+	opts, ok := methodOptions(fullMethod)
+	if !ok || !proto.HasExtension(opts, annotations.E_Http) {
+		return nil, false
+	}
+	rule, ok := proto.GetExtension(opts, annotations.E_Http).(*annotations.HttpRule)
+	return rule, ok && rule != nil
+}
+
+// IsSafe reports whether the method is bound to HTTP GET, i.e. it has no side
+// effects and may be retried freely.
+func IsSafe(fullMethod string) bool {
+	rule, ok := HTTPRule(fullMethod)
+	return ok && rule.GetGet() != ""
+}
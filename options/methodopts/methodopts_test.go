@@ -0,0 +1,108 @@
+package methodopts
+
+import (
+	"fmt"
+	"testing"
+
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+	hotelpb "github.com/JunBSer/services_proto/hotel/gen/go"
+	options "github.com/JunBSer/services_proto/options/auth_options/gen/go"
+)
+
+func TestAuthLevel(t *testing.T) {
+	tests := []struct {
+		method string
+		want   options.AuthLevel
+		wantOK bool
+	}{
+		{authpb.Auth_Login_FullMethodName, options.AuthLevel_NONE, true},
+		{authpb.Auth_DeleteAccount_FullMethodName, options.AuthLevel_USER, true},
+		{hotelpb.HotelService_GetHotel_FullMethodName, options.AuthLevel_USER, true},
+		{hotelpb.HotelService_CreateHotel_FullMethodName, options.AuthLevel_ADMIN, true},
+		{authpb.Auth_IntrospectToken_FullMethodName, options.AuthLevel_SERVICE, true},
+		{"/unknown.Service/Call", options.AuthLevel_NONE, false},
+		{"garbage", options.AuthLevel_NONE, false},
+	}
+	for _, tt := range tests {
+		// Twice, so the second lookup is served from the cache.
+		for range 2 {
+			got, ok := AuthLevel(tt.method)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("AuthLevel(%s) = %v, %v, want %v, %v", tt.method, got, ok, tt.want, tt.wantOK)
+			}
+		}
+	}
+}
+
+func TestRateLimits(t *testing.T) {
+	tests := []struct {
+		method string
+		want   string
+	}{
+		{authpb.Auth_Login_FullMethodName, "[20/60s RATE_LIMIT_KEY_IP 5/60s RATE_LIMIT_KEY_EMAIL]"},
+		{authpb.Auth_Reauthenticate_FullMethodName, "[5/300s RATE_LIMIT_KEY_USER]"},
+		{authpb.Auth_VerifyMFA_FullMethodName, "[10/60s RATE_LIMIT_KEY_IP]"},
+		{hotelpb.HotelService_GetHotel_FullMethodName, "[]"},
+		{"/unknown.Service/Call", "[]"},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, l := range RateLimits(tt.method) {
+			got = append(got, fmt.Sprintf("%d/%ds %v", l.GetRequests(), l.GetWindowSeconds(), l.GetKey()))
+		}
+		if fmt.Sprint(got) != tt.want {
+			t.Errorf("RateLimits(%s) = %v, want %s", tt.method, got, tt.want)
+		}
+	}
+}
+
+func TestRecentAuth(t *testing.T) {
+	tests := []struct {
+		method      string
+		wantOK      bool
+		wantMaxAge  uint32
+		wantWhenSet string
+	}{
+		{authpb.Auth_DeleteAccount_FullMethodName, true, 300, "[]"},
+		{authpb.Auth_ChangePassword_FullMethodName, true, 300, "[]"},
+		{authpb.Auth_UpdateProfile_FullMethodName, true, 300, "[email]"},
+		{authpb.Auth_Login_FullMethodName, false, 0, "[]"},
+		{"/unknown.Service/Call", false, 0, "[]"},
+	}
+	for _, tt := range tests {
+		ra, ok := RecentAuth(tt.method)
+		if ok != tt.wantOK {
+			t.Fatalf("RecentAuth(%s) ok = %v, want %v", tt.method, ok, tt.wantOK)
+		}
+		if ra.GetMaxAgeSeconds() != tt.wantMaxAge || fmt.Sprint(ra.GetWhenSet()) != tt.wantWhenSet {
+			t.Errorf("RecentAuth(%s) = %v, want max age %d, when set %s", tt.method, ra, tt.wantMaxAge, tt.wantWhenSet)
+		}
+	}
+}
+
+func TestHTTPRule(t *testing.T) {
+	tests := []struct {
+		method   string
+		wantOK   bool
+		wantPath string
+		wantSafe bool
+	}{
+		{hotelpb.HotelService_GetHotel_FullMethodName, true, "/v1/hotels/{id}", true},
+		{hotelpb.HotelService_CreateHotel_FullMethodName, true, "/v1/hotels", false},
+		{authpb.Auth_Login_FullMethodName, true, "/v1/auth/login", false},
+		{"/unknown.Service/Call", false, "", false},
+	}
+	for _, tt := range tests {
+		rule, ok := HTTPRule(tt.method)
+		if ok != tt.wantOK {
+			t.Fatalf("HTTPRule(%s) ok = %v, want %v", tt.method, ok, tt.wantOK)
+		}
+		path := rule.GetGet() + rule.GetPost() + rule.GetPut() + rule.GetDelete() + rule.GetPatch()
+		if path != tt.wantPath {
+			t.Errorf("HTTPRule(%s) path = %q, want %q", tt.method, path, tt.wantPath)
+		}
+		if got := IsSafe(tt.method); got != tt.wantSafe {
+			t.Errorf("IsSafe(%s) = %v, want %v", tt.method, got, tt.wantSafe)
+		}
+	}
+}
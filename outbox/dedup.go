@@ -0,0 +1,37 @@
+package outbox
+
+import "sync"
+
+// Deduper remembers the most recent record IDs so consumers can drop
+// redeliveries. It is safe for concurrent use.
+type Deduper struct {
+	mu   sync.Mutex
+	seen map[string]bool
+	ring []string
+	next int
+}
+
+// NewDeduper remembers up to size IDs, forgetting the oldest first.
+func NewDeduper(size int) *Deduper {
+	return &Deduper{seen: make(map[string]bool, size), ring: make([]string, size)}
+}
+
+// Seen records id and reports whether it was already recorded.
+func (d *Deduper) Seen(id string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.seen[id] {
+		return true
+	}
+	if len(d.ring) == 0 {
+		return false
+	}
+	if old := d.ring[d.next]; old != "" {
+		delete(d.seen, old)
+	}
+	d.ring[d.next] = id
+	d.next = (d.next + 1) % len(d.ring)
+	d.seen[id] = true
+	return false
+}
@@ -0,0 +1,28 @@
+package outbox
+
+import "testing"
+
+func TestDeduper(t *testing.T) {
+	tests := []struct {
+		name string
+		size int
+		ids  []string
+		want []bool
+	}{
+		{"first sighting", 2, []string{"a", "b"}, []bool{false, false}},
+		{"redelivery", 2, []string{"a", "a", "b", "a"}, []bool{false, true, false, true}},
+		{"oldest forgotten", 2, []string{"a", "b", "c", "a"}, []bool{false, false, false, false}},
+		{"recent kept", 2, []string{"a", "b", "c", "c", "b"}, []bool{false, false, false, true, true}},
+		{"zero size", 0, []string{"a", "a"}, []bool{false, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDeduper(tt.size)
+			for i, id := range tt.ids {
+				if got := d.Seen(id); got != tt.want[i] {
+					t.Errorf("Seen(%q) #%d = %v, want %v", id, i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
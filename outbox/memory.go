@@ -0,0 +1,129 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryStore is a Store for tests and single-process setups. It implements
+// Writer[*MemoryTx].
+type MemoryStore struct {
+	mu      sync.Mutex
+	seq     int64
+	pending []Record
+	ids     map[string]bool
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{ids: make(map[string]bool)}
+}
+
+// MemoryTx collects records added to a MemoryStore until Commit.
+type MemoryTx struct {
+	s       *MemoryStore
+	records []Record
+	done    bool
+}
+
+// Begin starts a transaction. Its records are invisible to Pending until
+// Commit.
+func (s *MemoryStore) Begin() *MemoryTx {
+	return &MemoryTx{s: s}
+}
+
+// Commit stores the transaction's records atomically: either all are stored
+// or, when an ID is already known, none are.
+func (tx *MemoryTx) Commit() error {
+	if tx.done {
+		return ErrTxDone
+	}
+	tx.done = true
+	return tx.s.commit(tx.records)
+}
+
+// Rollback discards the transaction's records. It is a no-op after Commit.
+func (tx *MemoryTx) Rollback() {
+	if !tx.done {
+		tx.done = true
+		tx.records = nil
+	}
+}
+
+// Add implements Writer. A nil tx stores the records in a transaction of
+// their own.
+func (s *MemoryStore) Add(ctx context.Context, tx *MemoryTx, records ...Record) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if tx == nil {
+		return s.commit(records)
+	}
+	if tx.done {
+		return ErrTxDone
+	}
+	tx.records = append(tx.records, records...)
+	return nil
+}
+
+func (s *MemoryStore) commit(records []Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	batch := make(map[string]bool, len(records))
+	for _, r := range records {
+		if s.ids[r.ID] || batch[r.ID] {
+			return ErrDuplicateRecord
+		}
+		batch[r.ID] = true
+	}
+	for _, r := range records {
+		s.seq++
+		r.Sequence = s.seq
+		s.pending = append(s.pending, r)
+		s.ids[r.ID] = true
+	}
+	return nil
+}
+
+func (s *MemoryStore) Pending(ctx context.Context, limit int) ([]Record, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := min(limit, len(s.pending))
+	return append([]Record(nil), s.pending[:n]...), nil
+}
+
+func (s *MemoryStore) MarkDelivered(ctx context.Context, ids []string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	done := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		done[id] = true
+	}
+	kept := s.pending[:0]
+	for _, r := range s.pending {
+		if !done[r.ID] {
+			kept = append(kept, r)
+		}
+	}
+	clear(s.pending[len(kept):])
+	s.pending = kept
+	return nil
+}
+
+// Len returns the number of undelivered records.
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.pending)
+}
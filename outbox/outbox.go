@@ -0,0 +1,102 @@
+// Package outbox implements the transactional outbox pattern. Services store
+// events as Records in the same transaction as the state change they
+// describe, and a Relay later delivers them to a Sink. Delivery is
+// at-least-once: consumers drop redeliveries by Record.ID, for example with a
+// Deduper.
+package outbox
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var (
+	ErrDuplicateRecord = errors.New("outbox: duplicate record id")
+	// ErrTxDone is returned when records are added to a transaction that was
+	// already committed or rolled back.
+	ErrTxDone = errors.New("outbox: transaction already finished")
+)
+
+// Record is a serialized event waiting in the outbox.
+type Record struct {
+	// ID identifies the event across redeliveries.
+	ID string
+	// Sequence is assigned by the Store and increases in commit order.
+	Sequence int64
+	// TypeURL names the payload's message type, as in google.protobuf.Any.
+	TypeURL string
+	Payload []byte
+	Headers map[string]string
+	// CreatedAt is set by NewRecord.
+	CreatedAt time.Time
+}
+
+// NewRecord serializes event. An empty id is replaced by a random one; pass
+// the event's own ID when it has one so consumers see a single identifier.
+func NewRecord(id string, event proto.Message, headers map[string]string) (Record, error) {
+	a, err := anypb.New(event)
+	if err != nil {
+		return Record{}, fmt.Errorf("outbox: marshal event: %w", err)
+	}
+	if id == "" {
+		id = rand.Text()
+	}
+	return Record{
+		ID:        id,
+		TypeURL:   a.GetTypeUrl(),
+		Payload:   a.GetValue(),
+		Headers:   maps.Clone(headers),
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// Any returns the payload as a google.protobuf.Any.
+func (r Record) Any() *anypb.Any {
+	return &anypb.Any{TypeUrl: r.TypeURL, Value: r.Payload}
+}
+
+// Unmarshal decodes the payload into a message of its registered type.
+func (r Record) Unmarshal() (proto.Message, error) {
+	m, err := r.Any().UnmarshalNew()
+	if err != nil {
+		return nil, fmt.Errorf("outbox: unmarshal %s: %w", r.TypeURL, err)
+	}
+	return m, nil
+}
+
+// Writer adds records in the transaction that makes the state change they
+// describe, so the records are stored if and only if it commits. Tx is the
+// store's transaction type: *sql.Tx for a SQL store, *MemoryTx for
+// MemoryStore.
+type Writer[Tx any] interface {
+	// Add stores records in tx. Records commit in the order given; an ID
+	// that is already stored fails the commit with ErrDuplicateRecord.
+	Add(ctx context.Context, tx Tx, records ...Record) error
+}
+
+// Store reads records back for delivery. Stores also implement Writer for
+// their transaction type.
+type Store interface {
+	// Pending returns up to limit undelivered records in sequence order.
+	Pending(ctx context.Context, limit int) ([]Record, error)
+	// MarkDelivered removes records from the pending set. Unknown IDs are
+	// ignored.
+	MarkDelivered(ctx context.Context, ids []string) error
+}
+
+// Sink delivers a batch of records, in order. A batch that fails is retried
+// in full, so sinks may see records they already delivered.
+type Sink interface {
+	Deliver(ctx context.Context, records []Record) error
+}
+
+type SinkFunc func(ctx context.Context, records []Record) error
+
+func (f SinkFunc) Deliver(ctx context.Context, records []Record) error { return f(ctx, records) }
@@ -0,0 +1,194 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func mustRecord(t *testing.T, id, value string) Record {
+	t.Helper()
+
+	r, err := NewRecord(id, wrapperspb.String(value), map[string]string{"k": "v"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestNewRecord(t *testing.T) {
+	headers := map[string]string{"ce-type": "greeting"}
+	r, err := NewRecord("r-1", wrapperspb.String("hello"), headers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	headers["ce-type"] = "changed"
+
+	if r.ID != "r-1" || r.TypeURL != "type.googleapis.com/google.protobuf.StringValue" || r.CreatedAt.IsZero() {
+		t.Errorf("record = %+v", r)
+	}
+	if r.Headers["ce-type"] != "greeting" {
+		t.Errorf("headers share the caller's map: %v", r.Headers)
+	}
+	m, err := r.Unmarshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(m, wrapperspb.String("hello")) {
+		t.Errorf("Unmarshal = %v, want hello", m)
+	}
+
+	a, _ := NewRecord("", wrapperspb.String("x"), nil)
+	b, _ := NewRecord("", wrapperspb.String("x"), nil)
+	if a.ID == "" || a.ID == b.ID {
+		t.Errorf("generated IDs %q and %q are not unique", a.ID, b.ID)
+	}
+}
+
+func pendingIDs(t *testing.T, s *MemoryStore) []string {
+	t.Helper()
+
+	recs, err := s.Pending(context.Background(), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for i, r := range recs {
+		if i > 0 && r.Sequence <= recs[i-1].Sequence {
+			t.Errorf("sequence %d after %d", r.Sequence, recs[i-1].Sequence)
+		}
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
+
+func TestMemoryStoreTx(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		// run adds records to s, which already holds "a".
+		run       func(t *testing.T, s *MemoryStore) error
+		wantErr   error
+		wantStore []string
+	}{
+		{
+			name: "commit",
+			run: func(t *testing.T, s *MemoryStore) error {
+				tx := s.Begin()
+				if err := s.Add(ctx, tx, mustRecord(t, "b", "1"), mustRecord(t, "c", "2")); err != nil {
+					return err
+				}
+				if got := pendingIDs(t, s); len(got) != 1 {
+					t.Errorf("uncommitted records are pending: %v", got)
+				}
+				return tx.Commit()
+			},
+			wantStore: []string{"a", "b", "c"},
+		},
+		{
+			name: "rollback",
+			run: func(t *testing.T, s *MemoryStore) error {
+				tx := s.Begin()
+				if err := s.Add(ctx, tx, mustRecord(t, "b", "1")); err != nil {
+					return err
+				}
+				tx.Rollback()
+				return tx.Commit()
+			},
+			wantErr:   ErrTxDone,
+			wantStore: []string{"a"},
+		},
+		{
+			name: "add after commit",
+			run: func(t *testing.T, s *MemoryStore) error {
+				tx := s.Begin()
+				if err := tx.Commit(); err != nil {
+					return err
+				}
+				return s.Add(ctx, tx, mustRecord(t, "b", "1"))
+			},
+			wantErr:   ErrTxDone,
+			wantStore: []string{"a"},
+		},
+		{
+			name: "duplicate of a stored record",
+			run: func(t *testing.T, s *MemoryStore) error {
+				tx := s.Begin()
+				if err := s.Add(ctx, tx, mustRecord(t, "b", "1"), mustRecord(t, "a", "2")); err != nil {
+					return err
+				}
+				return tx.Commit()
+			},
+			wantErr:   ErrDuplicateRecord,
+			wantStore: []string{"a"},
+		},
+		{
+			name: "duplicate within the transaction",
+			run: func(t *testing.T, s *MemoryStore) error {
+				tx := s.Begin()
+				_ = s.Add(ctx, tx, mustRecord(t, "b", "1"))
+				_ = s.Add(ctx, tx, mustRecord(t, "b", "2"))
+				return tx.Commit()
+			},
+			wantErr:   ErrDuplicateRecord,
+			wantStore: []string{"a"},
+		},
+		{
+			name: "nil tx",
+			run: func(t *testing.T, s *MemoryStore) error {
+				return s.Add(ctx, nil, mustRecord(t, "b", "1"))
+			},
+			wantStore: []string{"a", "b"},
+		},
+		{
+			name: "cancelled context",
+			run: func(t *testing.T, s *MemoryStore) error {
+				cctx, cancel := context.WithCancel(ctx)
+				cancel()
+				return s.Add(cctx, nil, mustRecord(t, "b", "1"))
+			},
+			wantErr:   context.Canceled,
+			wantStore: []string{"a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewMemoryStore()
+			if err := s.Add(ctx, nil, mustRecord(t, "a", "0")); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := tt.run(t, s); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if got := pendingIDs(t, s); !slices.Equal(got, tt.wantStore) {
+				t.Errorf("pending = %v, want %v", got, tt.wantStore)
+			}
+		})
+	}
+}
+
+func TestMemoryStoreMarkDelivered(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	for _, id := range []string{"a", "b", "c", "d"} {
+		if err := s.Add(ctx, nil, mustRecord(t, id, id)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.MarkDelivered(ctx, []string{"b", "d", "unknown"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := pendingIDs(t, s); !slices.Equal(got, []string{"a", "c"}) {
+		t.Errorf("pending = %v, want [a c]", got)
+	}
+	// Delivered IDs stay known, so a retried transaction cannot re-add them.
+	if err := s.Add(ctx, nil, mustRecord(t, "b", "b")); !errors.Is(err, ErrDuplicateRecord) {
+		t.Errorf("re-adding a delivered record = %v, want ErrDuplicateRecord", err)
+	}
+}
@@ -0,0 +1,89 @@
+package outbox
+
+import (
+	"context"
+	"time"
+)
+
+// Relay moves records from a Store to a Sink.
+type Relay struct {
+	Store Store
+	Sink  Sink
+	// BatchSize defaults to 100.
+	BatchSize int
+	// Interval between polls of the store. Defaults to 1s. Notify triggers
+	// an earlier poll.
+	Interval time.Duration
+	// OnError observes failed polls and deliveries, which are retried on the
+	// next poll.
+	OnError func(error)
+
+	wake chan struct{}
+}
+
+func NewRelay(store Store, sink Sink) *Relay {
+	return &Relay{Store: store, Sink: sink, wake: make(chan struct{}, 1)}
+}
+
+// Notify wakes the relay, typically right after a transaction that added
+// records has committed.
+func (r *Relay) Notify() {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run relays records until ctx is done.
+func (r *Relay) Run(ctx context.Context) error {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.Flush(ctx); err != nil && ctx.Err() == nil && r.OnError != nil {
+			r.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		case <-r.wake:
+		}
+	}
+}
+
+// Flush delivers pending records batch by batch until the store is drained
+// and returns how many were delivered.
+func (r *Relay) Flush(ctx context.Context) (int, error) {
+	size := r.BatchSize
+	if size <= 0 {
+		size = 100
+	}
+
+	delivered := 0
+	for {
+		batch, err := r.Store.Pending(ctx, size)
+		if err != nil || len(batch) == 0 {
+			return delivered, err
+		}
+		if err := r.Sink.Deliver(ctx, batch); err != nil {
+			return delivered, err
+		}
+
+		ids := make([]string, len(batch))
+		for i, rec := range batch {
+			ids[i] = rec.ID
+		}
+		if err := r.Store.MarkDelivered(ctx, ids); err != nil {
+			return delivered, err
+		}
+		delivered += len(batch)
+		if len(batch) < size {
+			return delivered, nil
+		}
+	}
+}
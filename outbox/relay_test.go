@@ -0,0 +1,155 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingSink collects delivered IDs and fails the deliveries listed in
+// failures, counted from 1.
+type recordingSink struct {
+	mu        sync.Mutex
+	failures  map[int]bool
+	calls     int
+	delivered []string
+}
+
+func (s *recordingSink) Deliver(_ context.Context, records []Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls++
+	if s.failures[s.calls] {
+		return errors.New("sink unavailable")
+	}
+	for _, r := range records {
+		s.delivered = append(s.delivered, r.ID)
+	}
+	return nil
+}
+
+func (s *recordingSink) ids() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.delivered)
+}
+
+func fill(t *testing.T, s *MemoryStore, ids ...string) {
+	t.Helper()
+
+	for _, id := range ids {
+		if err := s.Add(context.Background(), nil, mustRecord(t, id, id)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRelayFlush(t *testing.T) {
+	tests := []struct {
+		name          string
+		records       []string
+		batchSize     int
+		failures      map[int]bool
+		wantCount     int
+		wantErr       bool
+		wantDelivered []string
+		wantPending   []string
+	}{
+		{name: "empty", batchSize: 2},
+		{
+			name: "drains in batches", records: []string{"a", "b", "c", "d", "e"}, batchSize: 2,
+			wantCount: 5, wantDelivered: []string{"a", "b", "c", "d", "e"},
+		},
+		{
+			name: "exact batches", records: []string{"a", "b", "c", "d"}, batchSize: 2,
+			wantCount: 4, wantDelivered: []string{"a", "b", "c", "d"},
+		},
+		{
+			name: "default batch size", records: []string{"a", "b", "c"},
+			wantCount: 3, wantDelivered: []string{"a", "b", "c"},
+		},
+		{
+			name: "sink fails", records: []string{"a", "b", "c", "d", "e"}, batchSize: 2, failures: map[int]bool{2: true},
+			wantCount: 2, wantErr: true, wantDelivered: []string{"a", "b"}, wantPending: []string{"c", "d", "e"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryStore()
+			fill(t, store, tt.records...)
+			sink := &recordingSink{failures: tt.failures}
+			r := NewRelay(store, sink)
+			r.BatchSize = tt.batchSize
+
+			n, err := r.Flush(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Flush error = %v, want error %v", err, tt.wantErr)
+			}
+			if n != tt.wantCount {
+				t.Errorf("Flush = %d, want %d", n, tt.wantCount)
+			}
+			if got := sink.ids(); !slices.Equal(got, tt.wantDelivered) {
+				t.Errorf("delivered %v, want %v", got, tt.wantDelivered)
+			}
+			if got := pendingIDs(t, store); !slices.Equal(got, tt.wantPending) {
+				t.Errorf("pending %v, want %v", got, tt.wantPending)
+			}
+		})
+	}
+}
+
+func TestRelayRunRetriesAndNotify(t *testing.T) {
+	store := NewMemoryStore()
+	fill(t, store, "a", "b")
+	sink := &recordingSink{failures: map[int]bool{1: true}}
+
+	errs := make(chan error, 10)
+	r := NewRelay(store, sink)
+	// Polls never fire during the test; only Notify wakes the relay.
+	r.Interval = time.Hour
+	r.OnError = func(err error) { errs <- err }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- r.Run(ctx) }()
+
+	waitFor := func(want []string) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for !slices.Equal(sink.ids(), want) {
+			if time.Now().After(deadline) {
+				t.Fatalf("delivered %v, want %v", sink.ids(), want)
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+
+	// The first delivery fails and is retried in full on the next wake-up.
+	select {
+	case <-errs:
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnError did not see the failed delivery")
+	}
+	if got := sink.ids(); len(got) != 0 {
+		t.Fatalf("delivered %v after a failed delivery", got)
+	}
+	r.Notify()
+	waitFor([]string{"a", "b"})
+
+	fill(t, store, "c")
+	r.Notify()
+	waitFor([]string{"a", "b", "c"})
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Run = %v, want context.Canceled", err)
+	}
+	if store.Len() != 0 {
+		t.Errorf("%d records left pending", store.Len())
+	}
+}
@@ -0,0 +1,176 @@
+package ratelimit
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+)
+
+// Lockout locks an account after repeated failed logins, independently of
+// the caller's IP, so credential stuffing from many addresses is stopped as
+// well. State is kept in process memory.
+type Lockout struct {
+	// MaxFailures failed attempts within Window lock the account. Defaults
+	// to 5.
+	MaxFailures int
+	// Window defaults to 15 minutes.
+	Window time.Duration
+	// Duration of the lock. Defaults to 15 minutes.
+	Duration time.Duration
+	// Methods are the guarded login methods, keyed by their email field.
+	// Defaults to Auth/Login.
+	Methods []string
+	// Now defaults to time.Now.
+	Now func() time.Time
+
+	mu       sync.Mutex
+	accounts map[string]*account
+	ops      int
+}
+
+type account struct {
+	failures    int
+	first       time.Time
+	lockedUntil time.Time
+}
+
+func NewLockout() *Lockout {
+	return &Lockout{}
+}
+
+func (l *Lockout) maxFailures() int {
+	if l.MaxFailures > 0 {
+		return l.MaxFailures
+	}
+	return 5
+}
+
+func (l *Lockout) window() time.Duration {
+	if l.Window > 0 {
+		return l.Window
+	}
+	return 15 * time.Minute
+}
+
+func (l *Lockout) duration() time.Duration {
+	if l.Duration > 0 {
+		return l.Duration
+	}
+	return 15 * time.Minute
+}
+
+func (l *Lockout) now() time.Time {
+	if l.Now != nil {
+		return l.Now()
+	}
+	return time.Now()
+}
+
+func (l *Lockout) guards(fullMethod string) bool {
+	if len(l.Methods) == 0 {
+		return fullMethod == authpb.Auth_Login_FullMethodName
+	}
+	return slices.Contains(l.Methods, fullMethod)
+}
+
+// Locked returns how long the account stays locked.
+func (l *Lockout) Locked(email string) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	a, ok := l.accounts[email]
+	if !ok {
+		return 0, false
+	}
+	remaining := a.lockedUntil.Sub(l.now())
+	return remaining, remaining > 0
+}
+
+// Fail records a failed attempt and reports whether it locked the account.
+func (l *Lockout) Fail(email string) bool {
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.accounts == nil {
+		l.accounts = make(map[string]*account)
+	}
+	l.ops++
+	if l.ops%sweepEvery == 0 {
+		l.sweep(now)
+	}
+
+	a, ok := l.accounts[email]
+	if !ok {
+		a = &account{first: now}
+		l.accounts[email] = a
+	} else if now.Sub(a.first) > l.window() {
+		a.failures, a.first = 0, now
+	}
+	a.failures++
+	if a.failures < l.maxFailures() {
+		return false
+	}
+	a.failures = 0
+	a.first = now
+	a.lockedUntil = now.Add(l.duration())
+	return true
+}
+
+// Reset forgets the failures of an account after a successful login.
+func (l *Lockout) Reset(email string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.accounts, email)
+}
+
+func (l *Lockout) sweep(now time.Time) {
+	for k, a := range l.accounts {
+		if now.After(a.lockedUntil) && now.Sub(a.first) > l.window() {
+			delete(l.accounts, k)
+		}
+	}
+}
+
+func (l *Lockout) locked(email string) error {
+	if remaining, ok := l.Locked(email); ok {
+		return apierrors.Throttled(apierrors.ReasonAccountLocked, "account temporarily locked after repeated failed logins", remaining)
+	}
+	return nil
+}
+
+// UnaryInterceptor rejects logins to locked accounts and counts
+// INVALID_CREDENTIALS failures. Any successful call resets the count.
+func (l *Lockout) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !l.guards(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		email, ok := Email(req)
+		if !ok {
+			return handler(ctx, req)
+		}
+		if err := l.locked(email); err != nil {
+			return nil, err
+		}
+
+		resp, err := handler(ctx, req)
+		switch {
+		case err == nil:
+			l.Reset(email)
+		case apierrors.Is(err, apierrors.ReasonInvalidCredentials):
+			if l.Fail(email) {
+				return nil, l.locked(email)
+			}
+		}
+		return resp, err
+	}
+}
@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+)
+
+func TestLockoutFail(t *testing.T) {
+	clock := newClock()
+	l := &Lockout{MaxFailures: 3, Window: time.Minute, Duration: 5 * time.Minute, Now: clock.Now}
+	tests := []struct {
+		name      string
+		advance   time.Duration
+		fail      bool // record a failure, otherwise reset
+		wantLock  bool
+		stillLock bool
+	}{
+		{name: "first", fail: true},
+		{name: "second", fail: true},
+		{name: "window expired", advance: 2 * time.Minute, fail: true},
+		{name: "second again", fail: true},
+		{name: "third locks", fail: true, wantLock: true, stillLock: true},
+		{name: "still locked", advance: 4 * time.Minute, fail: true, stillLock: true},
+		{name: "lock expired", advance: 2 * time.Minute, fail: true},
+	}
+	for _, tt := range tests {
+		clock.Advance(tt.advance)
+		if got := l.Fail("a@example.com"); got != tt.wantLock {
+			t.Errorf("%s: Fail = %v, want %v", tt.name, got, tt.wantLock)
+		}
+		if _, locked := l.Locked("a@example.com"); locked != tt.stillLock {
+			t.Errorf("%s: Locked = %v, want %v", tt.name, locked, tt.stillLock)
+		}
+	}
+
+	l.Reset("a@example.com")
+	if _, locked := l.Locked("a@example.com"); locked {
+		t.Error("Locked after Reset")
+	}
+}
+
+func TestLockoutInterceptor(t *testing.T) {
+	clock := newClock()
+	l := &Lockout{MaxFailures: 2, Now: clock.Now}
+	intercept := l.UnaryInterceptor()
+	password := "right"
+	login := func(method, pw string) error {
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+		_, err := intercept(context.Background(), &authpb.LoginRequest{Email: "a@example.com", Password: pw}, info,
+			func(context.Context, any) (any, error) {
+				if pw != password {
+					return nil, apierrors.New(codes.Unauthenticated, apierrors.ReasonInvalidCredentials, "bad password")
+				}
+				return &authpb.LoginResponse{}, nil
+			})
+		return err
+	}
+
+	if err := login(authpb.Auth_Login_FullMethodName, "wrong"); !apierrors.Is(err, apierrors.ReasonInvalidCredentials) {
+		t.Fatalf("first failure = %v", err)
+	}
+	if err := login(authpb.Auth_Login_FullMethodName, "right"); err != nil {
+		t.Fatalf("success = %v", err)
+	}
+	// The success reset the count, so it takes two more failures.
+	if err := login(authpb.Auth_Login_FullMethodName, "wrong"); !apierrors.Is(err, apierrors.ReasonInvalidCredentials) {
+		t.Fatalf("failure after reset = %v", err)
+	}
+	if err := login(authpb.Auth_Login_FullMethodName, "wrong"); !apierrors.Is(err, apierrors.ReasonAccountLocked) {
+		t.Fatalf("locking failure = %v, want ACCOUNT_LOCKED", err)
+	}
+	if err := login(authpb.Auth_Login_FullMethodName, "right"); !apierrors.Is(err, apierrors.ReasonAccountLocked) {
+		t.Fatalf("right password while locked = %v, want ACCOUNT_LOCKED", err)
+	}
+	if err := login(authpb.Auth_Register_FullMethodName, "wrong"); apierrors.Is(err, apierrors.ReasonAccountLocked) {
+		t.Fatal("unguarded method was locked")
+	}
+
+	clock.Advance(16 * time.Minute)
+	if err := login(authpb.Auth_Login_FullMethodName, "right"); err != nil {
+		t.Fatalf("after the lock = %v", err)
+	}
+}
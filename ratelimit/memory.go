@@ -0,0 +1,80 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepEvery is how many takes pass between removals of idle buckets.
+const sweepEvery = 1024
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	window time.Duration
+}
+
+// MemoryBackend keeps buckets in process memory. Full buckets are dropped
+// periodically, so memory grows only with recently active keys.
+type MemoryBackend struct {
+	now func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	takes   int
+}
+
+// NewMemoryBackend returns a backend reading time from now, or time.Now if
+// nil.
+func NewMemoryBackend(now func() time.Time) *MemoryBackend {
+	if now == nil {
+		now = time.Now
+	}
+	return &MemoryBackend{now: now, buckets: make(map[string]*bucket)}
+}
+
+func (b *MemoryBackend) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	if err := ctx.Err(); err != nil {
+		return Result{}, err
+	}
+	capacity := float64(limit.Requests)
+	rate := capacity / limit.Window.Seconds() // tokens per second
+	now := b.now()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.takes++
+	if b.takes%sweepEvery == 0 {
+		b.sweep(now)
+	}
+
+	bk, ok := b.buckets[key]
+	if !ok {
+		bk = &bucket{tokens: capacity, last: now}
+		b.buckets[key] = bk
+	}
+	bk.window = limit.Window
+	if elapsed := now.Sub(bk.last).Seconds(); elapsed > 0 {
+		bk.tokens = math.Min(capacity, bk.tokens+elapsed*rate)
+		bk.last = now
+	}
+
+	if bk.tokens < 1 {
+		wait := time.Duration((1 - bk.tokens) / rate * float64(time.Second))
+		return Result{RetryAfter: wait}, nil
+	}
+	bk.tokens--
+	return Result{Allowed: true, Remaining: int(bk.tokens)}, nil
+}
+
+// sweep drops buckets idle for a whole window; they would be full again.
+func (b *MemoryBackend) sweep(now time.Time) {
+	for k, bk := range b.buckets {
+		if now.Sub(bk.last) >= bk.window {
+			delete(b.buckets, k)
+		}
+	}
+}
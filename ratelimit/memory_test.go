@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryBackendTake(t *testing.T) {
+	limit := Limit{Requests: 3, Window: 30 * time.Second} // one token per 10s
+	tests := []struct {
+		name    string
+		advance time.Duration
+		allowed bool
+		// remaining when allowed, retry delay otherwise
+		remaining int
+		retry     time.Duration
+	}{
+		{name: "full bucket", allowed: true, remaining: 2},
+		{name: "second", allowed: true, remaining: 1},
+		{name: "third", allowed: true, remaining: 0},
+		{name: "empty", allowed: false, retry: 10 * time.Second},
+		{name: "partly refilled", advance: 4 * time.Second, allowed: false, retry: 6 * time.Second},
+		{name: "one token back", advance: 6 * time.Second, allowed: true, remaining: 0},
+		{name: "refill caps at capacity", advance: time.Hour, allowed: true, remaining: 2},
+	}
+
+	clock := newClock()
+	b := NewMemoryBackend(clock.Now)
+	for _, tt := range tests {
+		clock.Advance(tt.advance)
+		res, err := b.Take(context.Background(), "k", limit)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if res.Allowed != tt.allowed {
+			t.Fatalf("%s: Allowed = %v, want %v", tt.name, res.Allowed, tt.allowed)
+		}
+		if tt.allowed && res.Remaining != tt.remaining {
+			t.Errorf("%s: Remaining = %d, want %d", tt.name, res.Remaining, tt.remaining)
+		}
+		if !tt.allowed && (res.RetryAfter-tt.retry).Abs() > time.Millisecond {
+			t.Errorf("%s: RetryAfter = %v, want %v", tt.name, res.RetryAfter, tt.retry)
+		}
+	}
+}
+
+func TestMemoryBackendKeysAreIndependent(t *testing.T) {
+	b := NewMemoryBackend(newClock().Now)
+	limit := Limit{Requests: 1, Window: time.Minute}
+	for _, key := range []string{"a", "b"} {
+		if res, _ := b.Take(context.Background(), key, limit); !res.Allowed {
+			t.Errorf("first take of %q denied", key)
+		}
+	}
+	if res, _ := b.Take(context.Background(), "a", limit); res.Allowed {
+		t.Error("second take of a allowed")
+	}
+}
+
+func TestMemoryBackendCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := NewMemoryBackend(nil).Take(ctx, "k", Limit{Requests: 1, Window: time.Second}); err == nil {
+		t.Error("Take with a cancelled context succeeded")
+	}
+}
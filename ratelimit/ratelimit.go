@@ -0,0 +1,186 @@
+// Package ratelimit enforces the rate_limit method options declared in
+// auth_options.proto with token buckets, and locks accounts out after
+// repeated failed logins. Rejected calls fail with ResourceExhausted and a
+// RetryInfo detail, which the gateway turns into a Retry-After header.
+//
+// Buckets live in a Backend. MemoryBackend suits a single process; a shared
+// store such as Redis can implement Backend to limit across replicas.
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	options "github.com/JunBSer/services_proto/options/auth_options/gen/go"
+	"github.com/JunBSer/services_proto/options/methodopts"
+)
+
+// Limit is a token bucket of Requests tokens refilled evenly over Window.
+type Limit struct {
+	Requests int
+	Window   time.Duration
+}
+
+// Result is the outcome of taking a token.
+type Result struct {
+	Allowed   bool
+	Remaining int
+	// RetryAfter is how long until a token is available when not allowed.
+	RetryAfter time.Duration
+}
+
+// Backend stores token buckets. Take must be atomic per key.
+type Backend interface {
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// Limiter enforces the rate_limit options of each called method.
+type Limiter struct {
+	Backend Backend
+	// User returns the authenticated user for RATE_LIMIT_KEY_USER limits.
+	// Without it those limits are skipped.
+	User func(ctx context.Context) (string, bool)
+	// Proxies in front of the gateway whose x-forwarded-for entries are
+	// believed for RATE_LIMIT_KEY_IP limits.
+	Proxies TrustedProxies
+	// OnError is called when the backend fails. Calls are let through so an
+	// outage of a shared backend does not take the service down.
+	OnError func(error)
+}
+
+func NewLimiter(backend Backend) *Limiter {
+	return &Limiter{Backend: backend}
+}
+
+// Allow takes a token from every bucket the method's limits select and
+// returns a RATE_LIMITED error if one of them is empty.
+func (l *Limiter) Allow(ctx context.Context, fullMethod string, req any) error {
+	for _, rl := range methodopts.RateLimits(fullMethod) {
+		if rl.GetRequests() == 0 || rl.GetWindowSeconds() == 0 {
+			continue
+		}
+		value, ok := l.keyValue(ctx, rl.GetKey(), req)
+		if !ok {
+			continue
+		}
+
+		key := fullMethod + "|" + rl.GetKey().String() + "|" + value
+		res, err := l.Backend.Take(ctx, key, Limit{
+			Requests: int(rl.GetRequests()),
+			Window:   time.Duration(rl.GetWindowSeconds()) * time.Second,
+		})
+		if err != nil {
+			if l.OnError != nil {
+				l.OnError(err)
+			}
+			continue
+		}
+		if !res.Allowed {
+			return apierrors.Throttled(apierrors.ReasonRateLimited, "too many requests, try again later", res.RetryAfter)
+		}
+	}
+	return nil
+}
+
+func (l *Limiter) keyValue(ctx context.Context, key options.RateLimitKey, req any) (string, bool) {
+	switch key {
+	case options.RateLimitKey_RATE_LIMIT_KEY_IP:
+		return l.Proxies.ClientIP(ctx)
+	case options.RateLimitKey_RATE_LIMIT_KEY_EMAIL:
+		return Email(req)
+	case options.RateLimitKey_RATE_LIMIT_KEY_USER:
+		if l.User == nil {
+			return "", false
+		}
+		return l.User(ctx)
+	}
+	return "", false
+}
+
+func (l *Limiter) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := l.Allow(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ClientIP is TrustedProxies(nil).ClientIP: it uses the last
+// x-forwarded-for entry, which grpc-gateway appends from the HTTP
+// connection's remote address.
+func ClientIP(ctx context.Context) (string, bool) {
+	return TrustedProxies(nil).ClientIP(ctx)
+}
+
+// TrustedProxies are the networks of proxies, such as load balancers, that
+// sit in front of the gateway and append to x-forwarded-for themselves.
+type TrustedProxies []netip.Prefix
+
+func (t TrustedProxies) trusted(addr netip.Addr) bool {
+	for _, p := range t {
+		if p.Contains(addr.Unmap()) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns the caller's address from x-forwarded-for, falling back
+// to the peer address. Clients control every entry they send, so the list is
+// read from the right: the last entry is the address the gateway accepted
+// the connection from, and an entry further left is only believed while the
+// entry after it is a trusted proxy. Services reachable without the gateway
+// should strip x-forwarded-for from untrusted callers.
+func (t TrustedProxies) ClientIP(ctx context.Context) (string, bool) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		var hops []string
+		for _, v := range md.Get("x-forwarded-for") {
+			for _, h := range strings.Split(v, ",") {
+				if h = strings.TrimSpace(h); h != "" {
+					hops = append(hops, h)
+				}
+			}
+		}
+		for i := len(hops) - 1; i >= 0; i-- {
+			addr, err := netip.ParseAddr(hops[i])
+			if err != nil || i == 0 || !t.trusted(addr) {
+				return hops[i], true
+			}
+		}
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "", false
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String(), true
+	}
+	return host, true
+}
+
+// Email returns the lower-cased email field of req.
+func Email(req any) (string, bool) {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return "", false
+	}
+	r := m.ProtoReflect()
+	fd := r.Descriptor().Fields().ByName("email")
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return "", false
+	}
+	email := strings.ToLower(strings.TrimSpace(r.Get(fd).String()))
+	return email, email != ""
+}
@@ -0,0 +1,144 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/JunBSer/services_proto/apierrors"
+	authpb "github.com/JunBSer/services_proto/auth/gen/go"
+)
+
+func TestClientIP(t *testing.T) {
+	lb := TrustedProxies{netip.MustParsePrefix("10.0.0.0/8")}
+	tests := []struct {
+		name    string
+		proxies TrustedProxies
+		xff     []string
+		peer    string
+		want    string
+	}{
+		{name: "gateway appended", xff: []string{"203.0.113.7"}, want: "203.0.113.7"},
+		{name: "spoofed entries ignored", xff: []string{"1.2.3.4, 5.6.7.8, 203.0.113.7"}, want: "203.0.113.7"},
+		{name: "repeated header", xff: []string{"1.2.3.4", "203.0.113.7"}, want: "203.0.113.7"},
+		{name: "behind trusted proxy", proxies: lb, xff: []string{"1.2.3.4, 203.0.113.7, 10.0.0.5"}, want: "203.0.113.7"},
+		{name: "untrusted proxy", xff: []string{"203.0.113.7, 10.0.0.5"}, want: "10.0.0.5"},
+		{name: "only trusted hops", proxies: lb, xff: []string{"10.0.0.9, 10.0.0.5"}, want: "10.0.0.9"},
+		{name: "peer fallback", peer: "198.51.100.2:4711", want: "198.51.100.2"},
+		{name: "empty header", xff: []string{" , "}, peer: "198.51.100.2:4711", want: "198.51.100.2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.xff != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.MD{"x-forwarded-for": tt.xff})
+			}
+			if tt.peer != "" {
+				addr, err := net.ResolveTCPAddr("tcp", tt.peer)
+				if err != nil {
+					t.Fatal(err)
+				}
+				ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+			}
+			got, ok := tt.proxies.ClientIP(ctx)
+			if !ok || got != tt.want {
+				t.Errorf("ClientIP = %q, %v; want %q", got, ok, tt.want)
+			}
+		})
+	}
+
+	if _, ok := ClientIP(context.Background()); ok {
+		t.Error("ClientIP without metadata or peer reported an address")
+	}
+}
+
+func TestEmail(t *testing.T) {
+	tests := []struct {
+		req    any
+		want   string
+		wantOK bool
+	}{
+		{&authpb.LoginRequest{Email: " User@Example.COM "}, "user@example.com", true},
+		{&authpb.LoginRequest{}, "", false},
+		{&authpb.LogoutRequest{}, "", false},
+		{"not a message", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := Email(tt.req)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("Email(%v) = %q, %v; want %q, %v", tt.req, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestLimiterAllow(t *testing.T) {
+	clock := newClock()
+	l := NewLimiter(NewMemoryBackend(clock.Now))
+	login := func(ip, email string) error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", ip))
+		return l.Allow(ctx, authpb.Auth_Login_FullMethodName, &authpb.LoginRequest{Email: email})
+	}
+
+	// Login allows 5 attempts per email and minute.
+	for i := range 5 {
+		if err := login(fmt.Sprintf("203.0.113.%d", i), "a@example.com"); err != nil {
+			t.Fatalf("attempt %d: %v", i, err)
+		}
+	}
+	err := login("203.0.113.99", "a@example.com")
+	if !apierrors.Is(err, apierrors.ReasonRateLimited) {
+		t.Fatalf("sixth attempt = %v, want RATE_LIMITED", err)
+	}
+	if d, ok := apierrors.RetryDelay(err); !ok || d <= 0 {
+		t.Errorf("RetryAfter = %v, %v; want a positive delay", d, ok)
+	}
+	if err := login("203.0.113.99", "b@example.com"); err != nil {
+		t.Errorf("other email: %v", err)
+	}
+
+	// And 20 per IP, which rotating spoofed entries does not get around.
+	for i := range 20 {
+		if err := login(fmt.Sprintf("9.9.9.%d, 203.0.113.50", i), fmt.Sprintf("u%d@example.com", i)); err != nil {
+			t.Fatalf("ip attempt %d: %v", i, err)
+		}
+	}
+	if err := login("1.1.1.1, 203.0.113.50", "w@example.com"); !apierrors.Is(err, apierrors.ReasonRateLimited) {
+		t.Fatalf("attempt over the IP limit = %v, want RATE_LIMITED", err)
+	}
+
+	clock.Advance(time.Minute)
+	if err := login("203.0.113.99", "a@example.com"); err != nil {
+		t.Errorf("after the window: %v", err)
+	}
+}
+
+// clock is a settable time source.
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newClock() *clock {
+	return &clock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}